@@ -31,6 +31,7 @@ const (
 	InternalBatchJob_FAILED            InternalBatchJob_State = 4
 	InternalBatchJob_CANCELED          InternalBatchJob_State = 5
 	InternalBatchJob_DELETED           InternalBatchJob_State = 6
+	InternalBatchJob_REQUEUED          InternalBatchJob_State = 7
 )
 
 // Enum value maps for InternalBatchJob_State.
@@ -43,6 +44,7 @@ var (
 		4: "FAILED",
 		5: "CANCELED",
 		6: "DELETED",
+		7: "REQUEUED",
 	}
 	InternalBatchJob_State_value = map[string]int32{
 		"STATE_UNSPECIFIED": 0,
@@ -52,6 +54,7 @@ var (
 		"FAILED":            4,
 		"CANCELED":          5,
 		"DELETED":           6,
+		"REQUEUED":          7,
 	}
 )
 
@@ -79,7 +82,7 @@ func (x InternalBatchJob_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InternalBatchJob_State.Descriptor instead.
 func (InternalBatchJob_State) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{8, 0}
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type InternalBatchJob_Action int32
@@ -89,6 +92,8 @@ const (
 	InternalBatchJob_CREATING           InternalBatchJob_Action = 1
 	InternalBatchJob_CANCELING          InternalBatchJob_Action = 2
 	InternalBatchJob_DELETING           InternalBatchJob_Action = 3
+	// REQUEUEING deletes the job from the cluster so that it is rescheduled to another cluster.
+	InternalBatchJob_REQUEUEING InternalBatchJob_Action = 4
 )
 
 // Enum value maps for InternalBatchJob_Action.
@@ -98,12 +103,14 @@ var (
 		1: "CREATING",
 		2: "CANCELING",
 		3: "DELETING",
+		4: "REQUEUEING",
 	}
 	InternalBatchJob_Action_value = map[string]int32{
 		"ACTION_UNSPECIFIED": 0,
 		"CREATING":           1,
 		"CANCELING":          2,
 		"DELETING":           3,
+		"REQUEUEING":         4,
 	}
 )
 
@@ -131,7 +138,7 @@ func (x InternalBatchJob_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InternalBatchJob_Action.Descriptor instead.
 func (InternalBatchJob_Action) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{8, 1}
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{13, 1}
 }
 
 type BatchJob struct {
@@ -157,6 +164,21 @@ type BatchJob struct {
 	OrganizationTitle string         `protobuf:"bytes,16,opt,name=organization_title,json=organizationTitle,proto3" json:"organization_title,omitempty"`
 	ProjectTitle      string         `protobuf:"bytes,17,opt,name=project_title,json=projectTitle,proto3" json:"project_title,omitempty"`
 	ClusterName       string         `protobuf:"bytes,18,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// priority is the scheduling priority of the job. A job with a higher priority can preempt
+	// preemptible workloads with a lower priority when there is no available capacity.
+	Priority int32 `protobuf:"varint,19,opt,name=priority,proto3" json:"priority,omitempty"`
+	// preemptible indicates whether the job can be preempted by a higher-priority workload.
+	Preemptible bool `protobuf:"varint,20,opt,name=preemptible,proto3" json:"preemptible,omitempty"`
+	// status_unknown is true if the cluster where the job runs is unreachable. The status is the last one
+	// reported before the cluster became unreachable.
+	StatusUnknown bool                `protobuf:"varint,21,opt,name=status_unknown,json=statusUnknown,proto3" json:"status_unknown,omitempty"`
+	Placement     *BatchJob_Placement `protobuf:"bytes,22,opt,name=placement,proto3" json:"placement,omitempty"`
+	// last_dispatch_error is the error of the last attempt of the dispatcher to process the queued action of the job.
+	// It is cleared when the action succeeds.
+	LastDispatchError string `protobuf:"bytes,23,opt,name=last_dispatch_error,json=lastDispatchError,proto3" json:"last_dispatch_error,omitempty"`
+	// status_reason describes why the job is not making progress (e.g., "ImagePullBackOff: Back-off pulling image").
+	// It is cleared when the cause is resolved.
+	StatusReason string `protobuf:"bytes,25,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
 }
 
 func (x *BatchJob) Reset() {
@@ -310,6 +332,48 @@ func (x *BatchJob) GetClusterName() string {
 	return ""
 }
 
+func (x *BatchJob) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *BatchJob) GetPreemptible() bool {
+	if x != nil {
+		return x.Preemptible
+	}
+	return false
+}
+
+func (x *BatchJob) GetStatusUnknown() bool {
+	if x != nil {
+		return x.StatusUnknown
+	}
+	return false
+}
+
+func (x *BatchJob) GetPlacement() *BatchJob_Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *BatchJob) GetLastDispatchError() string {
+	if x != nil {
+		return x.LastDispatchError
+	}
+	return ""
+}
+
+func (x *BatchJob) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
 type PyTorchJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -372,6 +436,12 @@ type CreateBatchJobRequest struct {
 	// data_files is a list of file IDs that will be downloaded to the container.
 	DataFiles []string       `protobuf:"bytes,6,rep,name=data_files,json=dataFiles,proto3" json:"data_files,omitempty"`
 	Kind      *BatchJob_Kind `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind,omitempty"`
+	// priority is the scheduling priority of the job. Defaults to 0.
+	Priority int32 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// preemptible specifies whether the job can be preempted by a higher-priority workload.
+	// Defaults to true. Only projects allowed by the server configuration can set this to false.
+	Preemptible *bool               `protobuf:"varint,9,opt,name=preemptible,proto3,oneof" json:"preemptible,omitempty"`
+	Placement   *BatchJob_Placement `protobuf:"bytes,10,opt,name=placement,proto3" json:"placement,omitempty"`
 }
 
 func (x *CreateBatchJobRequest) Reset() {
@@ -455,6 +525,27 @@ func (x *CreateBatchJobRequest) GetKind() *BatchJob_Kind {
 	return nil
 }
 
+func (x *CreateBatchJobRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateBatchJobRequest) GetPreemptible() bool {
+	if x != nil && x.Preemptible != nil {
+		return *x.Preemptible
+	}
+	return false
+}
+
+func (x *CreateBatchJobRequest) GetPlacement() *BatchJob_Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
 type ListBatchJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -717,18 +808,20 @@ func (x *DeleteBatchJobRequest) GetId() string {
 	return ""
 }
 
-type InternalBatchJob struct {
+// BatchJobLogArchive is the archived log of a container of a batch job pod.
+type BatchJobLogArchive struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Job          *BatchJob               `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	State        InternalBatchJob_State  `protobuf:"varint,2,opt,name=state,proto3,enum=llmariner.batch.server.v1.InternalBatchJob_State" json:"state,omitempty"`
-	QueuedAction InternalBatchJob_Action `protobuf:"varint,3,opt,name=queued_action,json=queuedAction,proto3,enum=llmariner.batch.server.v1.InternalBatchJob_Action" json:"queued_action,omitempty"`
+	PodName       string `protobuf:"bytes,1,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	ContainerName string `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	// chunk_urls are the presigned URLs of the gzip-compressed chunks of the log in order.
+	ChunkUrls []string `protobuf:"bytes,3,rep,name=chunk_urls,json=chunkUrls,proto3" json:"chunk_urls,omitempty"`
 }
 
-func (x *InternalBatchJob) Reset() {
-	*x = InternalBatchJob{}
+func (x *BatchJobLogArchive) Reset() {
+	*x = BatchJobLogArchive{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_batch_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -736,13 +829,13 @@ func (x *InternalBatchJob) Reset() {
 	}
 }
 
-func (x *InternalBatchJob) String() string {
+func (x *BatchJobLogArchive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalBatchJob) ProtoMessage() {}
+func (*BatchJobLogArchive) ProtoMessage() {}
 
-func (x *InternalBatchJob) ProtoReflect() protoreflect.Message {
+func (x *BatchJobLogArchive) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_batch_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -754,40 +847,42 @@ func (x *InternalBatchJob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalBatchJob.ProtoReflect.Descriptor instead.
-func (*InternalBatchJob) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchJobLogArchive.ProtoReflect.Descriptor instead.
+func (*BatchJobLogArchive) Descriptor() ([]byte, []int) {
 	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *InternalBatchJob) GetJob() *BatchJob {
+func (x *BatchJobLogArchive) GetPodName() string {
 	if x != nil {
-		return x.Job
+		return x.PodName
 	}
-	return nil
+	return ""
 }
 
-func (x *InternalBatchJob) GetState() InternalBatchJob_State {
+func (x *BatchJobLogArchive) GetContainerName() string {
 	if x != nil {
-		return x.State
+		return x.ContainerName
 	}
-	return InternalBatchJob_STATE_UNSPECIFIED
+	return ""
 }
 
-func (x *InternalBatchJob) GetQueuedAction() InternalBatchJob_Action {
+func (x *BatchJobLogArchive) GetChunkUrls() []string {
 	if x != nil {
-		return x.QueuedAction
+		return x.ChunkUrls
 	}
-	return InternalBatchJob_ACTION_UNSPECIFIED
+	return nil
 }
 
-type ListQueuedInternalBatchJobsRequest struct {
+type GetBatchJobLogArchivesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListQueuedInternalBatchJobsRequest) Reset() {
-	*x = ListQueuedInternalBatchJobsRequest{}
+func (x *GetBatchJobLogArchivesRequest) Reset() {
+	*x = GetBatchJobLogArchivesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_batch_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -795,13 +890,13 @@ func (x *ListQueuedInternalBatchJobsRequest) Reset() {
 	}
 }
 
-func (x *ListQueuedInternalBatchJobsRequest) String() string {
+func (x *GetBatchJobLogArchivesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuedInternalBatchJobsRequest) ProtoMessage() {}
+func (*GetBatchJobLogArchivesRequest) ProtoMessage() {}
 
-func (x *ListQueuedInternalBatchJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBatchJobLogArchivesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_batch_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -813,21 +908,30 @@ func (x *ListQueuedInternalBatchJobsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuedInternalBatchJobsRequest.ProtoReflect.Descriptor instead.
-func (*ListQueuedInternalBatchJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBatchJobLogArchivesRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchJobLogArchivesRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{9}
 }
 
-type ListQueuedInternalBatchJobsResponse struct {
+func (x *GetBatchJobLogArchivesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBatchJobLogArchivesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Jobs []*InternalBatchJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Archives []*BatchJobLogArchive `protobuf:"bytes,1,rep,name=archives,proto3" json:"archives,omitempty"`
+	// expires_at is the Unix timestamp in seconds when the URLs expire.
+	ExpiresAt int64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *ListQueuedInternalBatchJobsResponse) Reset() {
-	*x = ListQueuedInternalBatchJobsResponse{}
+func (x *GetBatchJobLogArchivesResponse) Reset() {
+	*x = GetBatchJobLogArchivesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_batch_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -835,13 +939,13 @@ func (x *ListQueuedInternalBatchJobsResponse) Reset() {
 	}
 }
 
-func (x *ListQueuedInternalBatchJobsResponse) String() string {
+func (x *GetBatchJobLogArchivesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuedInternalBatchJobsResponse) ProtoMessage() {}
+func (*GetBatchJobLogArchivesResponse) ProtoMessage() {}
 
-func (x *ListQueuedInternalBatchJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBatchJobLogArchivesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_batch_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -853,28 +957,44 @@ func (x *ListQueuedInternalBatchJobsResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuedInternalBatchJobsResponse.ProtoReflect.Descriptor instead.
-func (*ListQueuedInternalBatchJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBatchJobLogArchivesResponse.ProtoReflect.Descriptor instead.
+func (*GetBatchJobLogArchivesResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListQueuedInternalBatchJobsResponse) GetJobs() []*InternalBatchJob {
+func (x *GetBatchJobLogArchivesResponse) GetArchives() []*BatchJobLogArchive {
 	if x != nil {
-		return x.Jobs
+		return x.Archives
 	}
 	return nil
 }
 
-type GetInternalBatchJobRequest struct {
+func (x *GetBatchJobLogArchivesResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type StreamBatchJobLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *GetInternalBatchJobRequest) Reset() {
-	*x = GetInternalBatchJobRequest{}
+	// follow keeps streaming new logs until the container terminates or the request is canceled.
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// tail_lines is the number of the last lines of the logs to stream. All lines are streamed if zero.
+	TailLines int64 `protobuf:"varint,3,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	// container is the name of the container (e.g., "init"). Defaults to "main".
+	Container string `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+	// rank is the PyTorch rank of the pod whose logs are streamed. It selects one of the pods of a batch job
+	// that runs with multiple workers.
+	Rank int32 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *StreamBatchJobLogsRequest) Reset() {
+	*x = StreamBatchJobLogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_batch_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -882,13 +1002,13 @@ func (x *GetInternalBatchJobRequest) Reset() {
 	}
 }
 
-func (x *GetInternalBatchJobRequest) String() string {
+func (x *StreamBatchJobLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInternalBatchJobRequest) ProtoMessage() {}
+func (*StreamBatchJobLogsRequest) ProtoMessage() {}
 
-func (x *GetInternalBatchJobRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamBatchJobLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_batch_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -900,32 +1020,57 @@ func (x *GetInternalBatchJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInternalBatchJobRequest.ProtoReflect.Descriptor instead.
-func (*GetInternalBatchJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamBatchJobLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamBatchJobLogsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetInternalBatchJobRequest) GetId() string {
+func (x *StreamBatchJobLogsRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdateBatchJobStateRequest struct {
+func (x *StreamBatchJobLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamBatchJobLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *StreamBatchJobLogsRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *StreamBatchJobLogsRequest) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type StreamBatchJobLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	State InternalBatchJob_State `protobuf:"varint,2,opt,name=state,proto3,enum=llmariner.batch.server.v1.InternalBatchJob_State" json:"state,omitempty"`
-	// currently only used for FAILED state.
-	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// data is a chunk of the logs.
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *UpdateBatchJobStateRequest) Reset() {
-	*x = UpdateBatchJobStateRequest{}
+func (x *StreamBatchJobLogsResponse) Reset() {
+	*x = StreamBatchJobLogsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_batch_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -933,13 +1078,13 @@ func (x *UpdateBatchJobStateRequest) Reset() {
 	}
 }
 
-func (x *UpdateBatchJobStateRequest) String() string {
+func (x *StreamBatchJobLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBatchJobStateRequest) ProtoMessage() {}
+func (*StreamBatchJobLogsResponse) ProtoMessage() {}
 
-func (x *UpdateBatchJobStateRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamBatchJobLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_batch_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -951,47 +1096,31 @@ func (x *UpdateBatchJobStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBatchJobStateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBatchJobStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamBatchJobLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamBatchJobLogsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UpdateBatchJobStateRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateBatchJobStateRequest) GetState() InternalBatchJob_State {
-	if x != nil {
-		return x.State
-	}
-	return InternalBatchJob_STATE_UNSPECIFIED
-}
-
-func (x *UpdateBatchJobStateRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *UpdateBatchJobStateRequest) GetMessage() string {
+func (x *StreamBatchJobLogsResponse) GetData() string {
 	if x != nil {
-		return x.Message
+		return x.Data
 	}
 	return ""
 }
 
-type UpdateBatchJobStateResponse struct {
+type InternalBatchJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Job          *BatchJob                    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	State        InternalBatchJob_State       `protobuf:"varint,2,opt,name=state,proto3,enum=llmariner.batch.server.v1.InternalBatchJob_State" json:"state,omitempty"`
+	QueuedAction InternalBatchJob_Action      `protobuf:"varint,3,opt,name=queued_action,json=queuedAction,proto3,enum=llmariner.batch.server.v1.InternalBatchJob_Action" json:"queued_action,omitempty"`
+	KueueQueue   *InternalBatchJob_KueueQueue `protobuf:"bytes,4,opt,name=kueue_queue,json=kueueQueue,proto3" json:"kueue_queue,omitempty"`
 }
 
-func (x *UpdateBatchJobStateResponse) Reset() {
-	*x = UpdateBatchJobStateResponse{}
+func (x *InternalBatchJob) Reset() {
+	*x = InternalBatchJob{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_batch_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -999,13 +1128,13 @@ func (x *UpdateBatchJobStateResponse) Reset() {
 	}
 }
 
-func (x *UpdateBatchJobStateResponse) String() string {
+func (x *InternalBatchJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBatchJobStateResponse) ProtoMessage() {}
+func (*InternalBatchJob) ProtoMessage() {}
 
-func (x *UpdateBatchJobStateResponse) ProtoReflect() protoreflect.Message {
+func (x *InternalBatchJob) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_batch_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1017,22 +1146,47 @@ func (x *UpdateBatchJobStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBatchJobStateResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBatchJobStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalBatchJob.ProtoReflect.Descriptor instead.
+func (*InternalBatchJob) Descriptor() ([]byte, []int) {
 	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{13}
 }
 
-type BatchJob_Error struct {
+func (x *InternalBatchJob) GetJob() *BatchJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *InternalBatchJob) GetState() InternalBatchJob_State {
+	if x != nil {
+		return x.State
+	}
+	return InternalBatchJob_STATE_UNSPECIFIED
+}
+
+func (x *InternalBatchJob) GetQueuedAction() InternalBatchJob_Action {
+	if x != nil {
+		return x.QueuedAction
+	}
+	return InternalBatchJob_ACTION_UNSPECIFIED
+}
+
+func (x *InternalBatchJob) GetKueueQueue() *InternalBatchJob_KueueQueue {
+	if x != nil {
+		return x.KueueQueue
+	}
+	return nil
+}
+
+type ListQueuedInternalBatchJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *BatchJob_Error) Reset() {
-	*x = BatchJob_Error{}
+func (x *ListQueuedInternalBatchJobsRequest) Reset() {
+	*x = ListQueuedInternalBatchJobsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_batch_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1040,13 +1194,13 @@ func (x *BatchJob_Error) Reset() {
 	}
 }
 
-func (x *BatchJob_Error) String() string {
+func (x *ListQueuedInternalBatchJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchJob_Error) ProtoMessage() {}
+func (*ListQueuedInternalBatchJobsRequest) ProtoMessage() {}
 
-func (x *BatchJob_Error) ProtoReflect() protoreflect.Message {
+func (x *ListQueuedInternalBatchJobsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_batch_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1058,35 +1212,21 @@ func (x *BatchJob_Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchJob_Error.ProtoReflect.Descriptor instead.
-func (*BatchJob_Error) Descriptor() ([]byte, []int) {
-	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *BatchJob_Error) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *BatchJob_Error) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use ListQueuedInternalBatchJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuedInternalBatchJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{14}
 }
 
-type BatchJob_Resources struct {
+type ListQueuedInternalBatchJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GpuCount int32 `protobuf:"varint,1,opt,name=gpu_count,json=gpuCount,proto3" json:"gpu_count,omitempty"`
+	Jobs []*InternalBatchJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 }
 
-func (x *BatchJob_Resources) Reset() {
-	*x = BatchJob_Resources{}
+func (x *ListQueuedInternalBatchJobsResponse) Reset() {
+	*x = ListQueuedInternalBatchJobsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_batch_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1094,13 +1234,13 @@ func (x *BatchJob_Resources) Reset() {
 	}
 }
 
-func (x *BatchJob_Resources) String() string {
+func (x *ListQueuedInternalBatchJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchJob_Resources) ProtoMessage() {}
+func (*ListQueuedInternalBatchJobsResponse) ProtoMessage() {}
 
-func (x *BatchJob_Resources) ProtoReflect() protoreflect.Message {
+func (x *ListQueuedInternalBatchJobsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_batch_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1112,14 +1252,443 @@ func (x *BatchJob_Resources) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchJob_Resources.ProtoReflect.Descriptor instead.
-func (*BatchJob_Resources) Descriptor() ([]byte, []int) {
-	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use ListQueuedInternalBatchJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuedInternalBatchJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListQueuedInternalBatchJobsResponse) GetJobs() []*InternalBatchJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type GetInternalBatchJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetInternalBatchJobRequest) Reset() {
+	*x = GetInternalBatchJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_batch_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInternalBatchJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInternalBatchJobRequest) ProtoMessage() {}
+
+func (x *GetInternalBatchJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_batch_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInternalBatchJobRequest.ProtoReflect.Descriptor instead.
+func (*GetInternalBatchJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetInternalBatchJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateBatchJobStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State InternalBatchJob_State `protobuf:"varint,2,opt,name=state,proto3,enum=llmariner.batch.server.v1.InternalBatchJob_State" json:"state,omitempty"`
+	// currently only used for FAILED state. reason is the error code that classifies the failure
+	// (e.g., "oom_killed", "user_error").
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// exit_code is the exit code of the failed container. It is set only when reason is "user_error".
+	ExitCode int32 `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+}
+
+func (x *UpdateBatchJobStateRequest) Reset() {
+	*x = UpdateBatchJobStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_batch_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBatchJobStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchJobStateRequest) ProtoMessage() {}
+
+func (x *UpdateBatchJobStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_batch_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchJobStateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBatchJobStateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateBatchJobStateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBatchJobStateRequest) GetState() InternalBatchJob_State {
+	if x != nil {
+		return x.State
+	}
+	return InternalBatchJob_STATE_UNSPECIFIED
+}
+
+func (x *UpdateBatchJobStateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateBatchJobStateRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateBatchJobStateRequest) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type UpdateBatchJobStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateBatchJobStateResponse) Reset() {
+	*x = UpdateBatchJobStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_batch_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBatchJobStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchJobStateResponse) ProtoMessage() {}
+
+func (x *UpdateBatchJobStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_batch_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchJobStateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBatchJobStateResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{18}
+}
+
+type UpdateBatchJobStatusReasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status_reason is cleared if empty.
+	StatusReason string `protobuf:"bytes,2,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+}
+
+func (x *UpdateBatchJobStatusReasonRequest) Reset() {
+	*x = UpdateBatchJobStatusReasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_batch_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBatchJobStatusReasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchJobStatusReasonRequest) ProtoMessage() {}
+
+func (x *UpdateBatchJobStatusReasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_batch_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchJobStatusReasonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBatchJobStatusReasonRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateBatchJobStatusReasonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBatchJobStatusReasonRequest) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+type UpdateBatchJobStatusReasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateBatchJobStatusReasonResponse) Reset() {
+	*x = UpdateBatchJobStatusReasonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_batch_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBatchJobStatusReasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchJobStatusReasonResponse) ProtoMessage() {}
+
+func (x *UpdateBatchJobStatusReasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_batch_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchJobStatusReasonResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBatchJobStatusReasonResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{20}
+}
+
+type BatchJob_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// exit_code is the exit code of the failed container. It is set only when code is "user_error".
+	ExitCode int32 `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+}
+
+func (x *BatchJob_Error) Reset() {
+	*x = BatchJob_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_batch_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchJob_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchJob_Error) ProtoMessage() {}
+
+func (x *BatchJob_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_batch_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchJob_Error.ProtoReflect.Descriptor instead.
+func (*BatchJob_Error) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *BatchJob_Error) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchJob_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchJob_Error) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type BatchJob_Resources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GpuCount int32 `protobuf:"varint,1,opt,name=gpu_count,json=gpuCount,proto3" json:"gpu_count,omitempty"`
+	// accelerator_type is the Kubernetes resource name of the accelerator (e.g., "nvidia.com/gpu",
+	// "amd.com/gpu", "habana.ai/gaudi", "aws.amazon.com/neuron"). NVIDIA GPUs are used if not specified.
+	AcceleratorType string `protobuf:"bytes,2,opt,name=accelerator_type,json=acceleratorType,proto3" json:"accelerator_type,omitempty"`
+	// gpu_type is the GPU product name reported by NVIDIA GPU Feature Discovery (e.g., "NVIDIA-H100-80GB-HBM3").
+	// If specified, the workload runs only on the nodes that have the GPU product.
+	GpuType string `protobuf:"bytes,3,opt,name=gpu_type,json=gpuType,proto3" json:"gpu_type,omitempty"`
+	// min_gpu_memory_megabytes is the minimum memory size of each GPU in MiB.
+	MinGpuMemoryMegabytes int32 `protobuf:"varint,4,opt,name=min_gpu_memory_megabytes,json=minGpuMemoryMegabytes,proto3" json:"min_gpu_memory_megabytes,omitempty"`
+	// gpu_profile is a fractional GPU profile. It is either a MIG profile (e.g., "1g.10gb") that is mapped to
+	// the "nvidia.com/mig-<profile>" resource, or "shared" that is mapped to time-sliced GPUs exposed as the
+	// "nvidia.com/gpu.shared" resource. gpu_count is the number of the fractional GPUs.
+	GpuProfile string `protobuf:"bytes,5,opt,name=gpu_profile,json=gpuProfile,proto3" json:"gpu_profile,omitempty"`
+	// cpu_milicore and memory_megabytes are the CPU and memory requested by each pod of the job.
+	CpuMilicore     int32 `protobuf:"varint,6,opt,name=cpu_milicore,json=cpuMilicore,proto3" json:"cpu_milicore,omitempty"`
+	MemoryMegabytes int32 `protobuf:"varint,7,opt,name=memory_megabytes,json=memoryMegabytes,proto3" json:"memory_megabytes,omitempty"`
+}
+
+func (x *BatchJob_Resources) Reset() {
+	*x = BatchJob_Resources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_batch_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchJob_Resources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchJob_Resources) ProtoMessage() {}
+
+func (x *BatchJob_Resources) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_batch_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchJob_Resources.ProtoReflect.Descriptor instead.
+func (*BatchJob_Resources) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *BatchJob_Resources) GetGpuCount() int32 {
+	if x != nil {
+		return x.GpuCount
+	}
+	return 0
+}
+
+func (x *BatchJob_Resources) GetAcceleratorType() string {
+	if x != nil {
+		return x.AcceleratorType
+	}
+	return ""
+}
+
+func (x *BatchJob_Resources) GetGpuType() string {
+	if x != nil {
+		return x.GpuType
+	}
+	return ""
+}
+
+func (x *BatchJob_Resources) GetMinGpuMemoryMegabytes() int32 {
+	if x != nil {
+		return x.MinGpuMemoryMegabytes
+	}
+	return 0
+}
+
+func (x *BatchJob_Resources) GetGpuProfile() string {
+	if x != nil {
+		return x.GpuProfile
+	}
+	return ""
+}
+
+func (x *BatchJob_Resources) GetCpuMilicore() int32 {
+	if x != nil {
+		return x.CpuMilicore
+	}
+	return 0
 }
 
-func (x *BatchJob_Resources) GetGpuCount() int32 {
+func (x *BatchJob_Resources) GetMemoryMegabytes() int32 {
 	if x != nil {
-		return x.GpuCount
+		return x.MemoryMegabytes
 	}
 	return 0
 }
@@ -1138,7 +1707,7 @@ type BatchJob_Kind struct {
 func (x *BatchJob_Kind) Reset() {
 	*x = BatchJob_Kind{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_batch_service_proto_msgTypes[17]
+		mi := &file_api_v1_batch_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1151,7 +1720,7 @@ func (x *BatchJob_Kind) String() string {
 func (*BatchJob_Kind) ProtoMessage() {}
 
 func (x *BatchJob_Kind) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_batch_service_proto_msgTypes[17]
+	mi := &file_api_v1_batch_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,6 +1760,164 @@ type BatchJob_Kind_Pytorch struct {
 
 func (*BatchJob_Kind_Pytorch) isBatchJob_Kind_Kind() {}
 
+// Placement specifies the clusters where a job can run. Clusters are specified by their IDs or names,
+// and all of them must be assigned to the project.
+type BatchJob_Placement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// required_clusters are the clusters where the job must run. If not empty, the job runs only
+	// in one of the clusters.
+	RequiredClusters []string `protobuf:"bytes,1,rep,name=required_clusters,json=requiredClusters,proto3" json:"required_clusters,omitempty"`
+	// preferred_clusters are the clusters where the job preferably runs. Other clusters are used only
+	// when none of the preferred clusters can run the job.
+	PreferredClusters []string `protobuf:"bytes,2,rep,name=preferred_clusters,json=preferredClusters,proto3" json:"preferred_clusters,omitempty"`
+	// cluster_selector is the labels that the cluster must have.
+	ClusterSelector map[string]string `protobuf:"bytes,3,rep,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// region is the region where the job must run. This is a shorthand of the "region" label in cluster_selector.
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// preferred_cluster_selector is the labels of the clusters where the job preferably runs.
+	PreferredClusterSelector map[string]string `protobuf:"bytes,5,rep,name=preferred_cluster_selector,json=preferredClusterSelector,proto3" json:"preferred_cluster_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// namespace is the Kubernetes namespace where the job runs. It must be one of the namespaces assigned to
+	// the project. If empty, the first namespace assigned to the project in the cluster is used.
+	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *BatchJob_Placement) Reset() {
+	*x = BatchJob_Placement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_batch_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchJob_Placement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchJob_Placement) ProtoMessage() {}
+
+func (x *BatchJob_Placement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_batch_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchJob_Placement.ProtoReflect.Descriptor instead.
+func (*BatchJob_Placement) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *BatchJob_Placement) GetRequiredClusters() []string {
+	if x != nil {
+		return x.RequiredClusters
+	}
+	return nil
+}
+
+func (x *BatchJob_Placement) GetPreferredClusters() []string {
+	if x != nil {
+		return x.PreferredClusters
+	}
+	return nil
+}
+
+func (x *BatchJob_Placement) GetClusterSelector() map[string]string {
+	if x != nil {
+		return x.ClusterSelector
+	}
+	return nil
+}
+
+func (x *BatchJob_Placement) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *BatchJob_Placement) GetPreferredClusterSelector() map[string]string {
+	if x != nil {
+		return x.PreferredClusterSelector
+	}
+	return nil
+}
+
+func (x *BatchJob_Placement) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// KueueQueue is the Kueue queue of the project that the job is submitted to. It is resolved from the current
+// configuration of the server when the dispatcher gets the job.
+type InternalBatchJob_KueueQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the LocalQueue. The dispatcher uses the queue configured for the namespace if empty.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// resource_quotas are the nominal quotas of the ClusterQueue that the dispatcher provisions for the namespace
+	// (e.g., "nvidia.com/gpu": "8"). The quotas are ignored unless the dispatcher provisions the queues.
+	ResourceQuotas map[string]string `protobuf:"bytes,2,rep,name=resource_quotas,json=resourceQuotas,proto3" json:"resource_quotas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *InternalBatchJob_KueueQueue) Reset() {
+	*x = InternalBatchJob_KueueQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_batch_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InternalBatchJob_KueueQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalBatchJob_KueueQueue) ProtoMessage() {}
+
+func (x *InternalBatchJob_KueueQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_batch_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalBatchJob_KueueQueue.ProtoReflect.Descriptor instead.
+func (*InternalBatchJob_KueueQueue) Descriptor() ([]byte, []int) {
+	return file_api_v1_batch_service_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *InternalBatchJob_KueueQueue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalBatchJob_KueueQueue) GetResourceQuotas() map[string]string {
+	if x != nil {
+		return x.ResourceQuotas
+	}
+	return nil
+}
+
 var File_api_v1_batch_service_proto protoreflect.FileDescriptor
 
 var file_api_v1_batch_service_proto_rawDesc = []byte{
@@ -1199,7 +1926,7 @@ var file_api_v1_batch_service_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x07, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x0f, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a,
 	0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
@@ -1243,200 +1970,373 @@ var file_api_v1_batch_service_proto_rawDesc = []byte{
 	0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a,
 	0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x12, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x1a, 0x35, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x28, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x1a, 0x37, 0x0a, 0x09, 0x45, 0x6e, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x51, 0x0a, 0x04, 0x4b, 0x69,
-	0x6e, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x79, 0x54, 0x6f, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x48, 0x00, 0x52, 0x07, 0x70, 0x79,
-	0x74, 0x6f, 0x72, 0x63, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x2f, 0x0a,
-	0x0a, 0x50, 0x79, 0x54, 0x6f, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x21, 0x0a, 0x0c, 0x77,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8f,
-	0x04, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x57, 0x0a, 0x07, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
-	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x73, 0x12, 0x4b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x4b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x1a, 0x52, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x96, 0x02, 0x0a, 0x09,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x70,
+	0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x70, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x70, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x18,
+	0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x70, 0x75, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d,
+	0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15,
+	0x6d, 0x69, 0x6e, 0x47, 0x70, 0x75, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x67, 0x61,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x75, 0x5f, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x70, 0x75, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x69,
+	0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x70,
+	0x75, 0x4d, 0x69, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x67, 0x61, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x45, 0x6e, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x51, 0x0a,
+	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x79, 0x54, 0x6f, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x48, 0x00, 0x52,
+	0x07, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x1a, 0xa9, 0x04, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x6d, 0x0a, 0x10, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
 	0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x4e,
-	0x0a, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6c,
-	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45,
-	0x6e, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x6c,
-	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
-	0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x45, 0x6e, 0x76, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x42, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c,
-	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
-	0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d,
-	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f,
-	0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74,
-	0x65, 0x6d, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa7, 0x03, 0x0a, 0x10,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
-	0x12, 0x35, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a,
-	0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x47, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x89, 0x01, 0x0a, 0x1a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
 	0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x57, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
-	0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x05, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x45,
-	0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
-	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a,
-	0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x44,
-	0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x06, 0x22, 0x4b, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43,
-	0x45, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x4c, 0x45, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0x03, 0x22, 0x24, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x23, 0x4c,
-	0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a,
-	0x6f, 0x62, 0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x47, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb5, 0x05, 0x0a, 0x0c, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x30,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x18, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x42, 0x0a, 0x14, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x4b, 0x0a, 0x1d, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x18,
+	0x10, 0x19, 0x22, 0x2f, 0x0a, 0x0a, 0x50, 0x79, 0x54, 0x6f, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x62,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xaf, 0x05, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x57, 0x0a,
+	0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d,
 	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74,
+	0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x65,
+	0x6e, 0x76, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x3c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74,
 	0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x4a, 0x6f, 0x62, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a,
-	0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73,
-	0x12, 0x8a, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
-	0x62, 0x73, 0x12, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x7e, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x2d, 0x2e, 0x6c,
-	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6c,
-	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
-	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8b, 0x01,
-	0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
+	0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x62, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09,
+	0x45, 0x6e, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x42, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27,
+	0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x75, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x55, 0x72, 0x6c, 0x73, 0x22, 0x2f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a,
+	0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x08, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x30, 0x0a, 0x1a,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf9,
+	0x05, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x6f, 0x62, 0x12, 0x35, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x47, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x57, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0b,
+	0x6b, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x4b,
+	0x75, 0x65, 0x75, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x0a, 0x6b, 0x75, 0x65, 0x75, 0x65,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x1a, 0xd8, 0x01, 0x0a, 0x0a, 0x4b, 0x75, 0x65, 0x75, 0x65, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x73, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x4a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x2e, 0x4b,
+	0x75, 0x65, 0x75, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x1a, 0x41, 0x0a,
+	0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x7b, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43,
+	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x07, 0x22, 0x5b, 0x0a,
+	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x55, 0x45, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x22, 0x24, 0x0a, 0x22, 0x4c, 0x69,
+	0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x66, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a,
+	0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc4, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
+	0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x1d, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x21,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x97, 0x08, 0x0a,
+	0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
 	0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62,
 	0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22,
-	0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x84, 0x01, 0x0a, 0x0e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x30,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a,
+	0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f,
+	0x62, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12,
+	0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12,
+	0x7e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x2d,
 	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x4a, 0x6f, 0x62, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x32, 0xb5, 0x03, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x1b, 0x4c, 0x69,
-	0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x3d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a,
+	0x6f, 0x62, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x8b, 0x01, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a,
+	0x6f, 0x62, 0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x84, 0x01,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
+	0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a,
+	0x13, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb7, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12,
+	0x38, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
 	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
-	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
+	0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x6c, 0x6f, 0x67, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0xa5,
+	0x01, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
+	0x62, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x6c, 0x6f, 0x67, 0x73, 0x30, 0x01, 0x32, 0xd1, 0x04, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x9c, 0x01,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x3d, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x6f, 0x62, 0x12, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
 	0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
 	0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x4a, 0x6f, 0x62, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x2e, 0x6c, 0x6c,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x99,
+	0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3c, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6c, 0x6c,
 	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69,
 	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
 	0x65, 0x72, 0x2f, 0x6a, 0x6f, 0x62, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
@@ -1455,7 +2355,7 @@ func file_api_v1_batch_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_batch_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_batch_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_api_v1_batch_service_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_api_v1_batch_service_proto_goTypes = []interface{}{
 	(InternalBatchJob_State)(0),                 // 0: llmariner.batch.server.v1.InternalBatchJob.State
 	(InternalBatchJob_Action)(0),                // 1: llmariner.batch.server.v1.InternalBatchJob.Action
@@ -1467,56 +2367,81 @@ var file_api_v1_batch_service_proto_goTypes = []interface{}{
 	(*GetBatchJobRequest)(nil),                  // 7: llmariner.batch.server.v1.GetBatchJobRequest
 	(*CancelBatchJobRequest)(nil),               // 8: llmariner.batch.server.v1.CancelBatchJobRequest
 	(*DeleteBatchJobRequest)(nil),               // 9: llmariner.batch.server.v1.DeleteBatchJobRequest
-	(*InternalBatchJob)(nil),                    // 10: llmariner.batch.server.v1.InternalBatchJob
-	(*ListQueuedInternalBatchJobsRequest)(nil),  // 11: llmariner.batch.server.v1.ListQueuedInternalBatchJobsRequest
-	(*ListQueuedInternalBatchJobsResponse)(nil), // 12: llmariner.batch.server.v1.ListQueuedInternalBatchJobsResponse
-	(*GetInternalBatchJobRequest)(nil),          // 13: llmariner.batch.server.v1.GetInternalBatchJobRequest
-	(*UpdateBatchJobStateRequest)(nil),          // 14: llmariner.batch.server.v1.UpdateBatchJobStateRequest
-	(*UpdateBatchJobStateResponse)(nil),         // 15: llmariner.batch.server.v1.UpdateBatchJobStateResponse
-	(*BatchJob_Error)(nil),                      // 16: llmariner.batch.server.v1.BatchJob.Error
-	(*BatchJob_Resources)(nil),                  // 17: llmariner.batch.server.v1.BatchJob.Resources
-	nil,                                         // 18: llmariner.batch.server.v1.BatchJob.EnvsEntry
-	(*BatchJob_Kind)(nil),                       // 19: llmariner.batch.server.v1.BatchJob.Kind
-	nil,                                         // 20: llmariner.batch.server.v1.CreateBatchJobRequest.ScriptsEntry
-	nil,                                         // 21: llmariner.batch.server.v1.CreateBatchJobRequest.EnvsEntry
+	(*BatchJobLogArchive)(nil),                  // 10: llmariner.batch.server.v1.BatchJobLogArchive
+	(*GetBatchJobLogArchivesRequest)(nil),       // 11: llmariner.batch.server.v1.GetBatchJobLogArchivesRequest
+	(*GetBatchJobLogArchivesResponse)(nil),      // 12: llmariner.batch.server.v1.GetBatchJobLogArchivesResponse
+	(*StreamBatchJobLogsRequest)(nil),           // 13: llmariner.batch.server.v1.StreamBatchJobLogsRequest
+	(*StreamBatchJobLogsResponse)(nil),          // 14: llmariner.batch.server.v1.StreamBatchJobLogsResponse
+	(*InternalBatchJob)(nil),                    // 15: llmariner.batch.server.v1.InternalBatchJob
+	(*ListQueuedInternalBatchJobsRequest)(nil),  // 16: llmariner.batch.server.v1.ListQueuedInternalBatchJobsRequest
+	(*ListQueuedInternalBatchJobsResponse)(nil), // 17: llmariner.batch.server.v1.ListQueuedInternalBatchJobsResponse
+	(*GetInternalBatchJobRequest)(nil),          // 18: llmariner.batch.server.v1.GetInternalBatchJobRequest
+	(*UpdateBatchJobStateRequest)(nil),          // 19: llmariner.batch.server.v1.UpdateBatchJobStateRequest
+	(*UpdateBatchJobStateResponse)(nil),         // 20: llmariner.batch.server.v1.UpdateBatchJobStateResponse
+	(*UpdateBatchJobStatusReasonRequest)(nil),   // 21: llmariner.batch.server.v1.UpdateBatchJobStatusReasonRequest
+	(*UpdateBatchJobStatusReasonResponse)(nil),  // 22: llmariner.batch.server.v1.UpdateBatchJobStatusReasonResponse
+	(*BatchJob_Error)(nil),                      // 23: llmariner.batch.server.v1.BatchJob.Error
+	(*BatchJob_Resources)(nil),                  // 24: llmariner.batch.server.v1.BatchJob.Resources
+	nil,                                         // 25: llmariner.batch.server.v1.BatchJob.EnvsEntry
+	(*BatchJob_Kind)(nil),                       // 26: llmariner.batch.server.v1.BatchJob.Kind
+	(*BatchJob_Placement)(nil),                  // 27: llmariner.batch.server.v1.BatchJob.Placement
+	nil,                                         // 28: llmariner.batch.server.v1.BatchJob.Placement.ClusterSelectorEntry
+	nil,                                         // 29: llmariner.batch.server.v1.BatchJob.Placement.PreferredClusterSelectorEntry
+	nil,                                         // 30: llmariner.batch.server.v1.CreateBatchJobRequest.ScriptsEntry
+	nil,                                         // 31: llmariner.batch.server.v1.CreateBatchJobRequest.EnvsEntry
+	(*InternalBatchJob_KueueQueue)(nil),         // 32: llmariner.batch.server.v1.InternalBatchJob.KueueQueue
+	nil,                                         // 33: llmariner.batch.server.v1.InternalBatchJob.KueueQueue.ResourceQuotasEntry
 }
 var file_api_v1_batch_service_proto_depIdxs = []int32{
-	16, // 0: llmariner.batch.server.v1.BatchJob.error:type_name -> llmariner.batch.server.v1.BatchJob.Error
-	17, // 1: llmariner.batch.server.v1.BatchJob.resources:type_name -> llmariner.batch.server.v1.BatchJob.Resources
-	18, // 2: llmariner.batch.server.v1.BatchJob.envs:type_name -> llmariner.batch.server.v1.BatchJob.EnvsEntry
-	19, // 3: llmariner.batch.server.v1.BatchJob.kind:type_name -> llmariner.batch.server.v1.BatchJob.Kind
-	20, // 4: llmariner.batch.server.v1.CreateBatchJobRequest.scripts:type_name -> llmariner.batch.server.v1.CreateBatchJobRequest.ScriptsEntry
-	17, // 5: llmariner.batch.server.v1.CreateBatchJobRequest.resources:type_name -> llmariner.batch.server.v1.BatchJob.Resources
-	21, // 6: llmariner.batch.server.v1.CreateBatchJobRequest.envs:type_name -> llmariner.batch.server.v1.CreateBatchJobRequest.EnvsEntry
-	19, // 7: llmariner.batch.server.v1.CreateBatchJobRequest.kind:type_name -> llmariner.batch.server.v1.BatchJob.Kind
-	2,  // 8: llmariner.batch.server.v1.ListBatchJobsResponse.jobs:type_name -> llmariner.batch.server.v1.BatchJob
-	2,  // 9: llmariner.batch.server.v1.InternalBatchJob.job:type_name -> llmariner.batch.server.v1.BatchJob
-	0,  // 10: llmariner.batch.server.v1.InternalBatchJob.state:type_name -> llmariner.batch.server.v1.InternalBatchJob.State
-	1,  // 11: llmariner.batch.server.v1.InternalBatchJob.queued_action:type_name -> llmariner.batch.server.v1.InternalBatchJob.Action
-	10, // 12: llmariner.batch.server.v1.ListQueuedInternalBatchJobsResponse.jobs:type_name -> llmariner.batch.server.v1.InternalBatchJob
-	0,  // 13: llmariner.batch.server.v1.UpdateBatchJobStateRequest.state:type_name -> llmariner.batch.server.v1.InternalBatchJob.State
-	3,  // 14: llmariner.batch.server.v1.BatchJob.Kind.pytorch:type_name -> llmariner.batch.server.v1.PyTorchJob
-	4,  // 15: llmariner.batch.server.v1.BatchService.CreateBatchJob:input_type -> llmariner.batch.server.v1.CreateBatchJobRequest
-	5,  // 16: llmariner.batch.server.v1.BatchService.ListBatchJobs:input_type -> llmariner.batch.server.v1.ListBatchJobsRequest
-	7,  // 17: llmariner.batch.server.v1.BatchService.GetBatchJob:input_type -> llmariner.batch.server.v1.GetBatchJobRequest
-	8,  // 18: llmariner.batch.server.v1.BatchService.CancelBatchJob:input_type -> llmariner.batch.server.v1.CancelBatchJobRequest
-	9,  // 19: llmariner.batch.server.v1.BatchService.DeleteBatchJob:input_type -> llmariner.batch.server.v1.DeleteBatchJobRequest
-	11, // 20: llmariner.batch.server.v1.BatchWorkerService.ListQueuedInternalBatchJobs:input_type -> llmariner.batch.server.v1.ListQueuedInternalBatchJobsRequest
-	13, // 21: llmariner.batch.server.v1.BatchWorkerService.GetInternalBatchJob:input_type -> llmariner.batch.server.v1.GetInternalBatchJobRequest
-	14, // 22: llmariner.batch.server.v1.BatchWorkerService.UpdateBatchJobState:input_type -> llmariner.batch.server.v1.UpdateBatchJobStateRequest
-	2,  // 23: llmariner.batch.server.v1.BatchService.CreateBatchJob:output_type -> llmariner.batch.server.v1.BatchJob
-	6,  // 24: llmariner.batch.server.v1.BatchService.ListBatchJobs:output_type -> llmariner.batch.server.v1.ListBatchJobsResponse
-	2,  // 25: llmariner.batch.server.v1.BatchService.GetBatchJob:output_type -> llmariner.batch.server.v1.BatchJob
-	2,  // 26: llmariner.batch.server.v1.BatchService.CancelBatchJob:output_type -> llmariner.batch.server.v1.BatchJob
-	2,  // 27: llmariner.batch.server.v1.BatchService.DeleteBatchJob:output_type -> llmariner.batch.server.v1.BatchJob
-	12, // 28: llmariner.batch.server.v1.BatchWorkerService.ListQueuedInternalBatchJobs:output_type -> llmariner.batch.server.v1.ListQueuedInternalBatchJobsResponse
-	10, // 29: llmariner.batch.server.v1.BatchWorkerService.GetInternalBatchJob:output_type -> llmariner.batch.server.v1.InternalBatchJob
-	15, // 30: llmariner.batch.server.v1.BatchWorkerService.UpdateBatchJobState:output_type -> llmariner.batch.server.v1.UpdateBatchJobStateResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	23, // 0: llmariner.batch.server.v1.BatchJob.error:type_name -> llmariner.batch.server.v1.BatchJob.Error
+	24, // 1: llmariner.batch.server.v1.BatchJob.resources:type_name -> llmariner.batch.server.v1.BatchJob.Resources
+	25, // 2: llmariner.batch.server.v1.BatchJob.envs:type_name -> llmariner.batch.server.v1.BatchJob.EnvsEntry
+	26, // 3: llmariner.batch.server.v1.BatchJob.kind:type_name -> llmariner.batch.server.v1.BatchJob.Kind
+	27, // 4: llmariner.batch.server.v1.BatchJob.placement:type_name -> llmariner.batch.server.v1.BatchJob.Placement
+	30, // 5: llmariner.batch.server.v1.CreateBatchJobRequest.scripts:type_name -> llmariner.batch.server.v1.CreateBatchJobRequest.ScriptsEntry
+	24, // 6: llmariner.batch.server.v1.CreateBatchJobRequest.resources:type_name -> llmariner.batch.server.v1.BatchJob.Resources
+	31, // 7: llmariner.batch.server.v1.CreateBatchJobRequest.envs:type_name -> llmariner.batch.server.v1.CreateBatchJobRequest.EnvsEntry
+	26, // 8: llmariner.batch.server.v1.CreateBatchJobRequest.kind:type_name -> llmariner.batch.server.v1.BatchJob.Kind
+	27, // 9: llmariner.batch.server.v1.CreateBatchJobRequest.placement:type_name -> llmariner.batch.server.v1.BatchJob.Placement
+	2,  // 10: llmariner.batch.server.v1.ListBatchJobsResponse.jobs:type_name -> llmariner.batch.server.v1.BatchJob
+	10, // 11: llmariner.batch.server.v1.GetBatchJobLogArchivesResponse.archives:type_name -> llmariner.batch.server.v1.BatchJobLogArchive
+	2,  // 12: llmariner.batch.server.v1.InternalBatchJob.job:type_name -> llmariner.batch.server.v1.BatchJob
+	0,  // 13: llmariner.batch.server.v1.InternalBatchJob.state:type_name -> llmariner.batch.server.v1.InternalBatchJob.State
+	1,  // 14: llmariner.batch.server.v1.InternalBatchJob.queued_action:type_name -> llmariner.batch.server.v1.InternalBatchJob.Action
+	32, // 15: llmariner.batch.server.v1.InternalBatchJob.kueue_queue:type_name -> llmariner.batch.server.v1.InternalBatchJob.KueueQueue
+	15, // 16: llmariner.batch.server.v1.ListQueuedInternalBatchJobsResponse.jobs:type_name -> llmariner.batch.server.v1.InternalBatchJob
+	0,  // 17: llmariner.batch.server.v1.UpdateBatchJobStateRequest.state:type_name -> llmariner.batch.server.v1.InternalBatchJob.State
+	3,  // 18: llmariner.batch.server.v1.BatchJob.Kind.pytorch:type_name -> llmariner.batch.server.v1.PyTorchJob
+	28, // 19: llmariner.batch.server.v1.BatchJob.Placement.cluster_selector:type_name -> llmariner.batch.server.v1.BatchJob.Placement.ClusterSelectorEntry
+	29, // 20: llmariner.batch.server.v1.BatchJob.Placement.preferred_cluster_selector:type_name -> llmariner.batch.server.v1.BatchJob.Placement.PreferredClusterSelectorEntry
+	33, // 21: llmariner.batch.server.v1.InternalBatchJob.KueueQueue.resource_quotas:type_name -> llmariner.batch.server.v1.InternalBatchJob.KueueQueue.ResourceQuotasEntry
+	4,  // 22: llmariner.batch.server.v1.BatchService.CreateBatchJob:input_type -> llmariner.batch.server.v1.CreateBatchJobRequest
+	5,  // 23: llmariner.batch.server.v1.BatchService.ListBatchJobs:input_type -> llmariner.batch.server.v1.ListBatchJobsRequest
+	7,  // 24: llmariner.batch.server.v1.BatchService.GetBatchJob:input_type -> llmariner.batch.server.v1.GetBatchJobRequest
+	8,  // 25: llmariner.batch.server.v1.BatchService.CancelBatchJob:input_type -> llmariner.batch.server.v1.CancelBatchJobRequest
+	9,  // 26: llmariner.batch.server.v1.BatchService.DeleteBatchJob:input_type -> llmariner.batch.server.v1.DeleteBatchJobRequest
+	11, // 27: llmariner.batch.server.v1.BatchService.GetBatchJobLogArchives:input_type -> llmariner.batch.server.v1.GetBatchJobLogArchivesRequest
+	13, // 28: llmariner.batch.server.v1.BatchService.StreamBatchJobLogs:input_type -> llmariner.batch.server.v1.StreamBatchJobLogsRequest
+	16, // 29: llmariner.batch.server.v1.BatchWorkerService.ListQueuedInternalBatchJobs:input_type -> llmariner.batch.server.v1.ListQueuedInternalBatchJobsRequest
+	18, // 30: llmariner.batch.server.v1.BatchWorkerService.GetInternalBatchJob:input_type -> llmariner.batch.server.v1.GetInternalBatchJobRequest
+	19, // 31: llmariner.batch.server.v1.BatchWorkerService.UpdateBatchJobState:input_type -> llmariner.batch.server.v1.UpdateBatchJobStateRequest
+	21, // 32: llmariner.batch.server.v1.BatchWorkerService.UpdateBatchJobStatusReason:input_type -> llmariner.batch.server.v1.UpdateBatchJobStatusReasonRequest
+	2,  // 33: llmariner.batch.server.v1.BatchService.CreateBatchJob:output_type -> llmariner.batch.server.v1.BatchJob
+	6,  // 34: llmariner.batch.server.v1.BatchService.ListBatchJobs:output_type -> llmariner.batch.server.v1.ListBatchJobsResponse
+	2,  // 35: llmariner.batch.server.v1.BatchService.GetBatchJob:output_type -> llmariner.batch.server.v1.BatchJob
+	2,  // 36: llmariner.batch.server.v1.BatchService.CancelBatchJob:output_type -> llmariner.batch.server.v1.BatchJob
+	2,  // 37: llmariner.batch.server.v1.BatchService.DeleteBatchJob:output_type -> llmariner.batch.server.v1.BatchJob
+	12, // 38: llmariner.batch.server.v1.BatchService.GetBatchJobLogArchives:output_type -> llmariner.batch.server.v1.GetBatchJobLogArchivesResponse
+	14, // 39: llmariner.batch.server.v1.BatchService.StreamBatchJobLogs:output_type -> llmariner.batch.server.v1.StreamBatchJobLogsResponse
+	17, // 40: llmariner.batch.server.v1.BatchWorkerService.ListQueuedInternalBatchJobs:output_type -> llmariner.batch.server.v1.ListQueuedInternalBatchJobsResponse
+	15, // 41: llmariner.batch.server.v1.BatchWorkerService.GetInternalBatchJob:output_type -> llmariner.batch.server.v1.InternalBatchJob
+	20, // 42: llmariner.batch.server.v1.BatchWorkerService.UpdateBatchJobState:output_type -> llmariner.batch.server.v1.UpdateBatchJobStateResponse
+	22, // 43: llmariner.batch.server.v1.BatchWorkerService.UpdateBatchJobStatusReason:output_type -> llmariner.batch.server.v1.UpdateBatchJobStatusReasonResponse
+	33, // [33:44] is the sub-list for method output_type
+	22, // [22:33] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_batch_service_proto_init() }
@@ -1622,7 +2547,7 @@ func file_api_v1_batch_service_proto_init() {
 			}
 		}
 		file_api_v1_batch_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InternalBatchJob); i {
+			switch v := v.(*BatchJobLogArchive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1634,7 +2559,7 @@ func file_api_v1_batch_service_proto_init() {
 			}
 		}
 		file_api_v1_batch_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListQueuedInternalBatchJobsRequest); i {
+			switch v := v.(*GetBatchJobLogArchivesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1646,7 +2571,7 @@ func file_api_v1_batch_service_proto_init() {
 			}
 		}
 		file_api_v1_batch_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListQueuedInternalBatchJobsResponse); i {
+			switch v := v.(*GetBatchJobLogArchivesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1658,7 +2583,7 @@ func file_api_v1_batch_service_proto_init() {
 			}
 		}
 		file_api_v1_batch_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInternalBatchJobRequest); i {
+			switch v := v.(*StreamBatchJobLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1670,7 +2595,7 @@ func file_api_v1_batch_service_proto_init() {
 			}
 		}
 		file_api_v1_batch_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBatchJobStateRequest); i {
+			switch v := v.(*StreamBatchJobLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1682,7 +2607,7 @@ func file_api_v1_batch_service_proto_init() {
 			}
 		}
 		file_api_v1_batch_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBatchJobStateResponse); i {
+			switch v := v.(*InternalBatchJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1694,7 +2619,7 @@ func file_api_v1_batch_service_proto_init() {
 			}
 		}
 		file_api_v1_batch_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchJob_Error); i {
+			switch v := v.(*ListQueuedInternalBatchJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1706,7 +2631,19 @@ func file_api_v1_batch_service_proto_init() {
 			}
 		}
 		file_api_v1_batch_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchJob_Resources); i {
+			switch v := v.(*ListQueuedInternalBatchJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_batch_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInternalBatchJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1718,6 +2655,78 @@ func file_api_v1_batch_service_proto_init() {
 			}
 		}
 		file_api_v1_batch_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBatchJobStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_batch_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBatchJobStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_batch_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBatchJobStatusReasonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_batch_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBatchJobStatusReasonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_batch_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchJob_Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_batch_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchJob_Resources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_batch_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchJob_Kind); i {
 			case 0:
 				return &v.state
@@ -1729,8 +2738,33 @@ func file_api_v1_batch_service_proto_init() {
 				return nil
 			}
 		}
+		file_api_v1_batch_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchJob_Placement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_batch_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalBatchJob_KueueQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_v1_batch_service_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_api_v1_batch_service_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_api_v1_batch_service_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*BatchJob_Kind_Pytorch)(nil),
 	}
 	type x struct{}
@@ -1739,7 +2773,7 @@ func file_api_v1_batch_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_batch_service_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   20,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
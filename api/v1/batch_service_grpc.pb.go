@@ -23,6 +23,10 @@ type BatchServiceClient interface {
 	GetBatchJob(ctx context.Context, in *GetBatchJobRequest, opts ...grpc.CallOption) (*BatchJob, error)
 	CancelBatchJob(ctx context.Context, in *CancelBatchJobRequest, opts ...grpc.CallOption) (*BatchJob, error)
 	DeleteBatchJob(ctx context.Context, in *DeleteBatchJobRequest, opts ...grpc.CallOption) (*BatchJob, error)
+	// GetBatchJobLogArchives returns the logs of the batch job pods archived after the job finished.
+	GetBatchJobLogArchives(ctx context.Context, in *GetBatchJobLogArchivesRequest, opts ...grpc.CallOption) (*GetBatchJobLogArchivesResponse, error)
+	// StreamBatchJobLogs streams the logs of a running batch job pod from the cluster where the job is scheduled.
+	StreamBatchJobLogs(ctx context.Context, in *StreamBatchJobLogsRequest, opts ...grpc.CallOption) (BatchService_StreamBatchJobLogsClient, error)
 }
 
 type batchServiceClient struct {
@@ -78,6 +82,47 @@ func (c *batchServiceClient) DeleteBatchJob(ctx context.Context, in *DeleteBatch
 	return out, nil
 }
 
+func (c *batchServiceClient) GetBatchJobLogArchives(ctx context.Context, in *GetBatchJobLogArchivesRequest, opts ...grpc.CallOption) (*GetBatchJobLogArchivesResponse, error) {
+	out := new(GetBatchJobLogArchivesResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.batch.server.v1.BatchService/GetBatchJobLogArchives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *batchServiceClient) StreamBatchJobLogs(ctx context.Context, in *StreamBatchJobLogsRequest, opts ...grpc.CallOption) (BatchService_StreamBatchJobLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BatchService_ServiceDesc.Streams[0], "/llmariner.batch.server.v1.BatchService/StreamBatchJobLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &batchServiceStreamBatchJobLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BatchService_StreamBatchJobLogsClient interface {
+	Recv() (*StreamBatchJobLogsResponse, error)
+	grpc.ClientStream
+}
+
+type batchServiceStreamBatchJobLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *batchServiceStreamBatchJobLogsClient) Recv() (*StreamBatchJobLogsResponse, error) {
+	m := new(StreamBatchJobLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BatchServiceServer is the server API for BatchService service.
 // All implementations must embed UnimplementedBatchServiceServer
 // for forward compatibility
@@ -87,6 +132,10 @@ type BatchServiceServer interface {
 	GetBatchJob(context.Context, *GetBatchJobRequest) (*BatchJob, error)
 	CancelBatchJob(context.Context, *CancelBatchJobRequest) (*BatchJob, error)
 	DeleteBatchJob(context.Context, *DeleteBatchJobRequest) (*BatchJob, error)
+	// GetBatchJobLogArchives returns the logs of the batch job pods archived after the job finished.
+	GetBatchJobLogArchives(context.Context, *GetBatchJobLogArchivesRequest) (*GetBatchJobLogArchivesResponse, error)
+	// StreamBatchJobLogs streams the logs of a running batch job pod from the cluster where the job is scheduled.
+	StreamBatchJobLogs(*StreamBatchJobLogsRequest, BatchService_StreamBatchJobLogsServer) error
 	mustEmbedUnimplementedBatchServiceServer()
 }
 
@@ -109,6 +158,12 @@ func (UnimplementedBatchServiceServer) CancelBatchJob(context.Context, *CancelBa
 func (UnimplementedBatchServiceServer) DeleteBatchJob(context.Context, *DeleteBatchJobRequest) (*BatchJob, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBatchJob not implemented")
 }
+func (UnimplementedBatchServiceServer) GetBatchJobLogArchives(context.Context, *GetBatchJobLogArchivesRequest) (*GetBatchJobLogArchivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchJobLogArchives not implemented")
+}
+func (UnimplementedBatchServiceServer) StreamBatchJobLogs(*StreamBatchJobLogsRequest, BatchService_StreamBatchJobLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBatchJobLogs not implemented")
+}
 func (UnimplementedBatchServiceServer) mustEmbedUnimplementedBatchServiceServer() {}
 
 // UnsafeBatchServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -212,6 +267,45 @@ func _BatchService_DeleteBatchJob_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BatchService_GetBatchJobLogArchives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchJobLogArchivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchServiceServer).GetBatchJobLogArchives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.batch.server.v1.BatchService/GetBatchJobLogArchives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchServiceServer).GetBatchJobLogArchives(ctx, req.(*GetBatchJobLogArchivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BatchService_StreamBatchJobLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBatchJobLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BatchServiceServer).StreamBatchJobLogs(m, &batchServiceStreamBatchJobLogsServer{stream})
+}
+
+type BatchService_StreamBatchJobLogsServer interface {
+	Send(*StreamBatchJobLogsResponse) error
+	grpc.ServerStream
+}
+
+type batchServiceStreamBatchJobLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *batchServiceStreamBatchJobLogsServer) Send(m *StreamBatchJobLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // BatchService_ServiceDesc is the grpc.ServiceDesc for BatchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,8 +333,18 @@ var BatchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBatchJob",
 			Handler:    _BatchService_DeleteBatchJob_Handler,
 		},
+		{
+			MethodName: "GetBatchJobLogArchives",
+			Handler:    _BatchService_GetBatchJobLogArchives_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBatchJobLogs",
+			Handler:       _BatchService_StreamBatchJobLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/batch_service.proto",
 }
 
@@ -251,6 +355,8 @@ type BatchWorkerServiceClient interface {
 	ListQueuedInternalBatchJobs(ctx context.Context, in *ListQueuedInternalBatchJobsRequest, opts ...grpc.CallOption) (*ListQueuedInternalBatchJobsResponse, error)
 	GetInternalBatchJob(ctx context.Context, in *GetInternalBatchJobRequest, opts ...grpc.CallOption) (*InternalBatchJob, error)
 	UpdateBatchJobState(ctx context.Context, in *UpdateBatchJobStateRequest, opts ...grpc.CallOption) (*UpdateBatchJobStateResponse, error)
+	// UpdateBatchJobStatusReason updates the reason why the batch job is not making progress.
+	UpdateBatchJobStatusReason(ctx context.Context, in *UpdateBatchJobStatusReasonRequest, opts ...grpc.CallOption) (*UpdateBatchJobStatusReasonResponse, error)
 }
 
 type batchWorkerServiceClient struct {
@@ -288,6 +394,15 @@ func (c *batchWorkerServiceClient) UpdateBatchJobState(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *batchWorkerServiceClient) UpdateBatchJobStatusReason(ctx context.Context, in *UpdateBatchJobStatusReasonRequest, opts ...grpc.CallOption) (*UpdateBatchJobStatusReasonResponse, error) {
+	out := new(UpdateBatchJobStatusReasonResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.batch.server.v1.BatchWorkerService/UpdateBatchJobStatusReason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BatchWorkerServiceServer is the server API for BatchWorkerService service.
 // All implementations must embed UnimplementedBatchWorkerServiceServer
 // for forward compatibility
@@ -295,6 +410,8 @@ type BatchWorkerServiceServer interface {
 	ListQueuedInternalBatchJobs(context.Context, *ListQueuedInternalBatchJobsRequest) (*ListQueuedInternalBatchJobsResponse, error)
 	GetInternalBatchJob(context.Context, *GetInternalBatchJobRequest) (*InternalBatchJob, error)
 	UpdateBatchJobState(context.Context, *UpdateBatchJobStateRequest) (*UpdateBatchJobStateResponse, error)
+	// UpdateBatchJobStatusReason updates the reason why the batch job is not making progress.
+	UpdateBatchJobStatusReason(context.Context, *UpdateBatchJobStatusReasonRequest) (*UpdateBatchJobStatusReasonResponse, error)
 	mustEmbedUnimplementedBatchWorkerServiceServer()
 }
 
@@ -311,6 +428,9 @@ func (UnimplementedBatchWorkerServiceServer) GetInternalBatchJob(context.Context
 func (UnimplementedBatchWorkerServiceServer) UpdateBatchJobState(context.Context, *UpdateBatchJobStateRequest) (*UpdateBatchJobStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatchJobState not implemented")
 }
+func (UnimplementedBatchWorkerServiceServer) UpdateBatchJobStatusReason(context.Context, *UpdateBatchJobStatusReasonRequest) (*UpdateBatchJobStatusReasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatchJobStatusReason not implemented")
+}
 func (UnimplementedBatchWorkerServiceServer) mustEmbedUnimplementedBatchWorkerServiceServer() {}
 
 // UnsafeBatchWorkerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -378,6 +498,24 @@ func _BatchWorkerService_UpdateBatchJobState_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BatchWorkerService_UpdateBatchJobStatusReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBatchJobStatusReasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchWorkerServiceServer).UpdateBatchJobStatusReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.batch.server.v1.BatchWorkerService/UpdateBatchJobStatusReason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchWorkerServiceServer).UpdateBatchJobStatusReason(ctx, req.(*UpdateBatchJobStatusReasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BatchWorkerService_ServiceDesc is the grpc.ServiceDesc for BatchWorkerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -397,6 +535,10 @@ var BatchWorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBatchJobState",
 			Handler:    _BatchWorkerService_UpdateBatchJobState_Handler,
 		},
+		{
+			MethodName: "UpdateBatchJobStatusReason",
+			Handler:    _BatchWorkerService_UpdateBatchJobStatusReason_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/batch_service.proto",
@@ -30,6 +30,7 @@ const (
 	InternalJob_FAILED            InternalJob_State = 3
 	InternalJob_SUCCEEDED         InternalJob_State = 4
 	InternalJob_CANCELED          InternalJob_State = 5
+	InternalJob_REQUEUED          InternalJob_State = 6
 )
 
 // Enum value maps for InternalJob_State.
@@ -41,6 +42,7 @@ var (
 		3: "FAILED",
 		4: "SUCCEEDED",
 		5: "CANCELED",
+		6: "REQUEUED",
 	}
 	InternalJob_State_value = map[string]int32{
 		"STATE_UNSPECIFIED": 0,
@@ -49,6 +51,7 @@ var (
 		"FAILED":            3,
 		"SUCCEEDED":         4,
 		"CANCELED":          5,
+		"REQUEUED":          6,
 	}
 )
 
@@ -76,7 +79,7 @@ func (x InternalJob_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InternalJob_State.Descriptor instead.
 func (InternalJob_State) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{8, 0}
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type InternalJob_Action int32
@@ -85,6 +88,8 @@ const (
 	InternalJob_ACTION_UNSPECIFIED InternalJob_Action = 0
 	InternalJob_CREATING           InternalJob_Action = 1
 	InternalJob_CANCELING          InternalJob_Action = 2
+	// REQUEUEING deletes the job from the cluster so that it is rescheduled to another cluster.
+	InternalJob_REQUEUEING InternalJob_Action = 3
 )
 
 // Enum value maps for InternalJob_Action.
@@ -93,11 +98,13 @@ var (
 		0: "ACTION_UNSPECIFIED",
 		1: "CREATING",
 		2: "CANCELING",
+		3: "REQUEUEING",
 	}
 	InternalJob_Action_value = map[string]int32{
 		"ACTION_UNSPECIFIED": 0,
 		"CREATING":           1,
 		"CANCELING":          2,
+		"REQUEUEING":         3,
 	}
 )
 
@@ -125,7 +132,7 @@ func (x InternalJob_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InternalJob_Action.Descriptor instead.
 func (InternalJob_Action) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{8, 1}
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{13, 1}
 }
 
 type UpdateJobPhaseRequest_Phase int32
@@ -138,6 +145,7 @@ const (
 	UpdateJobPhaseRequest_FAILED            UpdateJobPhaseRequest_Phase = 4
 	UpdateJobPhaseRequest_RECREATE          UpdateJobPhaseRequest_Phase = 5
 	UpdateJobPhaseRequest_CANCELED          UpdateJobPhaseRequest_Phase = 6
+	UpdateJobPhaseRequest_REQUEUED          UpdateJobPhaseRequest_Phase = 7
 )
 
 // Enum value maps for UpdateJobPhaseRequest_Phase.
@@ -150,6 +158,7 @@ var (
 		4: "FAILED",
 		5: "RECREATE",
 		6: "CANCELED",
+		7: "REQUEUED",
 	}
 	UpdateJobPhaseRequest_Phase_value = map[string]int32{
 		"PHASE_UNSPECIFIED": 0,
@@ -159,6 +168,7 @@ var (
 		"FAILED":            4,
 		"RECREATE":          5,
 		"CANCELED":          6,
+		"REQUEUED":          7,
 	}
 )
 
@@ -186,7 +196,7 @@ func (x UpdateJobPhaseRequest_Phase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpdateJobPhaseRequest_Phase.Descriptor instead.
 func (UpdateJobPhaseRequest_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{12, 0}
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type Integration struct {
@@ -332,6 +342,16 @@ type Job struct {
 	ProjectTitle      string         `protobuf:"bytes,21,opt,name=project_title,json=projectTitle,proto3" json:"project_title,omitempty"`
 	ClusterName       string         `protobuf:"bytes,22,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	Resources         *Job_Resources `protobuf:"bytes,23,opt,name=resources,proto3" json:"resources,omitempty"`
+	// status_unknown is true if the cluster where the job runs is unreachable. The status is the last one
+	// reported before the cluster became unreachable.
+	StatusUnknown bool           `protobuf:"varint,25,opt,name=status_unknown,json=statusUnknown,proto3" json:"status_unknown,omitempty"`
+	Placement     *Job_Placement `protobuf:"bytes,26,opt,name=placement,proto3" json:"placement,omitempty"`
+	// last_dispatch_error is the error of the last attempt of the dispatcher to process the queued action of the job.
+	// It is cleared when the action succeeds.
+	LastDispatchError string `protobuf:"bytes,27,opt,name=last_dispatch_error,json=lastDispatchError,proto3" json:"last_dispatch_error,omitempty"`
+	// status_reason describes why the job is not making progress (e.g., "ImagePullBackOff: Back-off pulling image").
+	// It is cleared when the cause is resolved.
+	StatusReason string `protobuf:"bytes,29,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
 }
 
 func (x *Job) Reset() {
@@ -534,6 +554,34 @@ func (x *Job) GetResources() *Job_Resources {
 	return nil
 }
 
+func (x *Job) GetStatusUnknown() bool {
+	if x != nil {
+		return x.StatusUnknown
+	}
+	return false
+}
+
+func (x *Job) GetPlacement() *Job_Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *Job) GetLastDispatchError() string {
+	if x != nil {
+		return x.LastDispatchError
+	}
+	return ""
+}
+
+func (x *Job) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
 type CreateJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -556,7 +604,8 @@ type CreateJobRequest struct {
 	// metadata is not part of the OpenAI API spec, but if the key is "resources.gpu", the value
 	// specifies the number of GPUs allocated to the job. This is a workaround that allows users
 	// to specify the number of GPUs with the OpenAI API Python client.
-	Metadata map[string]string `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Metadata  map[string]string `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Placement *Job_Placement    `protobuf:"bytes,11,opt,name=placement,proto3" json:"placement,omitempty"`
 }
 
 func (x *CreateJobRequest) Reset() {
@@ -661,6 +710,13 @@ func (x *CreateJobRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *CreateJobRequest) GetPlacement() *Job_Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
 type ListJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -885,21 +941,20 @@ func (x *CancelJobRequest) GetId() string {
 	return ""
 }
 
-type InternalJob struct {
+// JobLogArchive is the archived log of a container of a job pod.
+type JobLogArchive struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Job           *Job   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	OutputModelId string `protobuf:"bytes,2,opt,name=output_model_id,json=outputModelId,proto3" json:"output_model_id,omitempty"`
-	Suffix        string `protobuf:"bytes,3,opt,name=suffix,proto3" json:"suffix,omitempty"`
-	// state is also stored in the job object, but this value takes precedence.
-	State        InternalJob_State  `protobuf:"varint,4,opt,name=state,proto3,enum=llmariner.fine_tuning.server.v1.InternalJob_State" json:"state,omitempty"`
-	QueuedAction InternalJob_Action `protobuf:"varint,5,opt,name=queued_action,json=queuedAction,proto3,enum=llmariner.fine_tuning.server.v1.InternalJob_Action" json:"queued_action,omitempty"`
+	PodName       string `protobuf:"bytes,1,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	ContainerName string `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	// chunk_urls are the presigned URLs of the gzip-compressed chunks of the log in order.
+	ChunkUrls []string `protobuf:"bytes,3,rep,name=chunk_urls,json=chunkUrls,proto3" json:"chunk_urls,omitempty"`
 }
 
-func (x *InternalJob) Reset() {
-	*x = InternalJob{}
+func (x *JobLogArchive) Reset() {
+	*x = JobLogArchive{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -907,13 +962,13 @@ func (x *InternalJob) Reset() {
 	}
 }
 
-func (x *InternalJob) String() string {
+func (x *JobLogArchive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalJob) ProtoMessage() {}
+func (*JobLogArchive) ProtoMessage() {}
 
-func (x *InternalJob) ProtoReflect() protoreflect.Message {
+func (x *JobLogArchive) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -925,54 +980,42 @@ func (x *InternalJob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalJob.ProtoReflect.Descriptor instead.
-func (*InternalJob) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobLogArchive.ProtoReflect.Descriptor instead.
+func (*JobLogArchive) Descriptor() ([]byte, []int) {
 	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *InternalJob) GetJob() *Job {
-	if x != nil {
-		return x.Job
-	}
-	return nil
-}
-
-func (x *InternalJob) GetOutputModelId() string {
+func (x *JobLogArchive) GetPodName() string {
 	if x != nil {
-		return x.OutputModelId
+		return x.PodName
 	}
 	return ""
 }
 
-func (x *InternalJob) GetSuffix() string {
+func (x *JobLogArchive) GetContainerName() string {
 	if x != nil {
-		return x.Suffix
+		return x.ContainerName
 	}
 	return ""
 }
 
-func (x *InternalJob) GetState() InternalJob_State {
-	if x != nil {
-		return x.State
-	}
-	return InternalJob_STATE_UNSPECIFIED
-}
-
-func (x *InternalJob) GetQueuedAction() InternalJob_Action {
+func (x *JobLogArchive) GetChunkUrls() []string {
 	if x != nil {
-		return x.QueuedAction
+		return x.ChunkUrls
 	}
-	return InternalJob_ACTION_UNSPECIFIED
+	return nil
 }
 
-type ListQueuedInternalJobsRequest struct {
+type GetJobLogArchivesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListQueuedInternalJobsRequest) Reset() {
-	*x = ListQueuedInternalJobsRequest{}
+func (x *GetJobLogArchivesRequest) Reset() {
+	*x = GetJobLogArchivesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,13 +1023,13 @@ func (x *ListQueuedInternalJobsRequest) Reset() {
 	}
 }
 
-func (x *ListQueuedInternalJobsRequest) String() string {
+func (x *GetJobLogArchivesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuedInternalJobsRequest) ProtoMessage() {}
+func (*GetJobLogArchivesRequest) ProtoMessage() {}
 
-func (x *ListQueuedInternalJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetJobLogArchivesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -998,21 +1041,30 @@ func (x *ListQueuedInternalJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuedInternalJobsRequest.ProtoReflect.Descriptor instead.
-func (*ListQueuedInternalJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetJobLogArchivesRequest.ProtoReflect.Descriptor instead.
+func (*GetJobLogArchivesRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{9}
 }
 
-type ListQueuedInternalJobsResponse struct {
+func (x *GetJobLogArchivesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetJobLogArchivesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Jobs []*InternalJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Archives []*JobLogArchive `protobuf:"bytes,1,rep,name=archives,proto3" json:"archives,omitempty"`
+	// expires_at is the Unix timestamp in seconds when the URLs expire.
+	ExpiresAt int64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *ListQueuedInternalJobsResponse) Reset() {
-	*x = ListQueuedInternalJobsResponse{}
+func (x *GetJobLogArchivesResponse) Reset() {
+	*x = GetJobLogArchivesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1020,13 +1072,13 @@ func (x *ListQueuedInternalJobsResponse) Reset() {
 	}
 }
 
-func (x *ListQueuedInternalJobsResponse) String() string {
+func (x *GetJobLogArchivesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuedInternalJobsResponse) ProtoMessage() {}
+func (*GetJobLogArchivesResponse) ProtoMessage() {}
 
-func (x *ListQueuedInternalJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetJobLogArchivesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1038,28 +1090,43 @@ func (x *ListQueuedInternalJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuedInternalJobsResponse.ProtoReflect.Descriptor instead.
-func (*ListQueuedInternalJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetJobLogArchivesResponse.ProtoReflect.Descriptor instead.
+func (*GetJobLogArchivesResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListQueuedInternalJobsResponse) GetJobs() []*InternalJob {
+func (x *GetJobLogArchivesResponse) GetArchives() []*JobLogArchive {
 	if x != nil {
-		return x.Jobs
+		return x.Archives
 	}
 	return nil
 }
 
-type GetInternalJobRequest struct {
+func (x *GetJobLogArchivesResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type StreamJobLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *GetInternalJobRequest) Reset() {
-	*x = GetInternalJobRequest{}
+	// follow keeps streaming new logs until the container terminates or the request is canceled.
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// tail_lines is the number of the last lines of the logs to stream. All lines are streamed if zero.
+	TailLines int64 `protobuf:"varint,3,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	// container is the name of the container. Defaults to "main".
+	Container string `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+	// rank is the PyTorch rank of the pod whose logs are streamed.
+	Rank int32 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *StreamJobLogsRequest) Reset() {
+	*x = StreamJobLogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1067,13 +1134,13 @@ func (x *GetInternalJobRequest) Reset() {
 	}
 }
 
-func (x *GetInternalJobRequest) String() string {
+func (x *StreamJobLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInternalJobRequest) ProtoMessage() {}
+func (*StreamJobLogsRequest) ProtoMessage() {}
 
-func (x *GetInternalJobRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamJobLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1085,114 +1152,72 @@ func (x *GetInternalJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInternalJobRequest.ProtoReflect.Descriptor instead.
-func (*GetInternalJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamJobLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamJobLogsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetInternalJobRequest) GetId() string {
+func (x *StreamJobLogsRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdateJobPhaseRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id    string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Phase UpdateJobPhaseRequest_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest_Phase" json:"phase,omitempty"`
-	// message describing the details of the job phase. currently only used for failed jobs.
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	// model_id is optional.
-	ModelId string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
-}
-
-func (x *UpdateJobPhaseRequest) Reset() {
-	*x = UpdateJobPhaseRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *UpdateJobPhaseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateJobPhaseRequest) ProtoMessage() {}
-
-func (x *UpdateJobPhaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateJobPhaseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJobPhaseRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *UpdateJobPhaseRequest) GetId() string {
+func (x *StreamJobLogsRequest) GetFollow() bool {
 	if x != nil {
-		return x.Id
+		return x.Follow
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateJobPhaseRequest) GetPhase() UpdateJobPhaseRequest_Phase {
+func (x *StreamJobLogsRequest) GetTailLines() int64 {
 	if x != nil {
-		return x.Phase
+		return x.TailLines
 	}
-	return UpdateJobPhaseRequest_PHASE_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateJobPhaseRequest) GetMessage() string {
+func (x *StreamJobLogsRequest) GetContainer() string {
 	if x != nil {
-		return x.Message
+		return x.Container
 	}
 	return ""
 }
 
-func (x *UpdateJobPhaseRequest) GetModelId() string {
+func (x *StreamJobLogsRequest) GetRank() int32 {
 	if x != nil {
-		return x.ModelId
+		return x.Rank
 	}
-	return ""
+	return 0
 }
 
-type UpdateJobPhaseResponse struct {
+type StreamJobLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// data is a chunk of the logs.
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *UpdateJobPhaseResponse) Reset() {
-	*x = UpdateJobPhaseResponse{}
+func (x *StreamJobLogsResponse) Reset() {
+	*x = StreamJobLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[13]
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateJobPhaseResponse) String() string {
+func (x *StreamJobLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobPhaseResponse) ProtoMessage() {}
+func (*StreamJobLogsResponse) ProtoMessage() {}
 
-func (x *UpdateJobPhaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[13]
+func (x *StreamJobLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,39 +1228,49 @@ func (x *UpdateJobPhaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobPhaseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateJobPhaseResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use StreamJobLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamJobLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{12}
 }
 
-type Integration_Wandb struct {
+func (x *StreamJobLogsResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type InternalJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Project string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Entity  string   `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
-	Tags    []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Job           *Job   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	OutputModelId string `protobuf:"bytes,2,opt,name=output_model_id,json=outputModelId,proto3" json:"output_model_id,omitempty"`
+	Suffix        string `protobuf:"bytes,3,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	// state is also stored in the job object, but this value takes precedence.
+	State        InternalJob_State       `protobuf:"varint,4,opt,name=state,proto3,enum=llmariner.fine_tuning.server.v1.InternalJob_State" json:"state,omitempty"`
+	QueuedAction InternalJob_Action      `protobuf:"varint,5,opt,name=queued_action,json=queuedAction,proto3,enum=llmariner.fine_tuning.server.v1.InternalJob_Action" json:"queued_action,omitempty"`
+	KueueQueue   *InternalJob_KueueQueue `protobuf:"bytes,6,opt,name=kueue_queue,json=kueueQueue,proto3" json:"kueue_queue,omitempty"`
 }
 
-func (x *Integration_Wandb) Reset() {
-	*x = Integration_Wandb{}
+func (x *InternalJob) Reset() {
+	*x = InternalJob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[14]
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Integration_Wandb) String() string {
+func (x *InternalJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Integration_Wandb) ProtoMessage() {}
+func (*InternalJob) ProtoMessage() {}
 
-func (x *Integration_Wandb) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[14]
+func (x *InternalJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,67 +1281,507 @@ func (x *Integration_Wandb) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Integration_Wandb.ProtoReflect.Descriptor instead.
-func (*Integration_Wandb) Descriptor() ([]byte, []int) {
-	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use InternalJob.ProtoReflect.Descriptor instead.
+func (*InternalJob) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Integration_Wandb) GetProject() string {
+func (x *InternalJob) GetJob() *Job {
 	if x != nil {
-		return x.Project
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-func (x *Integration_Wandb) GetName() string {
+func (x *InternalJob) GetOutputModelId() string {
 	if x != nil {
-		return x.Name
+		return x.OutputModelId
 	}
 	return ""
 }
 
-func (x *Integration_Wandb) GetEntity() string {
+func (x *InternalJob) GetSuffix() string {
 	if x != nil {
-		return x.Entity
+		return x.Suffix
 	}
 	return ""
 }
 
-func (x *Integration_Wandb) GetTags() []string {
+func (x *InternalJob) GetState() InternalJob_State {
 	if x != nil {
-		return x.Tags
+		return x.State
+	}
+	return InternalJob_STATE_UNSPECIFIED
+}
+
+func (x *InternalJob) GetQueuedAction() InternalJob_Action {
+	if x != nil {
+		return x.QueuedAction
+	}
+	return InternalJob_ACTION_UNSPECIFIED
+}
+
+func (x *InternalJob) GetKueueQueue() *InternalJob_KueueQueue {
+	if x != nil {
+		return x.KueueQueue
 	}
 	return nil
 }
 
-type FineTuningJobMethod_Hyperparameters struct {
+type ListQueuedInternalJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	BatchSize              int32   `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	Beta                   float64 `protobuf:"fixed64,2,opt,name=beta,proto3" json:"beta,omitempty"`
-	LearningRateMultiplier float64 `protobuf:"fixed64,3,opt,name=learning_rate_multiplier,json=learningRateMultiplier,proto3" json:"learning_rate_multiplier,omitempty"`
-	NEpochs                int32   `protobuf:"varint,4,opt,name=n_epochs,json=nEpochs,proto3" json:"n_epochs,omitempty"`
 }
 
-func (x *FineTuningJobMethod_Hyperparameters) Reset() {
-	*x = FineTuningJobMethod_Hyperparameters{}
+func (x *ListQueuedInternalJobsRequest) Reset() {
+	*x = ListQueuedInternalJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[15]
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FineTuningJobMethod_Hyperparameters) String() string {
+func (x *ListQueuedInternalJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedInternalJobsRequest) ProtoMessage() {}
+
+func (x *ListQueuedInternalJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuedInternalJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuedInternalJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{14}
+}
+
+type ListQueuedInternalJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*InternalJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListQueuedInternalJobsResponse) Reset() {
+	*x = ListQueuedInternalJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQueuedInternalJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedInternalJobsResponse) ProtoMessage() {}
+
+func (x *ListQueuedInternalJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuedInternalJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuedInternalJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListQueuedInternalJobsResponse) GetJobs() []*InternalJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type GetInternalJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetInternalJobRequest) Reset() {
+	*x = GetInternalJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInternalJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInternalJobRequest) ProtoMessage() {}
+
+func (x *GetInternalJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInternalJobRequest.ProtoReflect.Descriptor instead.
+func (*GetInternalJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetInternalJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateJobPhaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Phase UpdateJobPhaseRequest_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest_Phase" json:"phase,omitempty"`
+	// message describing the details of the job phase. currently only used for failed jobs.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// model_id is optional.
+	ModelId string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// error_code classifies the failure (e.g., "oom_killed", "user_error"). currently only used for failed jobs.
+	ErrorCode string `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// exit_code is the exit code of the failed container. It is set only when error_code is "user_error".
+	ExitCode int32 `protobuf:"varint,6,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+}
+
+func (x *UpdateJobPhaseRequest) Reset() {
+	*x = UpdateJobPhaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJobPhaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobPhaseRequest) ProtoMessage() {}
+
+func (x *UpdateJobPhaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobPhaseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobPhaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateJobPhaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateJobPhaseRequest) GetPhase() UpdateJobPhaseRequest_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return UpdateJobPhaseRequest_PHASE_UNSPECIFIED
+}
+
+func (x *UpdateJobPhaseRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateJobPhaseRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *UpdateJobPhaseRequest) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *UpdateJobPhaseRequest) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type UpdateJobPhaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateJobPhaseResponse) Reset() {
+	*x = UpdateJobPhaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJobPhaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobPhaseResponse) ProtoMessage() {}
+
+func (x *UpdateJobPhaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobPhaseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJobPhaseResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{18}
+}
+
+type UpdateJobStatusReasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status_reason is cleared if empty.
+	StatusReason string `protobuf:"bytes,2,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+}
+
+func (x *UpdateJobStatusReasonRequest) Reset() {
+	*x = UpdateJobStatusReasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJobStatusReasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobStatusReasonRequest) ProtoMessage() {}
+
+func (x *UpdateJobStatusReasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobStatusReasonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobStatusReasonRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateJobStatusReasonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateJobStatusReasonRequest) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+type UpdateJobStatusReasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateJobStatusReasonResponse) Reset() {
+	*x = UpdateJobStatusReasonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJobStatusReasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobStatusReasonResponse) ProtoMessage() {}
+
+func (x *UpdateJobStatusReasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobStatusReasonResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJobStatusReasonResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{20}
+}
+
+type Integration_Wandb struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Entity  string   `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	Tags    []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *Integration_Wandb) Reset() {
+	*x = Integration_Wandb{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Integration_Wandb) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Integration_Wandb) ProtoMessage() {}
+
+func (x *Integration_Wandb) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Integration_Wandb.ProtoReflect.Descriptor instead.
+func (*Integration_Wandb) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Integration_Wandb) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Integration_Wandb) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Integration_Wandb) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *Integration_Wandb) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type FineTuningJobMethod_Hyperparameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchSize              int32   `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Beta                   float64 `protobuf:"fixed64,2,opt,name=beta,proto3" json:"beta,omitempty"`
+	LearningRateMultiplier float64 `protobuf:"fixed64,3,opt,name=learning_rate_multiplier,json=learningRateMultiplier,proto3" json:"learning_rate_multiplier,omitempty"`
+	NEpochs                int32   `protobuf:"varint,4,opt,name=n_epochs,json=nEpochs,proto3" json:"n_epochs,omitempty"`
+}
+
+func (x *FineTuningJobMethod_Hyperparameters) Reset() {
+	*x = FineTuningJobMethod_Hyperparameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FineTuningJobMethod_Hyperparameters) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*FineTuningJobMethod_Hyperparameters) ProtoMessage() {}
 
 func (x *FineTuningJobMethod_Hyperparameters) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[15]
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,12 +1833,14 @@ type Job_Error struct {
 	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Param   string `protobuf:"bytes,3,opt,name=param,proto3" json:"param,omitempty"`
+	// exit_code is the exit code of the failed container. It is set only when code is "user_error".
+	ExitCode int32 `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
 }
 
 func (x *Job_Error) Reset() {
 	*x = Job_Error{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[16]
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1376,7 +1853,7 @@ func (x *Job_Error) String() string {
 func (*Job_Error) ProtoMessage() {}
 
 func (x *Job_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[16]
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,6 +1890,13 @@ func (x *Job_Error) GetParam() string {
 	return ""
 }
 
+func (x *Job_Error) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
 type Job_Hyperparameters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1429,7 +1913,7 @@ type Job_Hyperparameters struct {
 func (x *Job_Hyperparameters) Reset() {
 	*x = Job_Hyperparameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[17]
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1442,7 +1926,7 @@ func (x *Job_Hyperparameters) String() string {
 func (*Job_Hyperparameters) ProtoMessage() {}
 
 func (x *Job_Hyperparameters) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[17]
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1485,25 +1969,134 @@ type Job_Resources struct {
 	unknownFields protoimpl.UnknownFields
 
 	GpuCount int32 `protobuf:"varint,1,opt,name=gpu_count,json=gpuCount,proto3" json:"gpu_count,omitempty"`
+	// accelerator_type is the Kubernetes resource name of the accelerator (e.g., "nvidia.com/gpu",
+	// "amd.com/gpu", "habana.ai/gaudi", "aws.amazon.com/neuron"). NVIDIA GPUs are used if not specified.
+	AcceleratorType string `protobuf:"bytes,2,opt,name=accelerator_type,json=acceleratorType,proto3" json:"accelerator_type,omitempty"`
+	// gpu_type is the GPU product name reported by NVIDIA GPU Feature Discovery (e.g., "NVIDIA-H100-80GB-HBM3").
+	// If specified, the workload runs only on the nodes that have the GPU product.
+	GpuType string `protobuf:"bytes,3,opt,name=gpu_type,json=gpuType,proto3" json:"gpu_type,omitempty"`
+	// min_gpu_memory_megabytes is the minimum memory size of each GPU in MiB.
+	MinGpuMemoryMegabytes int32 `protobuf:"varint,4,opt,name=min_gpu_memory_megabytes,json=minGpuMemoryMegabytes,proto3" json:"min_gpu_memory_megabytes,omitempty"`
+	// cpu_milicore and memory_megabytes are the CPU and memory requested by the job.
+	CpuMilicore     int32 `protobuf:"varint,5,opt,name=cpu_milicore,json=cpuMilicore,proto3" json:"cpu_milicore,omitempty"`
+	MemoryMegabytes int32 `protobuf:"varint,6,opt,name=memory_megabytes,json=memoryMegabytes,proto3" json:"memory_megabytes,omitempty"`
 }
 
 func (x *Job_Resources) Reset() {
 	*x = Job_Resources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[18]
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job_Resources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job_Resources) ProtoMessage() {}
+
+func (x *Job_Resources) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job_Resources.ProtoReflect.Descriptor instead.
+func (*Job_Resources) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Job_Resources) GetGpuCount() int32 {
+	if x != nil {
+		return x.GpuCount
+	}
+	return 0
+}
+
+func (x *Job_Resources) GetAcceleratorType() string {
+	if x != nil {
+		return x.AcceleratorType
+	}
+	return ""
+}
+
+func (x *Job_Resources) GetGpuType() string {
+	if x != nil {
+		return x.GpuType
+	}
+	return ""
+}
+
+func (x *Job_Resources) GetMinGpuMemoryMegabytes() int32 {
+	if x != nil {
+		return x.MinGpuMemoryMegabytes
+	}
+	return 0
+}
+
+func (x *Job_Resources) GetCpuMilicore() int32 {
+	if x != nil {
+		return x.CpuMilicore
+	}
+	return 0
+}
+
+func (x *Job_Resources) GetMemoryMegabytes() int32 {
+	if x != nil {
+		return x.MemoryMegabytes
+	}
+	return 0
+}
+
+// Placement specifies the clusters where a job can run. Clusters are specified by their IDs or names,
+// and all of them must be assigned to the project.
+type Job_Placement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// required_clusters are the clusters where the job must run. If not empty, the job runs only
+	// in one of the clusters.
+	RequiredClusters []string `protobuf:"bytes,1,rep,name=required_clusters,json=requiredClusters,proto3" json:"required_clusters,omitempty"`
+	// preferred_clusters are the clusters where the job preferably runs. Other clusters are used only
+	// when none of the preferred clusters can run the job.
+	PreferredClusters []string `protobuf:"bytes,2,rep,name=preferred_clusters,json=preferredClusters,proto3" json:"preferred_clusters,omitempty"`
+	// cluster_selector is the labels that the cluster must have.
+	ClusterSelector map[string]string `protobuf:"bytes,3,rep,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// region is the region where the job must run. This is a shorthand of the "region" label in cluster_selector.
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// preferred_cluster_selector is the labels of the clusters where the job preferably runs.
+	PreferredClusterSelector map[string]string `protobuf:"bytes,5,rep,name=preferred_cluster_selector,json=preferredClusterSelector,proto3" json:"preferred_cluster_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// namespace is the Kubernetes namespace where the job runs. It must be one of the namespaces assigned to
+	// the project. If empty, the first namespace assigned to the project in the cluster is used.
+	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *Job_Placement) Reset() {
+	*x = Job_Placement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Job_Resources) String() string {
+func (x *Job_Placement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Job_Resources) ProtoMessage() {}
+func (*Job_Placement) ProtoMessage() {}
 
-func (x *Job_Resources) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[18]
+func (x *Job_Placement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,16 +2107,51 @@ func (x *Job_Resources) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Job_Resources.ProtoReflect.Descriptor instead.
-func (*Job_Resources) Descriptor() ([]byte, []int) {
-	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{2, 2}
+// Deprecated: Use Job_Placement.ProtoReflect.Descriptor instead.
+func (*Job_Placement) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{2, 3}
 }
 
-func (x *Job_Resources) GetGpuCount() int32 {
+func (x *Job_Placement) GetRequiredClusters() []string {
 	if x != nil {
-		return x.GpuCount
+		return x.RequiredClusters
 	}
-	return 0
+	return nil
+}
+
+func (x *Job_Placement) GetPreferredClusters() []string {
+	if x != nil {
+		return x.PreferredClusters
+	}
+	return nil
+}
+
+func (x *Job_Placement) GetClusterSelector() map[string]string {
+	if x != nil {
+		return x.ClusterSelector
+	}
+	return nil
+}
+
+func (x *Job_Placement) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Job_Placement) GetPreferredClusterSelector() map[string]string {
+	if x != nil {
+		return x.PreferredClusterSelector
+	}
+	return nil
+}
+
+func (x *Job_Placement) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
 }
 
 type CreateJobRequest_Hyperparameters struct {
@@ -1542,7 +2170,7 @@ type CreateJobRequest_Hyperparameters struct {
 func (x *CreateJobRequest_Hyperparameters) Reset() {
 	*x = CreateJobRequest_Hyperparameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[19]
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1555,7 +2183,7 @@ func (x *CreateJobRequest_Hyperparameters) String() string {
 func (*CreateJobRequest_Hyperparameters) ProtoMessage() {}
 
 func (x *CreateJobRequest_Hyperparameters) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[19]
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,6 +2220,66 @@ func (x *CreateJobRequest_Hyperparameters) GetNEpochs() int32 {
 	return 0
 }
 
+// KueueQueue is the Kueue queue of the project that the job is submitted to. It is resolved from the current
+// configuration of the server when the dispatcher gets the job.
+type InternalJob_KueueQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the LocalQueue. The dispatcher uses the queue configured for the namespace if empty.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// resource_quotas are the nominal quotas of the ClusterQueue that the dispatcher provisions for the namespace
+	// (e.g., "nvidia.com/gpu": "8"). The quotas are ignored unless the dispatcher provisions the queues.
+	ResourceQuotas map[string]string `protobuf:"bytes,2,rep,name=resource_quotas,json=resourceQuotas,proto3" json:"resource_quotas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *InternalJob_KueueQueue) Reset() {
+	*x = InternalJob_KueueQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_fine_tuning_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InternalJob_KueueQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalJob_KueueQueue) ProtoMessage() {}
+
+func (x *InternalJob_KueueQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_fine_tuning_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalJob_KueueQueue.ProtoReflect.Descriptor instead.
+func (*InternalJob_KueueQueue) Descriptor() ([]byte, []int) {
+	return file_api_v1_fine_tuning_service_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *InternalJob_KueueQueue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalJob_KueueQueue) GetResourceQuotas() map[string]string {
+	if x != nil {
+		return x.ResourceQuotas
+	}
+	return nil
+}
+
 var File_api_v1_fine_tuning_service_proto protoreflect.FileDescriptor
 
 var file_api_v1_fine_tuning_service_proto_rawDesc = []byte{
@@ -1633,7 +2321,7 @@ var file_api_v1_fine_tuning_service_proto_rawDesc = []byte{
 	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
 	0x67, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
 	0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0x91, 0x0a, 0x0a, 0x03, 0x4a,
+	0x05, 0x52, 0x07, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xfa, 0x11, 0x0a, 0x03, 0x4a,
 	0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
@@ -1698,219 +2386,382 @@ var file_api_v1_fine_tuning_service_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
 	0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a,
-	0x4b, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x85, 0x01, 0x0a,
-	0x0f, 0x48, 0x79, 0x70, 0x65, 0x72, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x38, 0x0a, 0x18, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x16, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x45, 0x70,
-	0x6f, 0x63, 0x68, 0x73, 0x1a, 0x28, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9f,
-	0x06, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x6b,
-	0x0a, 0x0f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x4c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x1a, 0x68, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x1a, 0x85, 0x01, 0x0a, 0x0f, 0x48, 0x79, 0x70, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x1a, 0xf5, 0x01, 0x0a, 0x09,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x70,
+	0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x70, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x70, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x18,
+	0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x70, 0x75, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d,
+	0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15,
+	0x6d, 0x69, 0x6e, 0x47, 0x70, 0x75, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x67, 0x61,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x69, 0x6c,
+	0x69, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x70, 0x75,
+	0x4d, 0x69, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x67, 0x61, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x1a, 0xab, 0x04, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x6e, 0x0a,
+	0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
 	0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0f, 0x68, 0x79, 0x70, 0x65,
-	0x72, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x06, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6c, 0x6c,
-	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
-	0x6e, 0x65, 0x54, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66,
-	0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69,
-	0x78, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x0c, 0x69, 0x6e,
-	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e,
-	0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64,
-	0x12, 0x4c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
-	0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x5b,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x3f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e,
-	0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x85, 0x01, 0x0a, 0x0f,
-	0x48, 0x79, 0x70, 0x65, 0x72, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x38,
-	0x0a, 0x18, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x16, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x3d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0xa0, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x8a, 0x01, 0x0a, 0x1a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4c, 0x2e, 0x6c, 0x6c, 0x6d,
 	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69,
 	0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f,
-	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xca, 0x03, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x36, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12,
-	0x26, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69,
-	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12,
-	0x48, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32,
-	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f,
-	0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e,
+	0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x1a, 0x42, 0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x1d, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x4a, 0x04, 0x08, 0x1c, 0x10, 0x1d, 0x22, 0xed, 0x06, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x6b, 0x0a, 0x0f, 0x68, 0x79, 0x70, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x41, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e,
 	0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12,
-	0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43,
-	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45,
-	0x4c, 0x45, 0x44, 0x10, 0x05, 0x22, 0x3d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x45, 0x41, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x49,
-	0x4e, 0x47, 0x10, 0x02, 0x22, 0x1f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x62, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
-	0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0xaa, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
-	0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x52, 0x0a, 0x05,
-	0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x6c, 0x6c,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x0f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x75, 0x6e, 0x69, 0x6e,
+	0x67, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
+	0x6f, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x1a, 0x85, 0x01, 0x0a, 0x0f, 0x48, 0x79, 0x70, 0x65, 0x72, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69,
+	0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a,
+	0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x70,
+	0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x55, 0x72, 0x6c, 0x73,
+	0x22, 0x2a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x86, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
+	0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x08, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x69, 0x6c,
+	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x2b, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x9e, 0x06, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x36, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69,
+	0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x26, 0x0a, 0x0f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x48, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6c, 0x6c,
 	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x15,
-	0x0a, 0x11, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x45, 0x50, 0x52, 0x4f, 0x43,
-	0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x43,
-	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49, 0x4e, 0x45,
-	0x54, 0x55, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10,
-	0x05, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x22,
-	0x18, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc0, 0x04, 0x0a, 0x11, 0x46, 0x69,
-	0x6e, 0x65, 0x54, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x85, 0x01, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x31, 0x2e,
+	0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e,
 	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74,
 	0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e,
-	0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01,
-	0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69,
-	0x6e, 0x67, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x8d, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74,
-	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x58, 0x0a, 0x0b, 0x6b, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x4a, 0x6f, 0x62, 0x2e, 0x4b, 0x75, 0x65, 0x75, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x0a,
+	0x6b, 0x75, 0x65, 0x75, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x1a, 0xd9, 0x01, 0x0a, 0x0a, 0x4b,
+	0x75, 0x65, 0x75, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x74, 0x0a,
+	0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
 	0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69,
-	0x6e, 0x67, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x12, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66,
-	0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4a, 0x6f, 0x62, 0x2e, 0x4b, 0x75, 0x65, 0x75, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x55, 0x45, 0x44, 0x10, 0x06, 0x22, 0x4d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x51, 0x55, 0x45, 0x55, 0x45,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x22, 0x1f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x62, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xf5, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x52, 0x0a,
+	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x45, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53,
+	0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49, 0x4e, 0x45, 0x54, 0x55,
+	0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0c, 0x0a,
+	0x08, 0x52, 0x45, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x07, 0x22, 0x18, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x1d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa8, 0x07, 0x0a, 0x11,
+	0x46, 0x69, 0x6e, 0x65, 0x54, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x85, 0x01, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12,
+	0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65,
+	0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66,
 	0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e,
-	0x67, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8e, 0x01, 0x0a, 0x09,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
-	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c,
-	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
-	0x6f, 0x62, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f,
-	0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x6f, 0x62, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x32, 0xb1, 0x03, 0x0a,
-	0x17, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73,
-	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a,
-	0x6f, 0x62, 0x73, 0x12, 0x3e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
-	0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
-	0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75,
+	0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x8d, 0x01, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
 	0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75,
+	0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x06, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x12, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e,
+	0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8e, 0x01,
+	0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x31, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
 	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f,
 	0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x81, 0x01, 0x0a,
-	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12,
-	0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65,
+	0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x20, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x6f,
+	0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0xba,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x73, 0x12, 0x39, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65,
 	0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
-	0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2f, 0x6a, 0x6f, 0x62, 0x2d, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75,
+	0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6c,
+	0x6f, 0x67, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0xa8, 0x01, 0x0a, 0x0d,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x35, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74,
+	0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x6f, 0x62,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74,
+	0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x6c, 0x6f, 0x67, 0x73, 0x30, 0x01, 0x32, 0xca, 0x04, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x65, 0x54,
+	0x75, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x3e, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74,
+	0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74,
+	0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62,
+	0x12, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e,
+	0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x81, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x66, 0x69,
+	0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x15, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2f, 0x6a, 0x6f, 0x62, 0x2d,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1926,7 +2777,7 @@ func file_api_v1_fine_tuning_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_fine_tuning_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_v1_fine_tuning_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_api_v1_fine_tuning_service_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_api_v1_fine_tuning_service_proto_goTypes = []interface{}{
 	(InternalJob_State)(0),                      // 0: llmariner.fine_tuning.server.v1.InternalJob.State
 	(InternalJob_Action)(0),                     // 1: llmariner.fine_tuning.server.v1.InternalJob.Action
@@ -1939,58 +2790,83 @@ var file_api_v1_fine_tuning_service_proto_goTypes = []interface{}{
 	(*ListJobsResponse)(nil),                    // 8: llmariner.fine_tuning.server.v1.ListJobsResponse
 	(*GetJobRequest)(nil),                       // 9: llmariner.fine_tuning.server.v1.GetJobRequest
 	(*CancelJobRequest)(nil),                    // 10: llmariner.fine_tuning.server.v1.CancelJobRequest
-	(*InternalJob)(nil),                         // 11: llmariner.fine_tuning.server.v1.InternalJob
-	(*ListQueuedInternalJobsRequest)(nil),       // 12: llmariner.fine_tuning.server.v1.ListQueuedInternalJobsRequest
-	(*ListQueuedInternalJobsResponse)(nil),      // 13: llmariner.fine_tuning.server.v1.ListQueuedInternalJobsResponse
-	(*GetInternalJobRequest)(nil),               // 14: llmariner.fine_tuning.server.v1.GetInternalJobRequest
-	(*UpdateJobPhaseRequest)(nil),               // 15: llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest
-	(*UpdateJobPhaseResponse)(nil),              // 16: llmariner.fine_tuning.server.v1.UpdateJobPhaseResponse
-	(*Integration_Wandb)(nil),                   // 17: llmariner.fine_tuning.server.v1.Integration.Wandb
-	(*FineTuningJobMethod_Hyperparameters)(nil), // 18: llmariner.fine_tuning.server.v1.FineTuningJobMethod.Hyperparameters
-	(*Job_Error)(nil),                           // 19: llmariner.fine_tuning.server.v1.Job.Error
-	(*Job_Hyperparameters)(nil),                 // 20: llmariner.fine_tuning.server.v1.Job.Hyperparameters
-	(*Job_Resources)(nil),                       // 21: llmariner.fine_tuning.server.v1.Job.Resources
-	(*CreateJobRequest_Hyperparameters)(nil),    // 22: llmariner.fine_tuning.server.v1.CreateJobRequest.Hyperparameters
-	nil,                                         // 23: llmariner.fine_tuning.server.v1.CreateJobRequest.MetadataEntry
+	(*JobLogArchive)(nil),                       // 11: llmariner.fine_tuning.server.v1.JobLogArchive
+	(*GetJobLogArchivesRequest)(nil),            // 12: llmariner.fine_tuning.server.v1.GetJobLogArchivesRequest
+	(*GetJobLogArchivesResponse)(nil),           // 13: llmariner.fine_tuning.server.v1.GetJobLogArchivesResponse
+	(*StreamJobLogsRequest)(nil),                // 14: llmariner.fine_tuning.server.v1.StreamJobLogsRequest
+	(*StreamJobLogsResponse)(nil),               // 15: llmariner.fine_tuning.server.v1.StreamJobLogsResponse
+	(*InternalJob)(nil),                         // 16: llmariner.fine_tuning.server.v1.InternalJob
+	(*ListQueuedInternalJobsRequest)(nil),       // 17: llmariner.fine_tuning.server.v1.ListQueuedInternalJobsRequest
+	(*ListQueuedInternalJobsResponse)(nil),      // 18: llmariner.fine_tuning.server.v1.ListQueuedInternalJobsResponse
+	(*GetInternalJobRequest)(nil),               // 19: llmariner.fine_tuning.server.v1.GetInternalJobRequest
+	(*UpdateJobPhaseRequest)(nil),               // 20: llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest
+	(*UpdateJobPhaseResponse)(nil),              // 21: llmariner.fine_tuning.server.v1.UpdateJobPhaseResponse
+	(*UpdateJobStatusReasonRequest)(nil),        // 22: llmariner.fine_tuning.server.v1.UpdateJobStatusReasonRequest
+	(*UpdateJobStatusReasonResponse)(nil),       // 23: llmariner.fine_tuning.server.v1.UpdateJobStatusReasonResponse
+	(*Integration_Wandb)(nil),                   // 24: llmariner.fine_tuning.server.v1.Integration.Wandb
+	(*FineTuningJobMethod_Hyperparameters)(nil), // 25: llmariner.fine_tuning.server.v1.FineTuningJobMethod.Hyperparameters
+	(*Job_Error)(nil),                           // 26: llmariner.fine_tuning.server.v1.Job.Error
+	(*Job_Hyperparameters)(nil),                 // 27: llmariner.fine_tuning.server.v1.Job.Hyperparameters
+	(*Job_Resources)(nil),                       // 28: llmariner.fine_tuning.server.v1.Job.Resources
+	(*Job_Placement)(nil),                       // 29: llmariner.fine_tuning.server.v1.Job.Placement
+	nil,                                         // 30: llmariner.fine_tuning.server.v1.Job.Placement.ClusterSelectorEntry
+	nil,                                         // 31: llmariner.fine_tuning.server.v1.Job.Placement.PreferredClusterSelectorEntry
+	(*CreateJobRequest_Hyperparameters)(nil),    // 32: llmariner.fine_tuning.server.v1.CreateJobRequest.Hyperparameters
+	nil,                                         // 33: llmariner.fine_tuning.server.v1.CreateJobRequest.MetadataEntry
+	(*InternalJob_KueueQueue)(nil),              // 34: llmariner.fine_tuning.server.v1.InternalJob.KueueQueue
+	nil,                                         // 35: llmariner.fine_tuning.server.v1.InternalJob.KueueQueue.ResourceQuotasEntry
 }
 var file_api_v1_fine_tuning_service_proto_depIdxs = []int32{
-	17, // 0: llmariner.fine_tuning.server.v1.Integration.wandb:type_name -> llmariner.fine_tuning.server.v1.Integration.Wandb
-	18, // 1: llmariner.fine_tuning.server.v1.FineTuningJobMethod.hyperparameters:type_name -> llmariner.fine_tuning.server.v1.FineTuningJobMethod.Hyperparameters
-	19, // 2: llmariner.fine_tuning.server.v1.Job.error:type_name -> llmariner.fine_tuning.server.v1.Job.Error
-	20, // 3: llmariner.fine_tuning.server.v1.Job.hyperparameters:type_name -> llmariner.fine_tuning.server.v1.Job.Hyperparameters
+	24, // 0: llmariner.fine_tuning.server.v1.Integration.wandb:type_name -> llmariner.fine_tuning.server.v1.Integration.Wandb
+	25, // 1: llmariner.fine_tuning.server.v1.FineTuningJobMethod.hyperparameters:type_name -> llmariner.fine_tuning.server.v1.FineTuningJobMethod.Hyperparameters
+	26, // 2: llmariner.fine_tuning.server.v1.Job.error:type_name -> llmariner.fine_tuning.server.v1.Job.Error
+	27, // 3: llmariner.fine_tuning.server.v1.Job.hyperparameters:type_name -> llmariner.fine_tuning.server.v1.Job.Hyperparameters
 	4,  // 4: llmariner.fine_tuning.server.v1.Job.method:type_name -> llmariner.fine_tuning.server.v1.FineTuningJobMethod
 	3,  // 5: llmariner.fine_tuning.server.v1.Job.integrations:type_name -> llmariner.fine_tuning.server.v1.Integration
-	21, // 6: llmariner.fine_tuning.server.v1.Job.resources:type_name -> llmariner.fine_tuning.server.v1.Job.Resources
-	22, // 7: llmariner.fine_tuning.server.v1.CreateJobRequest.hyperparameters:type_name -> llmariner.fine_tuning.server.v1.CreateJobRequest.Hyperparameters
-	4,  // 8: llmariner.fine_tuning.server.v1.CreateJobRequest.method:type_name -> llmariner.fine_tuning.server.v1.FineTuningJobMethod
-	3,  // 9: llmariner.fine_tuning.server.v1.CreateJobRequest.integrations:type_name -> llmariner.fine_tuning.server.v1.Integration
-	21, // 10: llmariner.fine_tuning.server.v1.CreateJobRequest.resources:type_name -> llmariner.fine_tuning.server.v1.Job.Resources
-	23, // 11: llmariner.fine_tuning.server.v1.CreateJobRequest.metadata:type_name -> llmariner.fine_tuning.server.v1.CreateJobRequest.MetadataEntry
-	5,  // 12: llmariner.fine_tuning.server.v1.ListJobsResponse.data:type_name -> llmariner.fine_tuning.server.v1.Job
-	5,  // 13: llmariner.fine_tuning.server.v1.InternalJob.job:type_name -> llmariner.fine_tuning.server.v1.Job
-	0,  // 14: llmariner.fine_tuning.server.v1.InternalJob.state:type_name -> llmariner.fine_tuning.server.v1.InternalJob.State
-	1,  // 15: llmariner.fine_tuning.server.v1.InternalJob.queued_action:type_name -> llmariner.fine_tuning.server.v1.InternalJob.Action
-	11, // 16: llmariner.fine_tuning.server.v1.ListQueuedInternalJobsResponse.jobs:type_name -> llmariner.fine_tuning.server.v1.InternalJob
-	2,  // 17: llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest.phase:type_name -> llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest.Phase
-	6,  // 18: llmariner.fine_tuning.server.v1.FineTuningService.CreateJob:input_type -> llmariner.fine_tuning.server.v1.CreateJobRequest
-	7,  // 19: llmariner.fine_tuning.server.v1.FineTuningService.ListJobs:input_type -> llmariner.fine_tuning.server.v1.ListJobsRequest
-	9,  // 20: llmariner.fine_tuning.server.v1.FineTuningService.GetJob:input_type -> llmariner.fine_tuning.server.v1.GetJobRequest
-	10, // 21: llmariner.fine_tuning.server.v1.FineTuningService.CancelJob:input_type -> llmariner.fine_tuning.server.v1.CancelJobRequest
-	12, // 22: llmariner.fine_tuning.server.v1.FineTuningWorkerService.ListQueuedInternalJobs:input_type -> llmariner.fine_tuning.server.v1.ListQueuedInternalJobsRequest
-	14, // 23: llmariner.fine_tuning.server.v1.FineTuningWorkerService.GetInternalJob:input_type -> llmariner.fine_tuning.server.v1.GetInternalJobRequest
-	15, // 24: llmariner.fine_tuning.server.v1.FineTuningWorkerService.UpdateJobPhase:input_type -> llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest
-	5,  // 25: llmariner.fine_tuning.server.v1.FineTuningService.CreateJob:output_type -> llmariner.fine_tuning.server.v1.Job
-	8,  // 26: llmariner.fine_tuning.server.v1.FineTuningService.ListJobs:output_type -> llmariner.fine_tuning.server.v1.ListJobsResponse
-	5,  // 27: llmariner.fine_tuning.server.v1.FineTuningService.GetJob:output_type -> llmariner.fine_tuning.server.v1.Job
-	5,  // 28: llmariner.fine_tuning.server.v1.FineTuningService.CancelJob:output_type -> llmariner.fine_tuning.server.v1.Job
-	13, // 29: llmariner.fine_tuning.server.v1.FineTuningWorkerService.ListQueuedInternalJobs:output_type -> llmariner.fine_tuning.server.v1.ListQueuedInternalJobsResponse
-	11, // 30: llmariner.fine_tuning.server.v1.FineTuningWorkerService.GetInternalJob:output_type -> llmariner.fine_tuning.server.v1.InternalJob
-	16, // 31: llmariner.fine_tuning.server.v1.FineTuningWorkerService.UpdateJobPhase:output_type -> llmariner.fine_tuning.server.v1.UpdateJobPhaseResponse
-	25, // [25:32] is the sub-list for method output_type
-	18, // [18:25] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	28, // 6: llmariner.fine_tuning.server.v1.Job.resources:type_name -> llmariner.fine_tuning.server.v1.Job.Resources
+	29, // 7: llmariner.fine_tuning.server.v1.Job.placement:type_name -> llmariner.fine_tuning.server.v1.Job.Placement
+	32, // 8: llmariner.fine_tuning.server.v1.CreateJobRequest.hyperparameters:type_name -> llmariner.fine_tuning.server.v1.CreateJobRequest.Hyperparameters
+	4,  // 9: llmariner.fine_tuning.server.v1.CreateJobRequest.method:type_name -> llmariner.fine_tuning.server.v1.FineTuningJobMethod
+	3,  // 10: llmariner.fine_tuning.server.v1.CreateJobRequest.integrations:type_name -> llmariner.fine_tuning.server.v1.Integration
+	28, // 11: llmariner.fine_tuning.server.v1.CreateJobRequest.resources:type_name -> llmariner.fine_tuning.server.v1.Job.Resources
+	33, // 12: llmariner.fine_tuning.server.v1.CreateJobRequest.metadata:type_name -> llmariner.fine_tuning.server.v1.CreateJobRequest.MetadataEntry
+	29, // 13: llmariner.fine_tuning.server.v1.CreateJobRequest.placement:type_name -> llmariner.fine_tuning.server.v1.Job.Placement
+	5,  // 14: llmariner.fine_tuning.server.v1.ListJobsResponse.data:type_name -> llmariner.fine_tuning.server.v1.Job
+	11, // 15: llmariner.fine_tuning.server.v1.GetJobLogArchivesResponse.archives:type_name -> llmariner.fine_tuning.server.v1.JobLogArchive
+	5,  // 16: llmariner.fine_tuning.server.v1.InternalJob.job:type_name -> llmariner.fine_tuning.server.v1.Job
+	0,  // 17: llmariner.fine_tuning.server.v1.InternalJob.state:type_name -> llmariner.fine_tuning.server.v1.InternalJob.State
+	1,  // 18: llmariner.fine_tuning.server.v1.InternalJob.queued_action:type_name -> llmariner.fine_tuning.server.v1.InternalJob.Action
+	34, // 19: llmariner.fine_tuning.server.v1.InternalJob.kueue_queue:type_name -> llmariner.fine_tuning.server.v1.InternalJob.KueueQueue
+	16, // 20: llmariner.fine_tuning.server.v1.ListQueuedInternalJobsResponse.jobs:type_name -> llmariner.fine_tuning.server.v1.InternalJob
+	2,  // 21: llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest.phase:type_name -> llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest.Phase
+	30, // 22: llmariner.fine_tuning.server.v1.Job.Placement.cluster_selector:type_name -> llmariner.fine_tuning.server.v1.Job.Placement.ClusterSelectorEntry
+	31, // 23: llmariner.fine_tuning.server.v1.Job.Placement.preferred_cluster_selector:type_name -> llmariner.fine_tuning.server.v1.Job.Placement.PreferredClusterSelectorEntry
+	35, // 24: llmariner.fine_tuning.server.v1.InternalJob.KueueQueue.resource_quotas:type_name -> llmariner.fine_tuning.server.v1.InternalJob.KueueQueue.ResourceQuotasEntry
+	6,  // 25: llmariner.fine_tuning.server.v1.FineTuningService.CreateJob:input_type -> llmariner.fine_tuning.server.v1.CreateJobRequest
+	7,  // 26: llmariner.fine_tuning.server.v1.FineTuningService.ListJobs:input_type -> llmariner.fine_tuning.server.v1.ListJobsRequest
+	9,  // 27: llmariner.fine_tuning.server.v1.FineTuningService.GetJob:input_type -> llmariner.fine_tuning.server.v1.GetJobRequest
+	10, // 28: llmariner.fine_tuning.server.v1.FineTuningService.CancelJob:input_type -> llmariner.fine_tuning.server.v1.CancelJobRequest
+	12, // 29: llmariner.fine_tuning.server.v1.FineTuningService.GetJobLogArchives:input_type -> llmariner.fine_tuning.server.v1.GetJobLogArchivesRequest
+	14, // 30: llmariner.fine_tuning.server.v1.FineTuningService.StreamJobLogs:input_type -> llmariner.fine_tuning.server.v1.StreamJobLogsRequest
+	17, // 31: llmariner.fine_tuning.server.v1.FineTuningWorkerService.ListQueuedInternalJobs:input_type -> llmariner.fine_tuning.server.v1.ListQueuedInternalJobsRequest
+	19, // 32: llmariner.fine_tuning.server.v1.FineTuningWorkerService.GetInternalJob:input_type -> llmariner.fine_tuning.server.v1.GetInternalJobRequest
+	20, // 33: llmariner.fine_tuning.server.v1.FineTuningWorkerService.UpdateJobPhase:input_type -> llmariner.fine_tuning.server.v1.UpdateJobPhaseRequest
+	22, // 34: llmariner.fine_tuning.server.v1.FineTuningWorkerService.UpdateJobStatusReason:input_type -> llmariner.fine_tuning.server.v1.UpdateJobStatusReasonRequest
+	5,  // 35: llmariner.fine_tuning.server.v1.FineTuningService.CreateJob:output_type -> llmariner.fine_tuning.server.v1.Job
+	8,  // 36: llmariner.fine_tuning.server.v1.FineTuningService.ListJobs:output_type -> llmariner.fine_tuning.server.v1.ListJobsResponse
+	5,  // 37: llmariner.fine_tuning.server.v1.FineTuningService.GetJob:output_type -> llmariner.fine_tuning.server.v1.Job
+	5,  // 38: llmariner.fine_tuning.server.v1.FineTuningService.CancelJob:output_type -> llmariner.fine_tuning.server.v1.Job
+	13, // 39: llmariner.fine_tuning.server.v1.FineTuningService.GetJobLogArchives:output_type -> llmariner.fine_tuning.server.v1.GetJobLogArchivesResponse
+	15, // 40: llmariner.fine_tuning.server.v1.FineTuningService.StreamJobLogs:output_type -> llmariner.fine_tuning.server.v1.StreamJobLogsResponse
+	18, // 41: llmariner.fine_tuning.server.v1.FineTuningWorkerService.ListQueuedInternalJobs:output_type -> llmariner.fine_tuning.server.v1.ListQueuedInternalJobsResponse
+	16, // 42: llmariner.fine_tuning.server.v1.FineTuningWorkerService.GetInternalJob:output_type -> llmariner.fine_tuning.server.v1.InternalJob
+	21, // 43: llmariner.fine_tuning.server.v1.FineTuningWorkerService.UpdateJobPhase:output_type -> llmariner.fine_tuning.server.v1.UpdateJobPhaseResponse
+	23, // 44: llmariner.fine_tuning.server.v1.FineTuningWorkerService.UpdateJobStatusReason:output_type -> llmariner.fine_tuning.server.v1.UpdateJobStatusReasonResponse
+	35, // [35:45] is the sub-list for method output_type
+	25, // [25:35] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_fine_tuning_service_proto_init() }
@@ -2096,7 +2972,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InternalJob); i {
+			switch v := v.(*JobLogArchive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2108,7 +2984,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListQueuedInternalJobsRequest); i {
+			switch v := v.(*GetJobLogArchivesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2120,7 +2996,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListQueuedInternalJobsResponse); i {
+			switch v := v.(*GetJobLogArchivesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2132,7 +3008,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInternalJobRequest); i {
+			switch v := v.(*StreamJobLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2144,7 +3020,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateJobPhaseRequest); i {
+			switch v := v.(*StreamJobLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2156,7 +3032,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateJobPhaseResponse); i {
+			switch v := v.(*InternalJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2168,7 +3044,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Integration_Wandb); i {
+			switch v := v.(*ListQueuedInternalJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2180,7 +3056,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FineTuningJobMethod_Hyperparameters); i {
+			switch v := v.(*ListQueuedInternalJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2192,7 +3068,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Job_Error); i {
+			switch v := v.(*GetInternalJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2204,7 +3080,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Job_Hyperparameters); i {
+			switch v := v.(*UpdateJobPhaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2216,7 +3092,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Job_Resources); i {
+			switch v := v.(*UpdateJobPhaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2228,6 +3104,102 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			}
 		}
 		file_api_v1_fine_tuning_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobStatusReasonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_fine_tuning_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobStatusReasonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_fine_tuning_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Integration_Wandb); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_fine_tuning_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FineTuningJobMethod_Hyperparameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_fine_tuning_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job_Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_fine_tuning_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job_Hyperparameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_fine_tuning_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job_Resources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_fine_tuning_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job_Placement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_fine_tuning_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateJobRequest_Hyperparameters); i {
 			case 0:
 				return &v.state
@@ -2239,6 +3211,18 @@ func file_api_v1_fine_tuning_service_proto_init() {
 				return nil
 			}
 		}
+		file_api_v1_fine_tuning_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalJob_KueueQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2246,7 +3230,7 @@ func file_api_v1_fine_tuning_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_fine_tuning_service_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   21,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
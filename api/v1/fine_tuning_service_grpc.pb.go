@@ -22,6 +22,10 @@ type FineTuningServiceClient interface {
 	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
 	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error)
 	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// GetJobLogArchives returns the logs of the job pods archived after the job finished.
+	GetJobLogArchives(ctx context.Context, in *GetJobLogArchivesRequest, opts ...grpc.CallOption) (*GetJobLogArchivesResponse, error)
+	// StreamJobLogs streams the logs of a running job pod from the cluster where the job is scheduled.
+	StreamJobLogs(ctx context.Context, in *StreamJobLogsRequest, opts ...grpc.CallOption) (FineTuningService_StreamJobLogsClient, error)
 }
 
 type fineTuningServiceClient struct {
@@ -68,6 +72,47 @@ func (c *fineTuningServiceClient) CancelJob(ctx context.Context, in *CancelJobRe
 	return out, nil
 }
 
+func (c *fineTuningServiceClient) GetJobLogArchives(ctx context.Context, in *GetJobLogArchivesRequest, opts ...grpc.CallOption) (*GetJobLogArchivesResponse, error) {
+	out := new(GetJobLogArchivesResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.fine_tuning.server.v1.FineTuningService/GetJobLogArchives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineTuningServiceClient) StreamJobLogs(ctx context.Context, in *StreamJobLogsRequest, opts ...grpc.CallOption) (FineTuningService_StreamJobLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FineTuningService_ServiceDesc.Streams[0], "/llmariner.fine_tuning.server.v1.FineTuningService/StreamJobLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fineTuningServiceStreamJobLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FineTuningService_StreamJobLogsClient interface {
+	Recv() (*StreamJobLogsResponse, error)
+	grpc.ClientStream
+}
+
+type fineTuningServiceStreamJobLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *fineTuningServiceStreamJobLogsClient) Recv() (*StreamJobLogsResponse, error) {
+	m := new(StreamJobLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FineTuningServiceServer is the server API for FineTuningService service.
 // All implementations must embed UnimplementedFineTuningServiceServer
 // for forward compatibility
@@ -76,6 +121,10 @@ type FineTuningServiceServer interface {
 	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
 	GetJob(context.Context, *GetJobRequest) (*Job, error)
 	CancelJob(context.Context, *CancelJobRequest) (*Job, error)
+	// GetJobLogArchives returns the logs of the job pods archived after the job finished.
+	GetJobLogArchives(context.Context, *GetJobLogArchivesRequest) (*GetJobLogArchivesResponse, error)
+	// StreamJobLogs streams the logs of a running job pod from the cluster where the job is scheduled.
+	StreamJobLogs(*StreamJobLogsRequest, FineTuningService_StreamJobLogsServer) error
 	mustEmbedUnimplementedFineTuningServiceServer()
 }
 
@@ -95,6 +144,12 @@ func (UnimplementedFineTuningServiceServer) GetJob(context.Context, *GetJobReque
 func (UnimplementedFineTuningServiceServer) CancelJob(context.Context, *CancelJobRequest) (*Job, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
 }
+func (UnimplementedFineTuningServiceServer) GetJobLogArchives(context.Context, *GetJobLogArchivesRequest) (*GetJobLogArchivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobLogArchives not implemented")
+}
+func (UnimplementedFineTuningServiceServer) StreamJobLogs(*StreamJobLogsRequest, FineTuningService_StreamJobLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamJobLogs not implemented")
+}
 func (UnimplementedFineTuningServiceServer) mustEmbedUnimplementedFineTuningServiceServer() {}
 
 // UnsafeFineTuningServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +235,45 @@ func _FineTuningService_CancelJob_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FineTuningService_GetJobLogArchives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobLogArchivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineTuningServiceServer).GetJobLogArchives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.fine_tuning.server.v1.FineTuningService/GetJobLogArchives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineTuningServiceServer).GetJobLogArchives(ctx, req.(*GetJobLogArchivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineTuningService_StreamJobLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamJobLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FineTuningServiceServer).StreamJobLogs(m, &fineTuningServiceStreamJobLogsServer{stream})
+}
+
+type FineTuningService_StreamJobLogsServer interface {
+	Send(*StreamJobLogsResponse) error
+	grpc.ServerStream
+}
+
+type fineTuningServiceStreamJobLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *fineTuningServiceStreamJobLogsServer) Send(m *StreamJobLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // FineTuningService_ServiceDesc is the grpc.ServiceDesc for FineTuningService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,8 +297,18 @@ var FineTuningService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelJob",
 			Handler:    _FineTuningService_CancelJob_Handler,
 		},
+		{
+			MethodName: "GetJobLogArchives",
+			Handler:    _FineTuningService_GetJobLogArchives_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamJobLogs",
+			Handler:       _FineTuningService_StreamJobLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/fine_tuning_service.proto",
 }
 
@@ -216,6 +320,8 @@ type FineTuningWorkerServiceClient interface {
 	GetInternalJob(ctx context.Context, in *GetInternalJobRequest, opts ...grpc.CallOption) (*InternalJob, error)
 	// UpdateJobPhase updates the job status depending on the phase.
 	UpdateJobPhase(ctx context.Context, in *UpdateJobPhaseRequest, opts ...grpc.CallOption) (*UpdateJobPhaseResponse, error)
+	// UpdateJobStatusReason updates the reason why the job is not making progress.
+	UpdateJobStatusReason(ctx context.Context, in *UpdateJobStatusReasonRequest, opts ...grpc.CallOption) (*UpdateJobStatusReasonResponse, error)
 }
 
 type fineTuningWorkerServiceClient struct {
@@ -253,6 +359,15 @@ func (c *fineTuningWorkerServiceClient) UpdateJobPhase(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *fineTuningWorkerServiceClient) UpdateJobStatusReason(ctx context.Context, in *UpdateJobStatusReasonRequest, opts ...grpc.CallOption) (*UpdateJobStatusReasonResponse, error) {
+	out := new(UpdateJobStatusReasonResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.fine_tuning.server.v1.FineTuningWorkerService/UpdateJobStatusReason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FineTuningWorkerServiceServer is the server API for FineTuningWorkerService service.
 // All implementations must embed UnimplementedFineTuningWorkerServiceServer
 // for forward compatibility
@@ -261,6 +376,8 @@ type FineTuningWorkerServiceServer interface {
 	GetInternalJob(context.Context, *GetInternalJobRequest) (*InternalJob, error)
 	// UpdateJobPhase updates the job status depending on the phase.
 	UpdateJobPhase(context.Context, *UpdateJobPhaseRequest) (*UpdateJobPhaseResponse, error)
+	// UpdateJobStatusReason updates the reason why the job is not making progress.
+	UpdateJobStatusReason(context.Context, *UpdateJobStatusReasonRequest) (*UpdateJobStatusReasonResponse, error)
 	mustEmbedUnimplementedFineTuningWorkerServiceServer()
 }
 
@@ -277,6 +394,9 @@ func (UnimplementedFineTuningWorkerServiceServer) GetInternalJob(context.Context
 func (UnimplementedFineTuningWorkerServiceServer) UpdateJobPhase(context.Context, *UpdateJobPhaseRequest) (*UpdateJobPhaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobPhase not implemented")
 }
+func (UnimplementedFineTuningWorkerServiceServer) UpdateJobStatusReason(context.Context, *UpdateJobStatusReasonRequest) (*UpdateJobStatusReasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobStatusReason not implemented")
+}
 func (UnimplementedFineTuningWorkerServiceServer) mustEmbedUnimplementedFineTuningWorkerServiceServer() {
 }
 
@@ -345,6 +465,24 @@ func _FineTuningWorkerService_UpdateJobPhase_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FineTuningWorkerService_UpdateJobStatusReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobStatusReasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineTuningWorkerServiceServer).UpdateJobStatusReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.fine_tuning.server.v1.FineTuningWorkerService/UpdateJobStatusReason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineTuningWorkerServiceServer).UpdateJobStatusReason(ctx, req.(*UpdateJobStatusReasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FineTuningWorkerService_ServiceDesc is the grpc.ServiceDesc for FineTuningWorkerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -364,6 +502,10 @@ var FineTuningWorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateJobPhase",
 			Handler:    _FineTuningWorkerService_UpdateJobPhase_Handler,
 		},
+		{
+			MethodName: "UpdateJobStatusReason",
+			Handler:    _FineTuningWorkerService_UpdateJobStatusReason_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/fine_tuning_service.proto",
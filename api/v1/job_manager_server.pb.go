@@ -22,6 +22,117 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ClusterHealthState int32
+
+const (
+	ClusterHealthState_CLUSTER_HEALTH_STATE_UNSPECIFIED ClusterHealthState = 0
+	ClusterHealthState_CLUSTER_HEALTH_STATE_HEALTHY     ClusterHealthState = 1
+	// CLUSTER_HEALTH_STATE_DEGRADED indicates that the cluster status has not been updated recently.
+	ClusterHealthState_CLUSTER_HEALTH_STATE_DEGRADED ClusterHealthState = 2
+	// CLUSTER_HEALTH_STATE_UNREACHABLE indicates that the cluster status has not been updated for a long time.
+	// The cluster is excluded from scheduling.
+	ClusterHealthState_CLUSTER_HEALTH_STATE_UNREACHABLE ClusterHealthState = 3
+)
+
+// Enum value maps for ClusterHealthState.
+var (
+	ClusterHealthState_name = map[int32]string{
+		0: "CLUSTER_HEALTH_STATE_UNSPECIFIED",
+		1: "CLUSTER_HEALTH_STATE_HEALTHY",
+		2: "CLUSTER_HEALTH_STATE_DEGRADED",
+		3: "CLUSTER_HEALTH_STATE_UNREACHABLE",
+	}
+	ClusterHealthState_value = map[string]int32{
+		"CLUSTER_HEALTH_STATE_UNSPECIFIED": 0,
+		"CLUSTER_HEALTH_STATE_HEALTHY":     1,
+		"CLUSTER_HEALTH_STATE_DEGRADED":    2,
+		"CLUSTER_HEALTH_STATE_UNREACHABLE": 3,
+	}
+)
+
+func (x ClusterHealthState) Enum() *ClusterHealthState {
+	p := new(ClusterHealthState)
+	*p = x
+	return p
+}
+
+func (x ClusterHealthState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClusterHealthState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_job_manager_server_proto_enumTypes[0].Descriptor()
+}
+
+func (ClusterHealthState) Type() protoreflect.EnumType {
+	return &file_api_v1_job_manager_server_proto_enumTypes[0]
+}
+
+func (x ClusterHealthState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClusterHealthState.Descriptor instead.
+func (ClusterHealthState) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{0}
+}
+
+// JobDrainPolicy specifies how batch jobs and fine-tuning jobs are handled when a cluster is drained.
+type JobDrainPolicy int32
+
+const (
+	JobDrainPolicy_JOB_DRAIN_POLICY_UNSPECIFIED JobDrainPolicy = 0
+	// JOB_DRAIN_POLICY_WAIT waits for the jobs to complete. This is the default.
+	JobDrainPolicy_JOB_DRAIN_POLICY_WAIT JobDrainPolicy = 1
+	// JOB_DRAIN_POLICY_CANCEL cancels the jobs.
+	JobDrainPolicy_JOB_DRAIN_POLICY_CANCEL JobDrainPolicy = 2
+	// JOB_DRAIN_POLICY_REQUEUE requeues the jobs to other clusters. The jobs are restarted from the beginning.
+	JobDrainPolicy_JOB_DRAIN_POLICY_REQUEUE JobDrainPolicy = 3
+)
+
+// Enum value maps for JobDrainPolicy.
+var (
+	JobDrainPolicy_name = map[int32]string{
+		0: "JOB_DRAIN_POLICY_UNSPECIFIED",
+		1: "JOB_DRAIN_POLICY_WAIT",
+		2: "JOB_DRAIN_POLICY_CANCEL",
+		3: "JOB_DRAIN_POLICY_REQUEUE",
+	}
+	JobDrainPolicy_value = map[string]int32{
+		"JOB_DRAIN_POLICY_UNSPECIFIED": 0,
+		"JOB_DRAIN_POLICY_WAIT":        1,
+		"JOB_DRAIN_POLICY_CANCEL":      2,
+		"JOB_DRAIN_POLICY_REQUEUE":     3,
+	}
+)
+
+func (x JobDrainPolicy) Enum() *JobDrainPolicy {
+	p := new(JobDrainPolicy)
+	*p = x
+	return p
+}
+
+func (x JobDrainPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobDrainPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_job_manager_server_proto_enumTypes[1].Descriptor()
+}
+
+func (JobDrainPolicy) Type() protoreflect.EnumType {
+	return &file_api_v1_job_manager_server_proto_enumTypes[1]
+}
+
+func (x JobDrainPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobDrainPolicy.Descriptor instead.
+func (JobDrainPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{1}
+}
+
 type JobType int32
 
 const (
@@ -58,11 +169,11 @@ func (x JobType) String() string {
 }
 
 func (JobType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_job_manager_server_proto_enumTypes[0].Descriptor()
+	return file_api_v1_job_manager_server_proto_enumTypes[2].Descriptor()
 }
 
 func (JobType) Type() protoreflect.EnumType {
-	return &file_api_v1_job_manager_server_proto_enumTypes[0]
+	return &file_api_v1_job_manager_server_proto_enumTypes[2]
 }
 
 func (x JobType) Number() protoreflect.EnumNumber {
@@ -71,7 +182,7 @@ func (x JobType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JobType.Descriptor instead.
 func (JobType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{0}
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{2}
 }
 
 type Cluster struct {
@@ -84,26 +195,565 @@ type Cluster struct {
 	Status  *ClusterStatus   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	Summary *Cluster_Summary `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
 	// last_updated_at is the last time the cluster was updated in Unix nano seconds.
-	LastUpdatedAt int64 `protobuf:"varint,5,opt,name=last_updated_at,json=lastUpdatedAt,proto3" json:"last_updated_at,omitempty"`
+	LastUpdatedAt  int64                    `protobuf:"varint,5,opt,name=last_updated_at,json=lastUpdatedAt,proto3" json:"last_updated_at,omitempty"`
+	UnhealthyNodes []*Cluster_UnhealthyNode `protobuf:"bytes,6,rep,name=unhealthy_nodes,json=unhealthyNodes,proto3" json:"unhealthy_nodes,omitempty"`
+	// cordoned is true if the cluster is excluded from scheduling.
+	Cordoned     bool   `protobuf:"varint,7,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
+	CordonReason string `protobuf:"bytes,8,opt,name=cordon_reason,json=cordonReason,proto3" json:"cordon_reason,omitempty"`
+	// draining is true if the workloads in the cluster are being moved out of the cluster.
+	Draining bool `protobuf:"varint,9,opt,name=draining,proto3" json:"draining,omitempty"`
+	// drain_status is set when the cluster is draining.
+	DrainStatus        *DrainStatus         `protobuf:"bytes,10,opt,name=drain_status,json=drainStatus,proto3" json:"drain_status,omitempty"`
+	MaintenanceWindows []*MaintenanceWindow `protobuf:"bytes,11,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+	// health_state is determined by the last time the cluster status was updated.
+	HealthState ClusterHealthState `protobuf:"varint,12,opt,name=health_state,json=healthState,proto3,enum=llmariner.jobs.server.v1.ClusterHealthState" json:"health_state,omitempty"`
+	// labels are the labels of the cluster (e.g., "region", "provider", "network-fabric", "cost-tier",
+	// "compliance-zone"). They are the labels reported by the dispatcher overridden by the labels set
+	// by administrators.
+	Labels map[string]string `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Cluster) Reset() {
+	*x = Cluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cluster) ProtoMessage() {}
+
+func (x *Cluster) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cluster.ProtoReflect.Descriptor instead.
+func (*Cluster) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cluster) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Cluster) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Cluster) GetStatus() *ClusterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Cluster) GetSummary() *Cluster_Summary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *Cluster) GetLastUpdatedAt() int64 {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return 0
+}
+
+func (x *Cluster) GetUnhealthyNodes() []*Cluster_UnhealthyNode {
+	if x != nil {
+		return x.UnhealthyNodes
+	}
+	return nil
+}
+
+func (x *Cluster) GetCordoned() bool {
+	if x != nil {
+		return x.Cordoned
+	}
+	return false
+}
+
+func (x *Cluster) GetCordonReason() string {
+	if x != nil {
+		return x.CordonReason
+	}
+	return ""
+}
+
+func (x *Cluster) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *Cluster) GetDrainStatus() *DrainStatus {
+	if x != nil {
+		return x.DrainStatus
+	}
+	return nil
+}
+
+func (x *Cluster) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+func (x *Cluster) GetHealthState() ClusterHealthState {
+	if x != nil {
+		return x.HealthState
+	}
+	return ClusterHealthState_CLUSTER_HEALTH_STATE_UNSPECIFIED
+}
+
+func (x *Cluster) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// DrainStatus is the numbers of the workloads that remain in a draining cluster.
+type DrainStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotebookCount      int32 `protobuf:"varint,1,opt,name=notebook_count,json=notebookCount,proto3" json:"notebook_count,omitempty"`
+	BatchJobCount      int32 `protobuf:"varint,2,opt,name=batch_job_count,json=batchJobCount,proto3" json:"batch_job_count,omitempty"`
+	FineTuningJobCount int32 `protobuf:"varint,3,opt,name=fine_tuning_job_count,json=fineTuningJobCount,proto3" json:"fine_tuning_job_count,omitempty"`
+}
+
+func (x *DrainStatus) Reset() {
+	*x = DrainStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainStatus) ProtoMessage() {}
+
+func (x *DrainStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainStatus.ProtoReflect.Descriptor instead.
+func (*DrainStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DrainStatus) GetNotebookCount() int32 {
+	if x != nil {
+		return x.NotebookCount
+	}
+	return 0
+}
+
+func (x *DrainStatus) GetBatchJobCount() int32 {
+	if x != nil {
+		return x.BatchJobCount
+	}
+	return 0
+}
+
+func (x *DrainStatus) GetFineTuningJobCount() int32 {
+	if x != nil {
+		return x.FineTuningJobCount
+	}
+	return 0
+}
+
+// MaintenanceWindow is a period when a cluster is excluded from scheduling.
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// start_time and end_time are Unix timestamps in seconds.
+	StartTime int64  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListClustersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListClustersRequest) Reset() {
+	*x = ListClustersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersRequest) ProtoMessage() {}
+
+func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListClustersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{3}
+}
+
+type ListClustersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clusters []*Cluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (x *ListClustersResponse) Reset() {
+	*x = ListClustersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersResponse) ProtoMessage() {}
+
+func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListClustersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListClustersResponse) GetClusters() []*Cluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type CordonClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CordonClusterRequest) Reset() {
+	*x = CordonClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CordonClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CordonClusterRequest) ProtoMessage() {}
+
+func (x *CordonClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CordonClusterRequest.ProtoReflect.Descriptor instead.
+func (*CordonClusterRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CordonClusterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CordonClusterRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UncordonClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UncordonClusterRequest) Reset() {
+	*x = UncordonClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UncordonClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UncordonClusterRequest) ProtoMessage() {}
+
+func (x *UncordonClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UncordonClusterRequest.ProtoReflect.Descriptor instead.
+func (*UncordonClusterRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UncordonClusterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DrainClusterRequest is the request to drain a cluster. The cluster is cordoned, and notebooks
+// in the cluster are requeued to other clusters.
+type DrainClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason    string         `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	JobPolicy JobDrainPolicy `protobuf:"varint,3,opt,name=job_policy,json=jobPolicy,proto3,enum=llmariner.jobs.server.v1.JobDrainPolicy" json:"job_policy,omitempty"`
+}
+
+func (x *DrainClusterRequest) Reset() {
+	*x = DrainClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainClusterRequest) ProtoMessage() {}
+
+func (x *DrainClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainClusterRequest.ProtoReflect.Descriptor instead.
+func (*DrainClusterRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DrainClusterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DrainClusterRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DrainClusterRequest) GetJobPolicy() JobDrainPolicy {
+	if x != nil {
+		return x.JobPolicy
+	}
+	return JobDrainPolicy_JOB_DRAIN_POLICY_UNSPECIFIED
+}
+
+type CreateMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// start_time and end_time are Unix timestamps in seconds.
+	StartTime int64  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *Cluster) Reset() {
-	*x = Cluster{}
+func (x *CreateMaintenanceWindowRequest) Reset() {
+	*x = CreateMaintenanceWindowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_proto_msgTypes[0]
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Cluster) String() string {
+func (x *CreateMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Cluster) ProtoMessage() {}
+func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *Cluster) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_proto_msgTypes[0]
+func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,69 +764,65 @@ func (x *Cluster) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Cluster.ProtoReflect.Descriptor instead.
-func (*Cluster) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Cluster) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Cluster) GetName() string {
+func (x *CreateMaintenanceWindowRequest) GetClusterId() string {
 	if x != nil {
-		return x.Name
+		return x.ClusterId
 	}
 	return ""
 }
 
-func (x *Cluster) GetStatus() *ClusterStatus {
+func (x *CreateMaintenanceWindowRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.Status
+		return x.StartTime
 	}
-	return nil
+	return 0
 }
 
-func (x *Cluster) GetSummary() *Cluster_Summary {
+func (x *CreateMaintenanceWindowRequest) GetEndTime() int64 {
 	if x != nil {
-		return x.Summary
+		return x.EndTime
 	}
-	return nil
+	return 0
 }
 
-func (x *Cluster) GetLastUpdatedAt() int64 {
+func (x *CreateMaintenanceWindowRequest) GetReason() string {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type ListClustersRequest struct {
+type DeleteMaintenanceWindowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListClustersRequest) Reset() {
-	*x = ListClustersRequest{}
+func (x *DeleteMaintenanceWindowRequest) Reset() {
+	*x = DeleteMaintenanceWindowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_proto_msgTypes[1]
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListClustersRequest) String() string {
+func (x *DeleteMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClustersRequest) ProtoMessage() {}
+func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_proto_msgTypes[1]
+func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -187,36 +833,53 @@ func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
-func (*ListClustersRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{9}
 }
 
-type ListClustersResponse struct {
+func (x *DeleteMaintenanceWindowRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// SetClusterLabelsRequest is the request to set the labels of a cluster. The labels replace the labels
+// previously set by administrators.
+type SetClusterLabelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Clusters []*Cluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Id     string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ListClustersResponse) Reset() {
-	*x = ListClustersResponse{}
+func (x *SetClusterLabelsRequest) Reset() {
+	*x = SetClusterLabelsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_proto_msgTypes[2]
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListClustersResponse) String() string {
+func (x *SetClusterLabelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClustersResponse) ProtoMessage() {}
+func (*SetClusterLabelsRequest) ProtoMessage() {}
 
-func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_proto_msgTypes[2]
+func (x *SetClusterLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,14 +890,21 @@ func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
-func (*ListClustersResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SetClusterLabelsRequest.ProtoReflect.Descriptor instead.
+func (*SetClusterLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListClustersResponse) GetClusters() []*Cluster {
+func (x *SetClusterLabelsRequest) GetId() string {
 	if x != nil {
-		return x.Clusters
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetClusterLabelsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
 	}
 	return nil
 }
@@ -255,7 +925,7 @@ type RequestFilter struct {
 func (x *RequestFilter) Reset() {
 	*x = RequestFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_proto_msgTypes[3]
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -268,7 +938,7 @@ func (x *RequestFilter) String() string {
 func (*RequestFilter) ProtoMessage() {}
 
 func (x *RequestFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_proto_msgTypes[3]
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +951,7 @@ func (x *RequestFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestFilter.ProtoReflect.Descriptor instead.
 func (*RequestFilter) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RequestFilter) GetStartTimestamp() int64 {
@@ -316,7 +986,7 @@ type ListJobSummariesRequest struct {
 func (x *ListJobSummariesRequest) Reset() {
 	*x = ListJobSummariesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_proto_msgTypes[4]
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -329,7 +999,7 @@ func (x *ListJobSummariesRequest) String() string {
 func (*ListJobSummariesRequest) ProtoMessage() {}
 
 func (x *ListJobSummariesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_proto_msgTypes[4]
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +1012,7 @@ func (x *ListJobSummariesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobSummariesRequest.ProtoReflect.Descriptor instead.
 func (*ListJobSummariesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListJobSummariesRequest) GetFilter() *RequestFilter {
@@ -363,7 +1033,7 @@ type ListJobSummariesResponse struct {
 func (x *ListJobSummariesResponse) Reset() {
 	*x = ListJobSummariesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_proto_msgTypes[5]
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -376,7 +1046,7 @@ func (x *ListJobSummariesResponse) String() string {
 func (*ListJobSummariesResponse) ProtoMessage() {}
 
 func (x *ListJobSummariesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_proto_msgTypes[5]
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +1059,7 @@ func (x *ListJobSummariesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobSummariesResponse.ProtoReflect.Descriptor instead.
 func (*ListJobSummariesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListJobSummariesResponse) GetDatapoints() []*ListJobSummariesResponse_Datapoint {
@@ -404,15 +1074,18 @@ type Cluster_Summary struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// gpu_capacity is the number of healthy accelerators.
 	GpuCapacity  int32 `protobuf:"varint,1,opt,name=gpu_capacity,json=gpuCapacity,proto3" json:"gpu_capacity,omitempty"`
 	GpuAllocated int32 `protobuf:"varint,2,opt,name=gpu_allocated,json=gpuAllocated,proto3" json:"gpu_allocated,omitempty"`
 	GpuPodCount  int32 `protobuf:"varint,3,opt,name=gpu_pod_count,json=gpuPodCount,proto3" json:"gpu_pod_count,omitempty"`
+	// unhealthy_gpu_count is the number of accelerators that cannot be used.
+	UnhealthyGpuCount int32 `protobuf:"varint,4,opt,name=unhealthy_gpu_count,json=unhealthyGpuCount,proto3" json:"unhealthy_gpu_count,omitempty"`
 }
 
 func (x *Cluster_Summary) Reset() {
 	*x = Cluster_Summary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_proto_msgTypes[6]
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -425,7 +1098,7 @@ func (x *Cluster_Summary) String() string {
 func (*Cluster_Summary) ProtoMessage() {}
 
 func (x *Cluster_Summary) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_proto_msgTypes[6]
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,6 +1135,79 @@ func (x *Cluster_Summary) GetGpuPodCount() int32 {
 	return 0
 }
 
+func (x *Cluster_Summary) GetUnhealthyGpuCount() int32 {
+	if x != nil {
+		return x.UnhealthyGpuCount
+	}
+	return 0
+}
+
+// UnhealthyNode is a GPU node that has unusable accelerators.
+type Cluster_UnhealthyNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// reasons are the reasons why the node cannot run workloads. Empty if only some of the accelerators
+	// are reported as unhealthy by the device plugin.
+	Reasons           []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	UnhealthyGpuCount int32    `protobuf:"varint,3,opt,name=unhealthy_gpu_count,json=unhealthyGpuCount,proto3" json:"unhealthy_gpu_count,omitempty"`
+}
+
+func (x *Cluster_UnhealthyNode) Reset() {
+	*x = Cluster_UnhealthyNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cluster_UnhealthyNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cluster_UnhealthyNode) ProtoMessage() {}
+
+func (x *Cluster_UnhealthyNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cluster_UnhealthyNode.ProtoReflect.Descriptor instead.
+func (*Cluster_UnhealthyNode) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Cluster_UnhealthyNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Cluster_UnhealthyNode) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *Cluster_UnhealthyNode) GetUnhealthyGpuCount() int32 {
+	if x != nil {
+		return x.UnhealthyGpuCount
+	}
+	return 0
+}
+
 type ListJobSummariesResponse_Value struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -483,7 +1229,7 @@ type ListJobSummariesResponse_Value struct {
 func (x *ListJobSummariesResponse_Value) Reset() {
 	*x = ListJobSummariesResponse_Value{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_proto_msgTypes[7]
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -496,7 +1242,7 @@ func (x *ListJobSummariesResponse_Value) String() string {
 func (*ListJobSummariesResponse_Value) ProtoMessage() {}
 
 func (x *ListJobSummariesResponse_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_proto_msgTypes[7]
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +1255,7 @@ func (x *ListJobSummariesResponse_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobSummariesResponse_Value.ProtoReflect.Descriptor instead.
 func (*ListJobSummariesResponse_Value) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{5, 0}
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *ListJobSummariesResponse_Value) GetJobType() JobType {
@@ -595,7 +1341,7 @@ type ListJobSummariesResponse_Datapoint struct {
 func (x *ListJobSummariesResponse_Datapoint) Reset() {
 	*x = ListJobSummariesResponse_Datapoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_proto_msgTypes[8]
+		mi := &file_api_v1_job_manager_server_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -608,7 +1354,7 @@ func (x *ListJobSummariesResponse_Datapoint) String() string {
 func (*ListJobSummariesResponse_Datapoint) ProtoMessage() {}
 
 func (x *ListJobSummariesResponse_Datapoint) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_proto_msgTypes[8]
+	mi := &file_api_v1_job_manager_server_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +1367,7 @@ func (x *ListJobSummariesResponse_Datapoint) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListJobSummariesResponse_Datapoint.ProtoReflect.Descriptor instead.
 func (*ListJobSummariesResponse_Datapoint) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{5, 1}
+	return file_api_v1_job_manager_server_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *ListJobSummariesResponse_Datapoint) GetTimestamp() int64 {
@@ -651,7 +1397,7 @@ var file_api_v1_job_manager_server_proto_rawDesc = []byte{
 	0x72, 0x76, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xd2, 0x02, 0x0a, 0x07, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x6f, 0x22, 0xa4, 0x08, 0x0a, 0x07, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
@@ -664,95 +1410,278 @@ var file_api_v1_job_manager_server_proto_rawDesc = []byte{
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07,
 	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
 	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a,
-	0x75, 0x0a, 0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x70,
-	0x75, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x67, 0x70, 0x75, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a,
-	0x0d, 0x67, 0x70, 0x75, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x67, 0x70, 0x75, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x70, 0x75, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x70, 0x75, 0x50, 0x6f,
-	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a,
-	0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
-	0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x17, 0x4c,
-	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
-	0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x94, 0x05, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
-	0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x58, 0x0a, 0x0f, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
 	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x1a, 0x9c, 0x03, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c, 0x0a, 0x08,
-	0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
-	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x21,
-	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70,
-	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
-	0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x75, 0x6e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x1a, 0x7b, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x50, 0x0a, 0x06,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x75, 0x6e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x72,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x72,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x72, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x72,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x48, 0x0a, 0x0c, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0b, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x5c, 0x0a, 0x13, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x12, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x4f,
+	0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x45, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0xa5, 0x01, 0x0a, 0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x70, 0x75, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x70, 0x75, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x70, 0x75, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x67, 0x70,
+	0x75, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x70,
+	0x75, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x67, 0x70, 0x75, 0x50, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x67, 0x70, 0x75, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x75, 0x6e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x47, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x6d,
+	0x0a, 0x0d, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x67, 0x70, 0x75, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x75, 0x6e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x79, 0x47, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x39, 0x0a,
+	0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x44, 0x72, 0x61,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x26, 0x0a, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4a,
+	0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x66, 0x69, 0x6e, 0x65, 0x5f,
+	0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x66, 0x69, 0x6e, 0x65, 0x54, 0x75, 0x6e, 0x69,
+	0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x11, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0x3e, 0x0a, 0x14, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x28, 0x0a, 0x16, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x44, 0x72,
+	0x61, 0x69, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0a, 0x6a, 0x6f, 0x62,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x6a, 0x6f, 0x62, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbb, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x55, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x94, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x94, 0x05, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x1a, 0x9c, 0x03, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c, 0x0a,
+	0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x75, 0x6e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x1a, 0x7b, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x50, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2a,
+	0xa5, 0x01, 0x0a, 0x12, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
+	0x52, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c,
+	0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x21,
+	0x0a, 0x1d, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43,
+	0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x2a, 0x88, 0x01, 0x0a, 0x0e, 0x4a, 0x6f, 0x62, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x4a, 0x4f,
+	0x42, 0x5f, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x4a, 0x4f, 0x42, 0x5f, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x57, 0x41, 0x49, 0x54, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4a, 0x4f, 0x42, 0x5f, 0x44,
+	0x52, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x4a, 0x4f, 0x42, 0x5f, 0x44, 0x52, 0x41, 0x49,
+	0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x55, 0x45,
+	0x10, 0x03, 0x2a, 0x68, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f, 0x42, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4a,
+	0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x55, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x42, 0x4f, 0x4f, 0x4b, 0x10, 0x03, 0x32, 0xfb, 0x09, 0x0a,
+	0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x2e, 0x6c,
 	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2a, 0x68,
-	0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x42,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x55, 0x4e, 0x49, 0x4e, 0x47, 0x10,
-	0x02, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f,
-	0x54, 0x45, 0x42, 0x4f, 0x4f, 0x4b, 0x10, 0x03, 0x32, 0xaf, 0x02, 0x0a, 0x0a, 0x4a, 0x6f, 0x62,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x72, 0x64, 0x6f,
+	0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
 	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x30, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73,
+	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x12, 0x97, 0x01,
+	0x0a, 0x0f, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f,
+	0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x63,
+	0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x27,
+	0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x75,
+	0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x12, 0x91, 0x01, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
 	0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
-	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x75,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x12, 0xb5, 0x01, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x38, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f,
+	0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x22,
+	0x32, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x12, 0xb7, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x38, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x3f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x39, 0x2a, 0x37, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x92, 0x01,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
+	0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x75,
 	0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
 	0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
 	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
@@ -779,40 +1708,72 @@ func file_api_v1_job_manager_server_proto_rawDescGZIP() []byte {
 	return file_api_v1_job_manager_server_proto_rawDescData
 }
 
-var file_api_v1_job_manager_server_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_job_manager_server_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_job_manager_server_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_job_manager_server_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_api_v1_job_manager_server_proto_goTypes = []interface{}{
-	(JobType)(0),                               // 0: llmariner.jobs.server.v1.JobType
-	(*Cluster)(nil),                            // 1: llmariner.jobs.server.v1.Cluster
-	(*ListClustersRequest)(nil),                // 2: llmariner.jobs.server.v1.ListClustersRequest
-	(*ListClustersResponse)(nil),               // 3: llmariner.jobs.server.v1.ListClustersResponse
-	(*RequestFilter)(nil),                      // 4: llmariner.jobs.server.v1.RequestFilter
-	(*ListJobSummariesRequest)(nil),            // 5: llmariner.jobs.server.v1.ListJobSummariesRequest
-	(*ListJobSummariesResponse)(nil),           // 6: llmariner.jobs.server.v1.ListJobSummariesResponse
-	(*Cluster_Summary)(nil),                    // 7: llmariner.jobs.server.v1.Cluster.Summary
-	(*ListJobSummariesResponse_Value)(nil),     // 8: llmariner.jobs.server.v1.ListJobSummariesResponse.Value
-	(*ListJobSummariesResponse_Datapoint)(nil), // 9: llmariner.jobs.server.v1.ListJobSummariesResponse.Datapoint
-	(*ClusterStatus)(nil),                      // 10: llmariner.jobs.server.v1.ClusterStatus
-	(*durationpb.Duration)(nil),                // 11: google.protobuf.Duration
+	(ClusterHealthState)(0),                    // 0: llmariner.jobs.server.v1.ClusterHealthState
+	(JobDrainPolicy)(0),                        // 1: llmariner.jobs.server.v1.JobDrainPolicy
+	(JobType)(0),                               // 2: llmariner.jobs.server.v1.JobType
+	(*Cluster)(nil),                            // 3: llmariner.jobs.server.v1.Cluster
+	(*DrainStatus)(nil),                        // 4: llmariner.jobs.server.v1.DrainStatus
+	(*MaintenanceWindow)(nil),                  // 5: llmariner.jobs.server.v1.MaintenanceWindow
+	(*ListClustersRequest)(nil),                // 6: llmariner.jobs.server.v1.ListClustersRequest
+	(*ListClustersResponse)(nil),               // 7: llmariner.jobs.server.v1.ListClustersResponse
+	(*CordonClusterRequest)(nil),               // 8: llmariner.jobs.server.v1.CordonClusterRequest
+	(*UncordonClusterRequest)(nil),             // 9: llmariner.jobs.server.v1.UncordonClusterRequest
+	(*DrainClusterRequest)(nil),                // 10: llmariner.jobs.server.v1.DrainClusterRequest
+	(*CreateMaintenanceWindowRequest)(nil),     // 11: llmariner.jobs.server.v1.CreateMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowRequest)(nil),     // 12: llmariner.jobs.server.v1.DeleteMaintenanceWindowRequest
+	(*SetClusterLabelsRequest)(nil),            // 13: llmariner.jobs.server.v1.SetClusterLabelsRequest
+	(*RequestFilter)(nil),                      // 14: llmariner.jobs.server.v1.RequestFilter
+	(*ListJobSummariesRequest)(nil),            // 15: llmariner.jobs.server.v1.ListJobSummariesRequest
+	(*ListJobSummariesResponse)(nil),           // 16: llmariner.jobs.server.v1.ListJobSummariesResponse
+	(*Cluster_Summary)(nil),                    // 17: llmariner.jobs.server.v1.Cluster.Summary
+	(*Cluster_UnhealthyNode)(nil),              // 18: llmariner.jobs.server.v1.Cluster.UnhealthyNode
+	nil,                                        // 19: llmariner.jobs.server.v1.Cluster.LabelsEntry
+	nil,                                        // 20: llmariner.jobs.server.v1.SetClusterLabelsRequest.LabelsEntry
+	(*ListJobSummariesResponse_Value)(nil),     // 21: llmariner.jobs.server.v1.ListJobSummariesResponse.Value
+	(*ListJobSummariesResponse_Datapoint)(nil), // 22: llmariner.jobs.server.v1.ListJobSummariesResponse.Datapoint
+	(*ClusterStatus)(nil),                      // 23: llmariner.jobs.server.v1.ClusterStatus
+	(*durationpb.Duration)(nil),                // 24: google.protobuf.Duration
 }
 var file_api_v1_job_manager_server_proto_depIdxs = []int32{
-	10, // 0: llmariner.jobs.server.v1.Cluster.status:type_name -> llmariner.jobs.server.v1.ClusterStatus
-	7,  // 1: llmariner.jobs.server.v1.Cluster.summary:type_name -> llmariner.jobs.server.v1.Cluster.Summary
-	1,  // 2: llmariner.jobs.server.v1.ListClustersResponse.clusters:type_name -> llmariner.jobs.server.v1.Cluster
-	11, // 3: llmariner.jobs.server.v1.RequestFilter.duration:type_name -> google.protobuf.Duration
-	4,  // 4: llmariner.jobs.server.v1.ListJobSummariesRequest.filter:type_name -> llmariner.jobs.server.v1.RequestFilter
-	9,  // 5: llmariner.jobs.server.v1.ListJobSummariesResponse.datapoints:type_name -> llmariner.jobs.server.v1.ListJobSummariesResponse.Datapoint
-	0,  // 6: llmariner.jobs.server.v1.ListJobSummariesResponse.Value.job_type:type_name -> llmariner.jobs.server.v1.JobType
-	8,  // 7: llmariner.jobs.server.v1.ListJobSummariesResponse.Datapoint.values:type_name -> llmariner.jobs.server.v1.ListJobSummariesResponse.Value
-	2,  // 8: llmariner.jobs.server.v1.JobService.ListClusters:input_type -> llmariner.jobs.server.v1.ListClustersRequest
-	5,  // 9: llmariner.jobs.server.v1.JobService.ListJobSummaries:input_type -> llmariner.jobs.server.v1.ListJobSummariesRequest
-	3,  // 10: llmariner.jobs.server.v1.JobService.ListClusters:output_type -> llmariner.jobs.server.v1.ListClustersResponse
-	6,  // 11: llmariner.jobs.server.v1.JobService.ListJobSummaries:output_type -> llmariner.jobs.server.v1.ListJobSummariesResponse
-	10, // [10:12] is the sub-list for method output_type
-	8,  // [8:10] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	23, // 0: llmariner.jobs.server.v1.Cluster.status:type_name -> llmariner.jobs.server.v1.ClusterStatus
+	17, // 1: llmariner.jobs.server.v1.Cluster.summary:type_name -> llmariner.jobs.server.v1.Cluster.Summary
+	18, // 2: llmariner.jobs.server.v1.Cluster.unhealthy_nodes:type_name -> llmariner.jobs.server.v1.Cluster.UnhealthyNode
+	4,  // 3: llmariner.jobs.server.v1.Cluster.drain_status:type_name -> llmariner.jobs.server.v1.DrainStatus
+	5,  // 4: llmariner.jobs.server.v1.Cluster.maintenance_windows:type_name -> llmariner.jobs.server.v1.MaintenanceWindow
+	0,  // 5: llmariner.jobs.server.v1.Cluster.health_state:type_name -> llmariner.jobs.server.v1.ClusterHealthState
+	19, // 6: llmariner.jobs.server.v1.Cluster.labels:type_name -> llmariner.jobs.server.v1.Cluster.LabelsEntry
+	3,  // 7: llmariner.jobs.server.v1.ListClustersResponse.clusters:type_name -> llmariner.jobs.server.v1.Cluster
+	1,  // 8: llmariner.jobs.server.v1.DrainClusterRequest.job_policy:type_name -> llmariner.jobs.server.v1.JobDrainPolicy
+	20, // 9: llmariner.jobs.server.v1.SetClusterLabelsRequest.labels:type_name -> llmariner.jobs.server.v1.SetClusterLabelsRequest.LabelsEntry
+	24, // 10: llmariner.jobs.server.v1.RequestFilter.duration:type_name -> google.protobuf.Duration
+	14, // 11: llmariner.jobs.server.v1.ListJobSummariesRequest.filter:type_name -> llmariner.jobs.server.v1.RequestFilter
+	22, // 12: llmariner.jobs.server.v1.ListJobSummariesResponse.datapoints:type_name -> llmariner.jobs.server.v1.ListJobSummariesResponse.Datapoint
+	2,  // 13: llmariner.jobs.server.v1.ListJobSummariesResponse.Value.job_type:type_name -> llmariner.jobs.server.v1.JobType
+	21, // 14: llmariner.jobs.server.v1.ListJobSummariesResponse.Datapoint.values:type_name -> llmariner.jobs.server.v1.ListJobSummariesResponse.Value
+	6,  // 15: llmariner.jobs.server.v1.JobService.ListClusters:input_type -> llmariner.jobs.server.v1.ListClustersRequest
+	8,  // 16: llmariner.jobs.server.v1.JobService.CordonCluster:input_type -> llmariner.jobs.server.v1.CordonClusterRequest
+	9,  // 17: llmariner.jobs.server.v1.JobService.UncordonCluster:input_type -> llmariner.jobs.server.v1.UncordonClusterRequest
+	10, // 18: llmariner.jobs.server.v1.JobService.DrainCluster:input_type -> llmariner.jobs.server.v1.DrainClusterRequest
+	11, // 19: llmariner.jobs.server.v1.JobService.CreateMaintenanceWindow:input_type -> llmariner.jobs.server.v1.CreateMaintenanceWindowRequest
+	12, // 20: llmariner.jobs.server.v1.JobService.DeleteMaintenanceWindow:input_type -> llmariner.jobs.server.v1.DeleteMaintenanceWindowRequest
+	13, // 21: llmariner.jobs.server.v1.JobService.SetClusterLabels:input_type -> llmariner.jobs.server.v1.SetClusterLabelsRequest
+	15, // 22: llmariner.jobs.server.v1.JobService.ListJobSummaries:input_type -> llmariner.jobs.server.v1.ListJobSummariesRequest
+	7,  // 23: llmariner.jobs.server.v1.JobService.ListClusters:output_type -> llmariner.jobs.server.v1.ListClustersResponse
+	3,  // 24: llmariner.jobs.server.v1.JobService.CordonCluster:output_type -> llmariner.jobs.server.v1.Cluster
+	3,  // 25: llmariner.jobs.server.v1.JobService.UncordonCluster:output_type -> llmariner.jobs.server.v1.Cluster
+	3,  // 26: llmariner.jobs.server.v1.JobService.DrainCluster:output_type -> llmariner.jobs.server.v1.Cluster
+	3,  // 27: llmariner.jobs.server.v1.JobService.CreateMaintenanceWindow:output_type -> llmariner.jobs.server.v1.Cluster
+	3,  // 28: llmariner.jobs.server.v1.JobService.DeleteMaintenanceWindow:output_type -> llmariner.jobs.server.v1.Cluster
+	3,  // 29: llmariner.jobs.server.v1.JobService.SetClusterLabels:output_type -> llmariner.jobs.server.v1.Cluster
+	16, // 30: llmariner.jobs.server.v1.JobService.ListJobSummaries:output_type -> llmariner.jobs.server.v1.ListJobSummariesResponse
+	23, // [23:31] is the sub-list for method output_type
+	15, // [15:23] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_job_manager_server_proto_init() }
@@ -835,7 +1796,7 @@ func file_api_v1_job_manager_server_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListClustersRequest); i {
+			switch v := v.(*DrainStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -847,7 +1808,7 @@ func file_api_v1_job_manager_server_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListClustersResponse); i {
+			switch v := v.(*MaintenanceWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -859,7 +1820,7 @@ func file_api_v1_job_manager_server_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestFilter); i {
+			switch v := v.(*ListClustersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -871,7 +1832,7 @@ func file_api_v1_job_manager_server_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobSummariesRequest); i {
+			switch v := v.(*ListClustersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -883,7 +1844,7 @@ func file_api_v1_job_manager_server_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobSummariesResponse); i {
+			switch v := v.(*CordonClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -895,7 +1856,7 @@ func file_api_v1_job_manager_server_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cluster_Summary); i {
+			switch v := v.(*UncordonClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -907,7 +1868,7 @@ func file_api_v1_job_manager_server_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobSummariesResponse_Value); i {
+			switch v := v.(*DrainClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -919,6 +1880,114 @@ func file_api_v1_job_manager_server_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMaintenanceWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMaintenanceWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetClusterLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobSummariesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobSummariesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cluster_Summary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cluster_UnhealthyNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobSummariesResponse_Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListJobSummariesResponse_Datapoint); i {
 			case 0:
 				return &v.state
@@ -936,8 +2005,8 @@ func file_api_v1_job_manager_server_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_job_manager_server_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
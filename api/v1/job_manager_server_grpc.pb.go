@@ -19,6 +19,12 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type JobServiceClient interface {
 	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
+	CordonCluster(ctx context.Context, in *CordonClusterRequest, opts ...grpc.CallOption) (*Cluster, error)
+	UncordonCluster(ctx context.Context, in *UncordonClusterRequest, opts ...grpc.CallOption) (*Cluster, error)
+	DrainCluster(ctx context.Context, in *DrainClusterRequest, opts ...grpc.CallOption) (*Cluster, error)
+	CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*Cluster, error)
+	DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*Cluster, error)
+	SetClusterLabels(ctx context.Context, in *SetClusterLabelsRequest, opts ...grpc.CallOption) (*Cluster, error)
 	ListJobSummaries(ctx context.Context, in *ListJobSummariesRequest, opts ...grpc.CallOption) (*ListJobSummariesResponse, error)
 }
 
@@ -39,6 +45,60 @@ func (c *jobServiceClient) ListClusters(ctx context.Context, in *ListClustersReq
 	return out, nil
 }
 
+func (c *jobServiceClient) CordonCluster(ctx context.Context, in *CordonClusterRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobService/CordonCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UncordonCluster(ctx context.Context, in *UncordonClusterRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobService/UncordonCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DrainCluster(ctx context.Context, in *DrainClusterRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobService/DrainCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobService/CreateMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobService/DeleteMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) SetClusterLabels(ctx context.Context, in *SetClusterLabelsRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobService/SetClusterLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobServiceClient) ListJobSummaries(ctx context.Context, in *ListJobSummariesRequest, opts ...grpc.CallOption) (*ListJobSummariesResponse, error) {
 	out := new(ListJobSummariesResponse)
 	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobService/ListJobSummaries", in, out, opts...)
@@ -53,6 +113,12 @@ func (c *jobServiceClient) ListJobSummaries(ctx context.Context, in *ListJobSumm
 // for forward compatibility
 type JobServiceServer interface {
 	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
+	CordonCluster(context.Context, *CordonClusterRequest) (*Cluster, error)
+	UncordonCluster(context.Context, *UncordonClusterRequest) (*Cluster, error)
+	DrainCluster(context.Context, *DrainClusterRequest) (*Cluster, error)
+	CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*Cluster, error)
+	DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*Cluster, error)
+	SetClusterLabels(context.Context, *SetClusterLabelsRequest) (*Cluster, error)
 	ListJobSummaries(context.Context, *ListJobSummariesRequest) (*ListJobSummariesResponse, error)
 	mustEmbedUnimplementedJobServiceServer()
 }
@@ -64,6 +130,24 @@ type UnimplementedJobServiceServer struct {
 func (UnimplementedJobServiceServer) ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
 }
+func (UnimplementedJobServiceServer) CordonCluster(context.Context, *CordonClusterRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonCluster not implemented")
+}
+func (UnimplementedJobServiceServer) UncordonCluster(context.Context, *UncordonClusterRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonCluster not implemented")
+}
+func (UnimplementedJobServiceServer) DrainCluster(context.Context, *DrainClusterRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainCluster not implemented")
+}
+func (UnimplementedJobServiceServer) CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMaintenanceWindow not implemented")
+}
+func (UnimplementedJobServiceServer) DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaintenanceWindow not implemented")
+}
+func (UnimplementedJobServiceServer) SetClusterLabels(context.Context, *SetClusterLabelsRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClusterLabels not implemented")
+}
 func (UnimplementedJobServiceServer) ListJobSummaries(context.Context, *ListJobSummariesRequest) (*ListJobSummariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListJobSummaries not implemented")
 }
@@ -98,6 +182,114 @@ func _JobService_ListClusters_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_CordonCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CordonCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.jobs.server.v1.JobService/CordonCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CordonCluster(ctx, req.(*CordonClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UncordonCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UncordonCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.jobs.server.v1.JobService/UncordonCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UncordonCluster(ctx, req.(*UncordonClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DrainCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DrainCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.jobs.server.v1.JobService/DrainCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DrainCluster(ctx, req.(*DrainClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_CreateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CreateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.jobs.server.v1.JobService/CreateMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CreateMaintenanceWindow(ctx, req.(*CreateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.jobs.server.v1.JobService/DeleteMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteMaintenanceWindow(ctx, req.(*DeleteMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_SetClusterLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetClusterLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).SetClusterLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.jobs.server.v1.JobService/SetClusterLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).SetClusterLabels(ctx, req.(*SetClusterLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JobService_ListJobSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListJobSummariesRequest)
 	if err := dec(in); err != nil {
@@ -127,6 +319,30 @@ var JobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListClusters",
 			Handler:    _JobService_ListClusters_Handler,
 		},
+		{
+			MethodName: "CordonCluster",
+			Handler:    _JobService_CordonCluster_Handler,
+		},
+		{
+			MethodName: "UncordonCluster",
+			Handler:    _JobService_UncordonCluster_Handler,
+		},
+		{
+			MethodName: "DrainCluster",
+			Handler:    _JobService_DrainCluster_Handler,
+		},
+		{
+			MethodName: "CreateMaintenanceWindow",
+			Handler:    _JobService_CreateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceWindow",
+			Handler:    _JobService_DeleteMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "SetClusterLabels",
+			Handler:    _JobService_SetClusterLabels_Handler,
+		},
 		{
 			MethodName: "ListJobSummaries",
 			Handler:    _JobService_ListJobSummaries_Handler,
@@ -20,13 +20,77 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DispatchAction_WorkloadType int32
+
+const (
+	DispatchAction_WORKLOAD_TYPE_UNSPECIFIED DispatchAction_WorkloadType = 0
+	DispatchAction_JOB                       DispatchAction_WorkloadType = 1
+	DispatchAction_NOTEBOOK                  DispatchAction_WorkloadType = 2
+	DispatchAction_BATCH_JOB                 DispatchAction_WorkloadType = 3
+)
+
+// Enum value maps for DispatchAction_WorkloadType.
+var (
+	DispatchAction_WorkloadType_name = map[int32]string{
+		0: "WORKLOAD_TYPE_UNSPECIFIED",
+		1: "JOB",
+		2: "NOTEBOOK",
+		3: "BATCH_JOB",
+	}
+	DispatchAction_WorkloadType_value = map[string]int32{
+		"WORKLOAD_TYPE_UNSPECIFIED": 0,
+		"JOB":                       1,
+		"NOTEBOOK":                  2,
+		"BATCH_JOB":                 3,
+	}
+)
+
+func (x DispatchAction_WorkloadType) Enum() *DispatchAction_WorkloadType {
+	p := new(DispatchAction_WorkloadType)
+	*p = x
+	return p
+}
+
+func (x DispatchAction_WorkloadType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DispatchAction_WorkloadType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_job_manager_server_worker_proto_enumTypes[0].Descriptor()
+}
+
+func (DispatchAction_WorkloadType) Type() protoreflect.EnumType {
+	return &file_api_v1_job_manager_server_worker_proto_enumTypes[0]
+}
+
+func (x DispatchAction_WorkloadType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DispatchAction_WorkloadType.Descriptor instead.
+func (DispatchAction_WorkloadType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{10, 0}
+}
+
 type GpuNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResourceName     string `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
-	AllocatableCount int32  `protobuf:"varint,2,opt,name=allocatable_count,json=allocatableCount,proto3" json:"allocatable_count,omitempty"` // TODO(kenji): Add more information such as Nvidia GPU architecture.
+	ResourceName string `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	// allocatable_count is the number of accelerators that the device plugin reports as healthy.
+	AllocatableCount int32  `protobuf:"varint,2,opt,name=allocatable_count,json=allocatableCount,proto3" json:"allocatable_count,omitempty"`
+	NodeName         string `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	// product is the GPU product name reported by NVIDIA GPU Feature Discovery (e.g., "NVIDIA-H100-80GB-HBM3").
+	Product string `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
+	// memory_megabytes is the memory size of each GPU in MiB reported by NVIDIA GPU Feature Discovery.
+	MemoryMegabytes int32 `protobuf:"varint,5,opt,name=memory_megabytes,json=memoryMegabytes,proto3" json:"memory_megabytes,omitempty"`
+	// capacity_count is the number of accelerators installed on the node. It is larger than allocatable_count
+	// if the device plugin reports some of the accelerators as unhealthy.
+	CapacityCount int32 `protobuf:"varint,6,opt,name=capacity_count,json=capacityCount,proto3" json:"capacity_count,omitempty"`
+	// unhealthy_reasons are the reasons why the node cannot run workloads (e.g., the node is not ready or
+	// has a taint added by a GPU health check). None of the accelerators on the node are usable if set.
+	UnhealthyReasons []string `protobuf:"bytes,7,rep,name=unhealthy_reasons,json=unhealthyReasons,proto3" json:"unhealthy_reasons,omitempty"`
 }
 
 func (x *GpuNode) Reset() {
@@ -75,6 +139,123 @@ func (x *GpuNode) GetAllocatableCount() int32 {
 	return 0
 }
 
+func (x *GpuNode) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *GpuNode) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *GpuNode) GetMemoryMegabytes() int32 {
+	if x != nil {
+		return x.MemoryMegabytes
+	}
+	return 0
+}
+
+func (x *GpuNode) GetCapacityCount() int32 {
+	if x != nil {
+		return x.CapacityCount
+	}
+	return 0
+}
+
+func (x *GpuNode) GetUnhealthyReasons() []string {
+	if x != nil {
+		return x.UnhealthyReasons
+	}
+	return nil
+}
+
+// Node is the CPU and memory capacity of a schedulable node.
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AllocatableCpuMilicore     int64  `protobuf:"varint,2,opt,name=allocatable_cpu_milicore,json=allocatableCpuMilicore,proto3" json:"allocatable_cpu_milicore,omitempty"`
+	AllocatableMemoryMegabytes int64  `protobuf:"varint,3,opt,name=allocatable_memory_megabytes,json=allocatableMemoryMegabytes,proto3" json:"allocatable_memory_megabytes,omitempty"`
+	// requested_cpu_milicore and requested_memory_megabytes are the sums of the requests of
+	// the non-terminated pods on the node.
+	RequestedCpuMilicore     int64 `protobuf:"varint,4,opt,name=requested_cpu_milicore,json=requestedCpuMilicore,proto3" json:"requested_cpu_milicore,omitempty"`
+	RequestedMemoryMegabytes int64 `protobuf:"varint,5,opt,name=requested_memory_megabytes,json=requestedMemoryMegabytes,proto3" json:"requested_memory_megabytes,omitempty"`
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetAllocatableCpuMilicore() int64 {
+	if x != nil {
+		return x.AllocatableCpuMilicore
+	}
+	return 0
+}
+
+func (x *Node) GetAllocatableMemoryMegabytes() int64 {
+	if x != nil {
+		return x.AllocatableMemoryMegabytes
+	}
+	return 0
+}
+
+func (x *Node) GetRequestedCpuMilicore() int64 {
+	if x != nil {
+		return x.RequestedCpuMilicore
+	}
+	return 0
+}
+
+func (x *Node) GetRequestedMemoryMegabytes() int64 {
+	if x != nil {
+		return x.RequestedMemoryMegabytes
+	}
+	return 0
+}
+
 type GpuPod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -83,12 +264,15 @@ type GpuPod struct {
 	ResourceName   string `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
 	AllocatedCount int32  `protobuf:"varint,2,opt,name=allocated_count,json=allocatedCount,proto3" json:"allocated_count,omitempty"`
 	NamespacedName string `protobuf:"bytes,3,opt,name=namespaced_name,json=namespacedName,proto3" json:"namespaced_name,omitempty"`
+	// node_name is the name of the node where the pod is running. This is used to understand
+	// resource fragmentation.
+	NodeName string `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
 }
 
 func (x *GpuPod) Reset() {
 	*x = GpuPod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[1]
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -101,7 +285,7 @@ func (x *GpuPod) String() string {
 func (*GpuPod) ProtoMessage() {}
 
 func (x *GpuPod) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[1]
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,7 +298,7 @@ func (x *GpuPod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GpuPod.ProtoReflect.Descriptor instead.
 func (*GpuPod) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{1}
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GpuPod) GetResourceName() string {
@@ -138,6 +322,13 @@ func (x *GpuPod) GetNamespacedName() string {
 	return ""
 }
 
+func (x *GpuPod) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
 // ProvisionableResource represents GPU instances that a cluster can provision
 // (e.g., Karpenter nodepool configuration).
 type ProvisionableResource struct {
@@ -145,14 +336,44 @@ type ProvisionableResource struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// instance_family and instance_type are the first values of the requirements of the nodepool.
+	// They are kept for backward compatibility. Use instance_families and instance_types instead.
 	InstanceFamily string `protobuf:"bytes,1,opt,name=instance_family,json=instanceFamily,proto3" json:"instance_family,omitempty"`
 	InstanceType   string `protobuf:"bytes,2,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	// name is the name of the nodepool.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// instance_types, instance_families and instance_categories are the values of the "In" requirements
+	// of the nodepool. An empty list means that the nodepool has no constraint.
+	InstanceTypes      []string `protobuf:"bytes,4,rep,name=instance_types,json=instanceTypes,proto3" json:"instance_types,omitempty"`
+	InstanceFamilies   []string `protobuf:"bytes,5,rep,name=instance_families,json=instanceFamilies,proto3" json:"instance_families,omitempty"`
+	InstanceCategories []string `protobuf:"bytes,6,rep,name=instance_categories,json=instanceCategories,proto3" json:"instance_categories,omitempty"`
+	// gpu_names are the values of the "karpenter.k8s.aws/instance-gpu-name" requirement (e.g., "a10g").
+	GpuNames []string `protobuf:"bytes,7,rep,name=gpu_names,json=gpuNames,proto3" json:"gpu_names,omitempty"`
+	// min_gpu_count and max_gpu_count are the bounds of the "karpenter.k8s.aws/instance-gpu-count" requirement.
+	MinGpuCount int32  `protobuf:"varint,8,opt,name=min_gpu_count,json=minGpuCount,proto3" json:"min_gpu_count,omitempty"`
+	MaxGpuCount *int32 `protobuf:"varint,9,opt,name=max_gpu_count,json=maxGpuCount,proto3,oneof" json:"max_gpu_count,omitempty"`
+	// gpu_limits are the limits of the accelerators that the nodepool can provision. The key is the
+	// resource name of the accelerator (e.g., "nvidia.com/gpu").
+	GpuLimits map[string]int32 `protobuf:"bytes,10,rep,name=gpu_limits,json=gpuLimits,proto3" json:"gpu_limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// provisioned_gpus are the accelerators of the nodes that the nodepool has already provisioned.
+	ProvisionedGpus map[string]int32 `protobuf:"bytes,11,rep,name=provisioned_gpus,json=provisionedGpus,proto3" json:"provisioned_gpus,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// source is the autoscaler that manages the resource. One of "karpenter.sh/v1", "karpenter.sh/v1beta1"
+	// and "cluster-autoscaler". An empty source means "karpenter.sh/v1".
+	Source string `protobuf:"bytes,12,opt,name=source,proto3" json:"source,omitempty"`
+	// node_gpus are the accelerators of each node that the resource provisions. The key is the resource name
+	// of the accelerator. This is set when the shape of the nodes is known in advance
+	// (e.g., a node group of Cluster Autoscaler).
+	NodeGpus map[string]int32 `protobuf:"bytes,13,rep,name=node_gpus,json=nodeGpus,proto3" json:"node_gpus,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// gpu_product and gpu_memory_megabytes are the GPU product name and the GPU memory size of
+	// the nodes that the resource provisions.
+	GpuProduct         string `protobuf:"bytes,14,opt,name=gpu_product,json=gpuProduct,proto3" json:"gpu_product,omitempty"`
+	GpuMemoryMegabytes int32  `protobuf:"varint,15,opt,name=gpu_memory_megabytes,json=gpuMemoryMegabytes,proto3" json:"gpu_memory_megabytes,omitempty"`
 }
 
 func (x *ProvisionableResource) Reset() {
 	*x = ProvisionableResource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[2]
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -165,7 +386,7 @@ func (x *ProvisionableResource) String() string {
 func (*ProvisionableResource) ProtoMessage() {}
 
 func (x *ProvisionableResource) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[2]
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +399,7 @@ func (x *ProvisionableResource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProvisionableResource.ProtoReflect.Descriptor instead.
 func (*ProvisionableResource) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ProvisionableResource) GetInstanceFamily() string {
@@ -195,6 +416,164 @@ func (x *ProvisionableResource) GetInstanceType() string {
 	return ""
 }
 
+func (x *ProvisionableResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProvisionableResource) GetInstanceTypes() []string {
+	if x != nil {
+		return x.InstanceTypes
+	}
+	return nil
+}
+
+func (x *ProvisionableResource) GetInstanceFamilies() []string {
+	if x != nil {
+		return x.InstanceFamilies
+	}
+	return nil
+}
+
+func (x *ProvisionableResource) GetInstanceCategories() []string {
+	if x != nil {
+		return x.InstanceCategories
+	}
+	return nil
+}
+
+func (x *ProvisionableResource) GetGpuNames() []string {
+	if x != nil {
+		return x.GpuNames
+	}
+	return nil
+}
+
+func (x *ProvisionableResource) GetMinGpuCount() int32 {
+	if x != nil {
+		return x.MinGpuCount
+	}
+	return 0
+}
+
+func (x *ProvisionableResource) GetMaxGpuCount() int32 {
+	if x != nil && x.MaxGpuCount != nil {
+		return *x.MaxGpuCount
+	}
+	return 0
+}
+
+func (x *ProvisionableResource) GetGpuLimits() map[string]int32 {
+	if x != nil {
+		return x.GpuLimits
+	}
+	return nil
+}
+
+func (x *ProvisionableResource) GetProvisionedGpus() map[string]int32 {
+	if x != nil {
+		return x.ProvisionedGpus
+	}
+	return nil
+}
+
+func (x *ProvisionableResource) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ProvisionableResource) GetNodeGpus() map[string]int32 {
+	if x != nil {
+		return x.NodeGpus
+	}
+	return nil
+}
+
+func (x *ProvisionableResource) GetGpuProduct() string {
+	if x != nil {
+		return x.GpuProduct
+	}
+	return ""
+}
+
+func (x *ProvisionableResource) GetGpuMemoryMegabytes() int32 {
+	if x != nil {
+		return x.GpuMemoryMegabytes
+	}
+	return 0
+}
+
+// NamespaceQuota is the ResourceQuota of a namespace. The key of the maps is "cpu" (in millicores),
+// "memory" (in megabytes), or the resource name of an accelerator (e.g., "nvidia.com/gpu").
+// When multiple ResourceQuotas in the namespace limit the same resource, the one with the smallest
+// headroom is reported.
+type NamespaceQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string           `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Hard      map[string]int64 `protobuf:"bytes,2,rep,name=hard,proto3" json:"hard,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Used      map[string]int64 `protobuf:"bytes,3,rep,name=used,proto3" json:"used,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *NamespaceQuota) Reset() {
+	*x = NamespaceQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceQuota) ProtoMessage() {}
+
+func (x *NamespaceQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceQuota.ProtoReflect.Descriptor instead.
+func (*NamespaceQuota) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NamespaceQuota) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NamespaceQuota) GetHard() map[string]int64 {
+	if x != nil {
+		return x.Hard
+	}
+	return nil
+}
+
+func (x *NamespaceQuota) GetUsed() map[string]int64 {
+	if x != nil {
+		return x.Used
+	}
+	return nil
+}
+
 type ClusterStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -204,12 +583,16 @@ type ClusterStatus struct {
 	ProvisionableResources []*ProvisionableResource `protobuf:"bytes,2,rep,name=provisionable_resources,json=provisionableResources,proto3" json:"provisionable_resources,omitempty"`
 	// TODO(kenji): Revisit if this becomes too large.
 	GpuPods []*GpuPod `protobuf:"bytes,3,rep,name=gpu_pods,json=gpuPods,proto3" json:"gpu_pods,omitempty"`
+	Nodes   []*Node   `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// labels are the labels of the cluster configured in the dispatcher.
+	Labels          map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NamespaceQuotas []*NamespaceQuota `protobuf:"bytes,6,rep,name=namespace_quotas,json=namespaceQuotas,proto3" json:"namespace_quotas,omitempty"`
 }
 
 func (x *ClusterStatus) Reset() {
 	*x = ClusterStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[3]
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -222,7 +605,7 @@ func (x *ClusterStatus) String() string {
 func (*ClusterStatus) ProtoMessage() {}
 
 func (x *ClusterStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[3]
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +618,7 @@ func (x *ClusterStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterStatus.ProtoReflect.Descriptor instead.
 func (*ClusterStatus) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ClusterStatus) GetGpuNodes() []*GpuNode {
@@ -259,18 +642,44 @@ func (x *ClusterStatus) GetGpuPods() []*GpuPod {
 	return nil
 }
 
+func (x *ClusterStatus) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ClusterStatus) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ClusterStatus) GetNamespaceQuotas() []*NamespaceQuota {
+	if x != nil {
+		return x.NamespaceQuotas
+	}
+	return nil
+}
+
 type UpdateClusterStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ClusterStatus *ClusterStatus `protobuf:"bytes,1,opt,name=cluster_status,json=clusterStatus,proto3" json:"cluster_status,omitempty"`
+	// session_id and sequence_number are used to detect missed deltas sent by UpdateClusterStatusDelta.
+	// session_id is generated by the dispatcher when it starts. The deltas following this request must have
+	// the same session ID and sequence numbers that start from sequence_number + 1.
+	SessionId      string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SequenceNumber int64  `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
 }
 
 func (x *UpdateClusterStatusRequest) Reset() {
 	*x = UpdateClusterStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[4]
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -283,7 +692,7 @@ func (x *UpdateClusterStatusRequest) String() string {
 func (*UpdateClusterStatusRequest) ProtoMessage() {}
 
 func (x *UpdateClusterStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[4]
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +705,7 @@ func (x *UpdateClusterStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateClusterStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateClusterStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateClusterStatusRequest) GetClusterStatus() *ClusterStatus {
@@ -306,29 +715,782 @@ func (x *UpdateClusterStatusRequest) GetClusterStatus() *ClusterStatus {
 	return nil
 }
 
-type UpdateClusterStatusResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateClusterStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UpdateClusterStatusRequest) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+type UpdateClusterStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateClusterStatusResponse) Reset() {
+	*x = UpdateClusterStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateClusterStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClusterStatusResponse) ProtoMessage() {}
+
+func (x *UpdateClusterStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClusterStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClusterStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{7}
+}
+
+type UpdateClusterStatusDeltaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// session_id must be the one of the last full status sent by UpdateClusterStatus.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// sequence_number must be the sequence number of the last accepted update plus one.
+	SequenceNumber int64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// updated_gpu_nodes replace all GPU nodes that have the same node names.
+	UpdatedGpuNodes []*GpuNode `protobuf:"bytes,3,rep,name=updated_gpu_nodes,json=updatedGpuNodes,proto3" json:"updated_gpu_nodes,omitempty"`
+	// deleted_node_names are the names of the nodes that have been deleted or no longer have GPUs.
+	DeletedNodeNames []string `protobuf:"bytes,4,rep,name=deleted_node_names,json=deletedNodeNames,proto3" json:"deleted_node_names,omitempty"`
+	// updated_gpu_pods are added or replace the GPU pods that have the same namespaced names.
+	UpdatedGpuPods []*GpuPod `protobuf:"bytes,5,rep,name=updated_gpu_pods,json=updatedGpuPods,proto3" json:"updated_gpu_pods,omitempty"`
+	// deleted_pod_names are the namespaced names of the GPU pods that have been deleted or are no longer running.
+	DeletedPodNames []string `protobuf:"bytes,6,rep,name=deleted_pod_names,json=deletedPodNames,proto3" json:"deleted_pod_names,omitempty"`
+	// provisionable_resources replace all provisionable resources if provisionable_resources_updated is true.
+	ProvisionableResources        []*ProvisionableResource `protobuf:"bytes,7,rep,name=provisionable_resources,json=provisionableResources,proto3" json:"provisionable_resources,omitempty"`
+	ProvisionableResourcesUpdated bool                     `protobuf:"varint,8,opt,name=provisionable_resources_updated,json=provisionableResourcesUpdated,proto3" json:"provisionable_resources_updated,omitempty"`
+	// updated_nodes replace the nodes that have the same names.
+	UpdatedNodes []*Node `protobuf:"bytes,9,rep,name=updated_nodes,json=updatedNodes,proto3" json:"updated_nodes,omitempty"`
+	// deleted_nodes are the names of the nodes that have been deleted or are no longer schedulable.
+	DeletedNodes []string `protobuf:"bytes,10,rep,name=deleted_nodes,json=deletedNodes,proto3" json:"deleted_nodes,omitempty"`
+	// namespace_quotas replace all namespace quotas if namespace_quotas_updated is true.
+	NamespaceQuotas        []*NamespaceQuota `protobuf:"bytes,11,rep,name=namespace_quotas,json=namespaceQuotas,proto3" json:"namespace_quotas,omitempty"`
+	NamespaceQuotasUpdated bool              `protobuf:"varint,12,opt,name=namespace_quotas_updated,json=namespaceQuotasUpdated,proto3" json:"namespace_quotas_updated,omitempty"`
+}
+
+func (x *UpdateClusterStatusDeltaRequest) Reset() {
+	*x = UpdateClusterStatusDeltaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateClusterStatusDeltaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClusterStatusDeltaRequest) ProtoMessage() {}
+
+func (x *UpdateClusterStatusDeltaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClusterStatusDeltaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClusterStatusDeltaRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetUpdatedGpuNodes() []*GpuNode {
+	if x != nil {
+		return x.UpdatedGpuNodes
+	}
+	return nil
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetDeletedNodeNames() []string {
+	if x != nil {
+		return x.DeletedNodeNames
+	}
+	return nil
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetUpdatedGpuPods() []*GpuPod {
+	if x != nil {
+		return x.UpdatedGpuPods
+	}
+	return nil
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetDeletedPodNames() []string {
+	if x != nil {
+		return x.DeletedPodNames
+	}
+	return nil
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetProvisionableResources() []*ProvisionableResource {
+	if x != nil {
+		return x.ProvisionableResources
+	}
+	return nil
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetProvisionableResourcesUpdated() bool {
+	if x != nil {
+		return x.ProvisionableResourcesUpdated
+	}
+	return false
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetUpdatedNodes() []*Node {
+	if x != nil {
+		return x.UpdatedNodes
+	}
+	return nil
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetDeletedNodes() []string {
+	if x != nil {
+		return x.DeletedNodes
+	}
+	return nil
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetNamespaceQuotas() []*NamespaceQuota {
+	if x != nil {
+		return x.NamespaceQuotas
+	}
+	return nil
+}
+
+func (x *UpdateClusterStatusDeltaRequest) GetNamespaceQuotasUpdated() bool {
+	if x != nil {
+		return x.NamespaceQuotasUpdated
+	}
+	return false
+}
+
+type UpdateClusterStatusDeltaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resync_required is true when the delta is not applied because the server has missed preceding updates.
+	// The dispatcher must send the full status with UpdateClusterStatus.
+	ResyncRequired bool `protobuf:"varint,1,opt,name=resync_required,json=resyncRequired,proto3" json:"resync_required,omitempty"`
+}
+
+func (x *UpdateClusterStatusDeltaResponse) Reset() {
+	*x = UpdateClusterStatusDeltaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateClusterStatusDeltaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClusterStatusDeltaResponse) ProtoMessage() {}
+
+func (x *UpdateClusterStatusDeltaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClusterStatusDeltaResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClusterStatusDeltaResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateClusterStatusDeltaResponse) GetResyncRequired() bool {
+	if x != nil {
+		return x.ResyncRequired
+	}
+	return false
+}
+
+// DispatchAction is a queued action of a workload pushed to the dispatcher of the cluster where the workload runs.
+type DispatchAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkloadType DispatchAction_WorkloadType `protobuf:"varint,1,opt,name=workload_type,json=workloadType,proto3,enum=llmariner.jobs.server.v1.DispatchAction_WorkloadType" json:"workload_type,omitempty"`
+	// id is the ID of the workload.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// action is the queued action of the workload (e.g., "creating").
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// cursor is the position of the action in the stream. It is a sequence number that increases every time
+	// an action is queued. The dispatcher resumes the stream after the cursor of the workload type when it reconnects.
+	Cursor int64 `protobuf:"varint,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *DispatchAction) Reset() {
+	*x = DispatchAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchAction) ProtoMessage() {}
+
+func (x *DispatchAction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchAction.ProtoReflect.Descriptor instead.
+func (*DispatchAction) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DispatchAction) GetWorkloadType() DispatchAction_WorkloadType {
+	if x != nil {
+		return x.WorkloadType
+	}
+	return DispatchAction_WORKLOAD_TYPE_UNSPECIFIED
+}
+
+func (x *DispatchAction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DispatchAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DispatchAction) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+// DispatchCursor is the cursor of a workload type. All the actions of the workload type up to the cursor
+// have been processed.
+type DispatchCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkloadType DispatchAction_WorkloadType `protobuf:"varint,1,opt,name=workload_type,json=workloadType,proto3,enum=llmariner.jobs.server.v1.DispatchAction_WorkloadType" json:"workload_type,omitempty"`
+	Cursor       int64                       `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *DispatchCursor) Reset() {
+	*x = DispatchCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchCursor) ProtoMessage() {}
+
+func (x *DispatchCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchCursor.ProtoReflect.Descriptor instead.
+func (*DispatchCursor) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DispatchCursor) GetWorkloadType() DispatchAction_WorkloadType {
+	if x != nil {
+		return x.WorkloadType
+	}
+	return DispatchAction_WORKLOAD_TYPE_UNSPECIFIED
+}
+
+func (x *DispatchCursor) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+type StreamQueuedActionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*StreamQueuedActionsRequest_Subscribe_
+	//	*StreamQueuedActionsRequest_Ack_
+	Message isStreamQueuedActionsRequest_Message `protobuf_oneof:"message"`
+}
+
+func (x *StreamQueuedActionsRequest) Reset() {
+	*x = StreamQueuedActionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamQueuedActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQueuedActionsRequest) ProtoMessage() {}
+
+func (x *StreamQueuedActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQueuedActionsRequest.ProtoReflect.Descriptor instead.
+func (*StreamQueuedActionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *StreamQueuedActionsRequest) GetMessage() isStreamQueuedActionsRequest_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *StreamQueuedActionsRequest) GetSubscribe() *StreamQueuedActionsRequest_Subscribe {
+	if x, ok := x.GetMessage().(*StreamQueuedActionsRequest_Subscribe_); ok {
+		return x.Subscribe
+	}
+	return nil
+}
+
+func (x *StreamQueuedActionsRequest) GetAck() *StreamQueuedActionsRequest_Ack {
+	if x, ok := x.GetMessage().(*StreamQueuedActionsRequest_Ack_); ok {
+		return x.Ack
+	}
+	return nil
+}
+
+type isStreamQueuedActionsRequest_Message interface {
+	isStreamQueuedActionsRequest_Message()
+}
+
+type StreamQueuedActionsRequest_Subscribe_ struct {
+	Subscribe *StreamQueuedActionsRequest_Subscribe `protobuf:"bytes,1,opt,name=subscribe,proto3,oneof"`
+}
+
+type StreamQueuedActionsRequest_Ack_ struct {
+	Ack *StreamQueuedActionsRequest_Ack `protobuf:"bytes,2,opt,name=ack,proto3,oneof"`
+}
+
+func (*StreamQueuedActionsRequest_Subscribe_) isStreamQueuedActionsRequest_Message() {}
+
+func (*StreamQueuedActionsRequest_Ack_) isStreamQueuedActionsRequest_Message() {}
+
+type StreamQueuedActionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action *DispatchAction `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *StreamQueuedActionsResponse) Reset() {
+	*x = StreamQueuedActionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamQueuedActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQueuedActionsResponse) ProtoMessage() {}
+
+func (x *StreamQueuedActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQueuedActionsResponse.ProtoReflect.Descriptor instead.
+func (*StreamQueuedActionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StreamQueuedActionsResponse) GetAction() *DispatchAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type UpdateLastDispatchErrorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkloadType DispatchAction_WorkloadType `protobuf:"varint,1,opt,name=workload_type,json=workloadType,proto3,enum=llmariner.jobs.server.v1.DispatchAction_WorkloadType" json:"workload_type,omitempty"`
+	Id           string                      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// error is the error of the last attempt to process the queued action of the workload. An empty error
+	// clears the error.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *UpdateLastDispatchErrorRequest) Reset() {
+	*x = UpdateLastDispatchErrorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLastDispatchErrorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLastDispatchErrorRequest) ProtoMessage() {}
+
+func (x *UpdateLastDispatchErrorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLastDispatchErrorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLastDispatchErrorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateLastDispatchErrorRequest) GetWorkloadType() DispatchAction_WorkloadType {
+	if x != nil {
+		return x.WorkloadType
+	}
+	return DispatchAction_WORKLOAD_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateLastDispatchErrorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLastDispatchErrorRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateLastDispatchErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateLastDispatchErrorResponse) Reset() {
+	*x = UpdateLastDispatchErrorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLastDispatchErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLastDispatchErrorResponse) ProtoMessage() {}
+
+func (x *UpdateLastDispatchErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLastDispatchErrorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLastDispatchErrorResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{15}
+}
+
+type ListActiveWorkloadsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListActiveWorkloadsRequest) Reset() {
+	*x = ListActiveWorkloadsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListActiveWorkloadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActiveWorkloadsRequest) ProtoMessage() {}
+
+func (x *ListActiveWorkloadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActiveWorkloadsRequest.ProtoReflect.Descriptor instead.
+func (*ListActiveWorkloadsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{16}
+}
+
+type ListActiveWorkloadsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// workloads are the workloads assigned to the cluster that have not reached a terminal state.
+	Workloads []*ListActiveWorkloadsResponse_Workload `protobuf:"bytes,1,rep,name=workloads,proto3" json:"workloads,omitempty"`
+}
+
+func (x *ListActiveWorkloadsResponse) Reset() {
+	*x = ListActiveWorkloadsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListActiveWorkloadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActiveWorkloadsResponse) ProtoMessage() {}
+
+func (x *ListActiveWorkloadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActiveWorkloadsResponse.ProtoReflect.Descriptor instead.
+func (*ListActiveWorkloadsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListActiveWorkloadsResponse) GetWorkloads() []*ListActiveWorkloadsResponse_Workload {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+// Subscribe starts the stream. It must be the first message of the stream.
+type StreamQueuedActionsRequest_Subscribe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cursors are the cursors of the workload types. The server pushes the actions after the cursor of
+	// their workload type. All queued actions of a workload type are pushed if it has no cursor.
+	Cursors []*DispatchCursor `protobuf:"bytes,1,rep,name=cursors,proto3" json:"cursors,omitempty"`
+}
+
+func (x *StreamQueuedActionsRequest_Subscribe) Reset() {
+	*x = StreamQueuedActionsRequest_Subscribe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamQueuedActionsRequest_Subscribe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQueuedActionsRequest_Subscribe) ProtoMessage() {}
+
+func (x *StreamQueuedActionsRequest_Subscribe) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQueuedActionsRequest_Subscribe.ProtoReflect.Descriptor instead.
+func (*StreamQueuedActionsRequest_Subscribe) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *StreamQueuedActionsRequest_Subscribe) GetCursors() []*DispatchCursor {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+// Ack acknowledges the result of a pushed action.
+type StreamQueuedActionsRequest_Ack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cursor int64  `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// error is the error that occurred while processing the action. Empty if the action succeeded.
+	Error        string                      `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	WorkloadType DispatchAction_WorkloadType `protobuf:"varint,4,opt,name=workload_type,json=workloadType,proto3,enum=llmariner.jobs.server.v1.DispatchAction_WorkloadType" json:"workload_type,omitempty"`
 }
 
-func (x *UpdateClusterStatusResponse) Reset() {
-	*x = UpdateClusterStatusResponse{}
+func (x *StreamQueuedActionsRequest_Ack) Reset() {
+	*x = StreamQueuedActionsRequest_Ack{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[5]
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateClusterStatusResponse) String() string {
+func (x *StreamQueuedActionsRequest_Ack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateClusterStatusResponse) ProtoMessage() {}
+func (*StreamQueuedActionsRequest_Ack) ProtoMessage() {}
 
-func (x *UpdateClusterStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[5]
+func (x *StreamQueuedActionsRequest_Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,9 +1501,111 @@ func (x *UpdateClusterStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateClusterStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateClusterStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use StreamQueuedActionsRequest_Ack.ProtoReflect.Descriptor instead.
+func (*StreamQueuedActionsRequest_Ack) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *StreamQueuedActionsRequest_Ack) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamQueuedActionsRequest_Ack) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *StreamQueuedActionsRequest_Ack) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StreamQueuedActionsRequest_Ack) GetWorkloadType() DispatchAction_WorkloadType {
+	if x != nil {
+		return x.WorkloadType
+	}
+	return DispatchAction_WORKLOAD_TYPE_UNSPECIFIED
+}
+
+type ListActiveWorkloadsResponse_Workload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkloadType DispatchAction_WorkloadType `protobuf:"varint,1,opt,name=workload_type,json=workloadType,proto3,enum=llmariner.jobs.server.v1.DispatchAction_WorkloadType" json:"workload_type,omitempty"`
+	Id           string                      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// running is true if the Kubernetes resources of the workload have been created and the workload is
+	// expected to be running (e.g., a running job or an initializing notebook).
+	Running bool `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	// kubernetes_namespace is the namespace of the Kubernetes resources of the workload.
+	KubernetesNamespace string `protobuf:"bytes,4,opt,name=kubernetes_namespace,json=kubernetesNamespace,proto3" json:"kubernetes_namespace,omitempty"`
+}
+
+func (x *ListActiveWorkloadsResponse_Workload) Reset() {
+	*x = ListActiveWorkloadsResponse_Workload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListActiveWorkloadsResponse_Workload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActiveWorkloadsResponse_Workload) ProtoMessage() {}
+
+func (x *ListActiveWorkloadsResponse_Workload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_job_manager_server_worker_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActiveWorkloadsResponse_Workload.ProtoReflect.Descriptor instead.
+func (*ListActiveWorkloadsResponse_Workload) Descriptor() ([]byte, []int) {
+	return file_api_v1_job_manager_server_worker_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *ListActiveWorkloadsResponse_Workload) GetWorkloadType() DispatchAction_WorkloadType {
+	if x != nil {
+		return x.WorkloadType
+	}
+	return DispatchAction_WORKLOAD_TYPE_UNSPECIFIED
+}
+
+func (x *ListActiveWorkloadsResponse_Workload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListActiveWorkloadsResponse_Workload) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *ListActiveWorkloadsResponse_Workload) GetKubernetesNamespace() string {
+	if x != nil {
+		return x.KubernetesNamespace
+	}
+	return ""
 }
 
 var File_api_v1_job_manager_server_worker_proto protoreflect.FileDescriptor
@@ -351,64 +1615,373 @@ var file_api_v1_job_manager_server_worker_proto_rawDesc = []byte{
 	0x61, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
 	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
 	0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x22, 0x5b, 0x0a, 0x07, 0x47, 0x70, 0x75, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x61,
-	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x7f, 0x0a, 0x06, 0x47, 0x70, 0x75, 0x50, 0x6f, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27,
-	0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x65, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x67, 0x70, 0x75,
-	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c,
-	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x70, 0x75, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x08, 0x67, 0x70, 0x75, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x68, 0x0a, 0x17, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d,
-	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x16, 0x70, 0x72, 0x6f,
+	0x76, 0x31, 0x22, 0x91, 0x02, 0x0a, 0x07, 0x47, 0x70, 0x75, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x69, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x43, 0x70, 0x75, 0x4d, 0x69, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x40, 0x0a,
+	0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x34, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x70, 0x75,
+	0x5f, 0x6d, 0x69, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x70, 0x75, 0x4d, 0x69, 0x6c,
+	0x69, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x67, 0x61, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x06, 0x47, 0x70, 0x75, 0x50, 0x6f, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xd0, 0x07, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x69,
+	0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x70, 0x75, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x70, 0x75, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x70, 0x75, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x47, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a,
+	0x0a, 0x67, 0x70, 0x75, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f,
+	0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
 	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x67, 0x70, 0x75, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
-	0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x70, 0x75, 0x50, 0x6f, 0x64, 0x52, 0x07, 0x67, 0x70, 0x75, 0x50, 0x6f, 0x64, 0x73,
-	0x22, 0x6c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e,
-	0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x63, 0x65, 0x2e, 0x47, 0x70, 0x75, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x67, 0x70, 0x75, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x10,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x67, 0x70, 0x75, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x65, 0x64, 0x47, 0x70, 0x75, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x47, 0x70, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x67, 0x70,
+	0x75, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x70,
+	0x75, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x70, 0x75,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x75, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x70, 0x75, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x70, 0x75, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x67, 0x70, 0x75, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x67, 0x61, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x47, 0x70, 0x75, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65,
+	0x64, 0x47, 0x70, 0x75, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x70,
+	0x75, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x70, 0x75, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb0, 0x02, 0x0a, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x68, 0x61, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x48,
+	0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x68, 0x61, 0x72, 0x64, 0x12, 0x46,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x1a, 0x37, 0x0a, 0x09, 0x48, 0x61, 0x72, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x37, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x04, 0x0a, 0x0d, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x67, 0x70,
+	0x75, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x70, 0x75, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x08, 0x67, 0x70, 0x75, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x68, 0x0a, 0x17, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x16, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x67, 0x70, 0x75, 0x5f, 0x70, 0x6f, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
 	0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1d,
-	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x99, 0x01,
-	0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x31, 0x2e, 0x47, 0x70, 0x75, 0x50, 0x6f, 0x64, 0x52, 0x07, 0x67, 0x70, 0x75, 0x50, 0x6f, 0x64,
+	0x73, 0x12, 0x34, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x0f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x06, 0x0a, 0x1f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x67, 0x70, 0x75, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f,
+	0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x70, 0x75,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x47, 0x70, 0x75,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x67,
+	0x70, 0x75, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x70, 0x75, 0x50, 0x6f, 0x64, 0x52,
+	0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x47, 0x70, 0x75, 0x50, 0x6f, 0x64, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x68, 0x0a, 0x17, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x16, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a,
+	0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f,
+	0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x0f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x38, 0x0a, 0x18,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x22, 0x81, 0x02, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x53, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x4f, 0x52, 0x4b, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x4a, 0x4f, 0x42, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f,
+	0x54, 0x45, 0x42, 0x4f, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x4a, 0x4f, 0x42, 0x10, 0x03, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x5a, 0x0a, 0x0d, 0x77, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f,
+	0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xc8,
+	0x03, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5e, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x48, 0x00, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x4c, 0x0a,
+	0x03, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6c, 0x6c, 0x6d,
 	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62,
-	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
-	0x72, 0x2f, 0x6a, 0x6f, 0x62, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x1a, 0x4f, 0x0a, 0x09, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x52, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x1a, 0x9f, 0x01, 0x0a,
+	0x03, 0x41, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x5a, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5f, 0x0a, 0x1b, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x1e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a,
+	0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x77, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xc1, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x1a, 0xc3,
+	0x01, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x5a, 0x0a, 0x0d, 0x77,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x31, 0x0a, 0x14, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x32, 0xd4, 0x05, 0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f,
+	0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x93, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x39, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x90, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74,
+	0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x34, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x29, 0x5a, 0x27, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2f, 0x6a, 0x6f, 0x62, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -423,27 +1996,81 @@ func file_api_v1_job_manager_server_worker_proto_rawDescGZIP() []byte {
 	return file_api_v1_job_manager_server_worker_proto_rawDescData
 }
 
-var file_api_v1_job_manager_server_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_job_manager_server_worker_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_job_manager_server_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_api_v1_job_manager_server_worker_proto_goTypes = []interface{}{
-	(*GpuNode)(nil),                     // 0: llmariner.jobs.server.v1.GpuNode
-	(*GpuPod)(nil),                      // 1: llmariner.jobs.server.v1.GpuPod
-	(*ProvisionableResource)(nil),       // 2: llmariner.jobs.server.v1.ProvisionableResource
-	(*ClusterStatus)(nil),               // 3: llmariner.jobs.server.v1.ClusterStatus
-	(*UpdateClusterStatusRequest)(nil),  // 4: llmariner.jobs.server.v1.UpdateClusterStatusRequest
-	(*UpdateClusterStatusResponse)(nil), // 5: llmariner.jobs.server.v1.UpdateClusterStatusResponse
+	(DispatchAction_WorkloadType)(0),         // 0: llmariner.jobs.server.v1.DispatchAction.WorkloadType
+	(*GpuNode)(nil),                          // 1: llmariner.jobs.server.v1.GpuNode
+	(*Node)(nil),                             // 2: llmariner.jobs.server.v1.Node
+	(*GpuPod)(nil),                           // 3: llmariner.jobs.server.v1.GpuPod
+	(*ProvisionableResource)(nil),            // 4: llmariner.jobs.server.v1.ProvisionableResource
+	(*NamespaceQuota)(nil),                   // 5: llmariner.jobs.server.v1.NamespaceQuota
+	(*ClusterStatus)(nil),                    // 6: llmariner.jobs.server.v1.ClusterStatus
+	(*UpdateClusterStatusRequest)(nil),       // 7: llmariner.jobs.server.v1.UpdateClusterStatusRequest
+	(*UpdateClusterStatusResponse)(nil),      // 8: llmariner.jobs.server.v1.UpdateClusterStatusResponse
+	(*UpdateClusterStatusDeltaRequest)(nil),  // 9: llmariner.jobs.server.v1.UpdateClusterStatusDeltaRequest
+	(*UpdateClusterStatusDeltaResponse)(nil), // 10: llmariner.jobs.server.v1.UpdateClusterStatusDeltaResponse
+	(*DispatchAction)(nil),                   // 11: llmariner.jobs.server.v1.DispatchAction
+	(*DispatchCursor)(nil),                   // 12: llmariner.jobs.server.v1.DispatchCursor
+	(*StreamQueuedActionsRequest)(nil),       // 13: llmariner.jobs.server.v1.StreamQueuedActionsRequest
+	(*StreamQueuedActionsResponse)(nil),      // 14: llmariner.jobs.server.v1.StreamQueuedActionsResponse
+	(*UpdateLastDispatchErrorRequest)(nil),   // 15: llmariner.jobs.server.v1.UpdateLastDispatchErrorRequest
+	(*UpdateLastDispatchErrorResponse)(nil),  // 16: llmariner.jobs.server.v1.UpdateLastDispatchErrorResponse
+	(*ListActiveWorkloadsRequest)(nil),       // 17: llmariner.jobs.server.v1.ListActiveWorkloadsRequest
+	(*ListActiveWorkloadsResponse)(nil),      // 18: llmariner.jobs.server.v1.ListActiveWorkloadsResponse
+	nil,                                      // 19: llmariner.jobs.server.v1.ProvisionableResource.GpuLimitsEntry
+	nil,                                      // 20: llmariner.jobs.server.v1.ProvisionableResource.ProvisionedGpusEntry
+	nil,                                      // 21: llmariner.jobs.server.v1.ProvisionableResource.NodeGpusEntry
+	nil,                                      // 22: llmariner.jobs.server.v1.NamespaceQuota.HardEntry
+	nil,                                      // 23: llmariner.jobs.server.v1.NamespaceQuota.UsedEntry
+	nil,                                      // 24: llmariner.jobs.server.v1.ClusterStatus.LabelsEntry
+	(*StreamQueuedActionsRequest_Subscribe)(nil), // 25: llmariner.jobs.server.v1.StreamQueuedActionsRequest.Subscribe
+	(*StreamQueuedActionsRequest_Ack)(nil),       // 26: llmariner.jobs.server.v1.StreamQueuedActionsRequest.Ack
+	(*ListActiveWorkloadsResponse_Workload)(nil), // 27: llmariner.jobs.server.v1.ListActiveWorkloadsResponse.Workload
 }
 var file_api_v1_job_manager_server_worker_proto_depIdxs = []int32{
-	0, // 0: llmariner.jobs.server.v1.ClusterStatus.gpu_nodes:type_name -> llmariner.jobs.server.v1.GpuNode
-	2, // 1: llmariner.jobs.server.v1.ClusterStatus.provisionable_resources:type_name -> llmariner.jobs.server.v1.ProvisionableResource
-	1, // 2: llmariner.jobs.server.v1.ClusterStatus.gpu_pods:type_name -> llmariner.jobs.server.v1.GpuPod
-	3, // 3: llmariner.jobs.server.v1.UpdateClusterStatusRequest.cluster_status:type_name -> llmariner.jobs.server.v1.ClusterStatus
-	4, // 4: llmariner.jobs.server.v1.JobWorkerService.UpdateClusterStatus:input_type -> llmariner.jobs.server.v1.UpdateClusterStatusRequest
-	5, // 5: llmariner.jobs.server.v1.JobWorkerService.UpdateClusterStatus:output_type -> llmariner.jobs.server.v1.UpdateClusterStatusResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	19, // 0: llmariner.jobs.server.v1.ProvisionableResource.gpu_limits:type_name -> llmariner.jobs.server.v1.ProvisionableResource.GpuLimitsEntry
+	20, // 1: llmariner.jobs.server.v1.ProvisionableResource.provisioned_gpus:type_name -> llmariner.jobs.server.v1.ProvisionableResource.ProvisionedGpusEntry
+	21, // 2: llmariner.jobs.server.v1.ProvisionableResource.node_gpus:type_name -> llmariner.jobs.server.v1.ProvisionableResource.NodeGpusEntry
+	22, // 3: llmariner.jobs.server.v1.NamespaceQuota.hard:type_name -> llmariner.jobs.server.v1.NamespaceQuota.HardEntry
+	23, // 4: llmariner.jobs.server.v1.NamespaceQuota.used:type_name -> llmariner.jobs.server.v1.NamespaceQuota.UsedEntry
+	1,  // 5: llmariner.jobs.server.v1.ClusterStatus.gpu_nodes:type_name -> llmariner.jobs.server.v1.GpuNode
+	4,  // 6: llmariner.jobs.server.v1.ClusterStatus.provisionable_resources:type_name -> llmariner.jobs.server.v1.ProvisionableResource
+	3,  // 7: llmariner.jobs.server.v1.ClusterStatus.gpu_pods:type_name -> llmariner.jobs.server.v1.GpuPod
+	2,  // 8: llmariner.jobs.server.v1.ClusterStatus.nodes:type_name -> llmariner.jobs.server.v1.Node
+	24, // 9: llmariner.jobs.server.v1.ClusterStatus.labels:type_name -> llmariner.jobs.server.v1.ClusterStatus.LabelsEntry
+	5,  // 10: llmariner.jobs.server.v1.ClusterStatus.namespace_quotas:type_name -> llmariner.jobs.server.v1.NamespaceQuota
+	6,  // 11: llmariner.jobs.server.v1.UpdateClusterStatusRequest.cluster_status:type_name -> llmariner.jobs.server.v1.ClusterStatus
+	1,  // 12: llmariner.jobs.server.v1.UpdateClusterStatusDeltaRequest.updated_gpu_nodes:type_name -> llmariner.jobs.server.v1.GpuNode
+	3,  // 13: llmariner.jobs.server.v1.UpdateClusterStatusDeltaRequest.updated_gpu_pods:type_name -> llmariner.jobs.server.v1.GpuPod
+	4,  // 14: llmariner.jobs.server.v1.UpdateClusterStatusDeltaRequest.provisionable_resources:type_name -> llmariner.jobs.server.v1.ProvisionableResource
+	2,  // 15: llmariner.jobs.server.v1.UpdateClusterStatusDeltaRequest.updated_nodes:type_name -> llmariner.jobs.server.v1.Node
+	5,  // 16: llmariner.jobs.server.v1.UpdateClusterStatusDeltaRequest.namespace_quotas:type_name -> llmariner.jobs.server.v1.NamespaceQuota
+	0,  // 17: llmariner.jobs.server.v1.DispatchAction.workload_type:type_name -> llmariner.jobs.server.v1.DispatchAction.WorkloadType
+	0,  // 18: llmariner.jobs.server.v1.DispatchCursor.workload_type:type_name -> llmariner.jobs.server.v1.DispatchAction.WorkloadType
+	25, // 19: llmariner.jobs.server.v1.StreamQueuedActionsRequest.subscribe:type_name -> llmariner.jobs.server.v1.StreamQueuedActionsRequest.Subscribe
+	26, // 20: llmariner.jobs.server.v1.StreamQueuedActionsRequest.ack:type_name -> llmariner.jobs.server.v1.StreamQueuedActionsRequest.Ack
+	11, // 21: llmariner.jobs.server.v1.StreamQueuedActionsResponse.action:type_name -> llmariner.jobs.server.v1.DispatchAction
+	0,  // 22: llmariner.jobs.server.v1.UpdateLastDispatchErrorRequest.workload_type:type_name -> llmariner.jobs.server.v1.DispatchAction.WorkloadType
+	27, // 23: llmariner.jobs.server.v1.ListActiveWorkloadsResponse.workloads:type_name -> llmariner.jobs.server.v1.ListActiveWorkloadsResponse.Workload
+	12, // 24: llmariner.jobs.server.v1.StreamQueuedActionsRequest.Subscribe.cursors:type_name -> llmariner.jobs.server.v1.DispatchCursor
+	0,  // 25: llmariner.jobs.server.v1.StreamQueuedActionsRequest.Ack.workload_type:type_name -> llmariner.jobs.server.v1.DispatchAction.WorkloadType
+	0,  // 26: llmariner.jobs.server.v1.ListActiveWorkloadsResponse.Workload.workload_type:type_name -> llmariner.jobs.server.v1.DispatchAction.WorkloadType
+	7,  // 27: llmariner.jobs.server.v1.JobWorkerService.UpdateClusterStatus:input_type -> llmariner.jobs.server.v1.UpdateClusterStatusRequest
+	9,  // 28: llmariner.jobs.server.v1.JobWorkerService.UpdateClusterStatusDelta:input_type -> llmariner.jobs.server.v1.UpdateClusterStatusDeltaRequest
+	13, // 29: llmariner.jobs.server.v1.JobWorkerService.StreamQueuedActions:input_type -> llmariner.jobs.server.v1.StreamQueuedActionsRequest
+	15, // 30: llmariner.jobs.server.v1.JobWorkerService.UpdateLastDispatchError:input_type -> llmariner.jobs.server.v1.UpdateLastDispatchErrorRequest
+	17, // 31: llmariner.jobs.server.v1.JobWorkerService.ListActiveWorkloads:input_type -> llmariner.jobs.server.v1.ListActiveWorkloadsRequest
+	8,  // 32: llmariner.jobs.server.v1.JobWorkerService.UpdateClusterStatus:output_type -> llmariner.jobs.server.v1.UpdateClusterStatusResponse
+	10, // 33: llmariner.jobs.server.v1.JobWorkerService.UpdateClusterStatusDelta:output_type -> llmariner.jobs.server.v1.UpdateClusterStatusDeltaResponse
+	14, // 34: llmariner.jobs.server.v1.JobWorkerService.StreamQueuedActions:output_type -> llmariner.jobs.server.v1.StreamQueuedActionsResponse
+	16, // 35: llmariner.jobs.server.v1.JobWorkerService.UpdateLastDispatchError:output_type -> llmariner.jobs.server.v1.UpdateLastDispatchErrorResponse
+	18, // 36: llmariner.jobs.server.v1.JobWorkerService.ListActiveWorkloads:output_type -> llmariner.jobs.server.v1.ListActiveWorkloadsResponse
+	32, // [32:37] is the sub-list for method output_type
+	27, // [27:32] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_job_manager_server_worker_proto_init() }
@@ -465,7 +2092,7 @@ func file_api_v1_job_manager_server_worker_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_worker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GpuPod); i {
+			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -477,7 +2104,7 @@ func file_api_v1_job_manager_server_worker_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_worker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProvisionableResource); i {
+			switch v := v.(*GpuPod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -489,7 +2116,7 @@ func file_api_v1_job_manager_server_worker_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_worker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterStatus); i {
+			switch v := v.(*ProvisionableResource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -501,7 +2128,7 @@ func file_api_v1_job_manager_server_worker_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_worker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateClusterStatusRequest); i {
+			switch v := v.(*NamespaceQuota); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -513,6 +2140,30 @@ func file_api_v1_job_manager_server_worker_proto_init() {
 			}
 		}
 		file_api_v1_job_manager_server_worker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateClusterStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateClusterStatusResponse); i {
 			case 0:
 				return &v.state
@@ -524,19 +2175,181 @@ func file_api_v1_job_manager_server_worker_proto_init() {
 				return nil
 			}
 		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateClusterStatusDeltaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateClusterStatusDeltaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamQueuedActionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamQueuedActionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLastDispatchErrorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLastDispatchErrorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListActiveWorkloadsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListActiveWorkloadsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamQueuedActionsRequest_Subscribe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamQueuedActionsRequest_Ack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_job_manager_server_worker_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListActiveWorkloadsResponse_Workload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_api_v1_job_manager_server_worker_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_api_v1_job_manager_server_worker_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*StreamQueuedActionsRequest_Subscribe_)(nil),
+		(*StreamQueuedActionsRequest_Ack_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_job_manager_server_worker_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_job_manager_server_worker_proto_goTypes,
 		DependencyIndexes: file_api_v1_job_manager_server_worker_proto_depIdxs,
+		EnumInfos:         file_api_v1_job_manager_server_worker_proto_enumTypes,
 		MessageInfos:      file_api_v1_job_manager_server_worker_proto_msgTypes,
 	}.Build()
 	File_api_v1_job_manager_server_worker_proto = out.File
@@ -20,6 +20,15 @@ const _ = grpc.SupportPackageIsVersion7
 type JobWorkerServiceClient interface {
 	// UpdateClusterStatus updates the status of the cluster.
 	UpdateClusterStatus(ctx context.Context, in *UpdateClusterStatusRequest, opts ...grpc.CallOption) (*UpdateClusterStatusResponse, error)
+	// UpdateClusterStatusDelta updates the status of the cluster with the changes since the last update.
+	UpdateClusterStatusDelta(ctx context.Context, in *UpdateClusterStatusDeltaRequest, opts ...grpc.CallOption) (*UpdateClusterStatusDeltaResponse, error)
+	// StreamQueuedActions pushes the queued actions of the cluster as they are created. The dispatcher
+	// acknowledges each action with its result.
+	StreamQueuedActions(ctx context.Context, opts ...grpc.CallOption) (JobWorkerService_StreamQueuedActionsClient, error)
+	// UpdateLastDispatchError updates the error of the last attempt to process the queued action of a workload.
+	UpdateLastDispatchError(ctx context.Context, in *UpdateLastDispatchErrorRequest, opts ...grpc.CallOption) (*UpdateLastDispatchErrorResponse, error)
+	// ListActiveWorkloads lists the workloads of the cluster that have not reached a terminal state.
+	ListActiveWorkloads(ctx context.Context, in *ListActiveWorkloadsRequest, opts ...grpc.CallOption) (*ListActiveWorkloadsResponse, error)
 }
 
 type jobWorkerServiceClient struct {
@@ -39,12 +48,79 @@ func (c *jobWorkerServiceClient) UpdateClusterStatus(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *jobWorkerServiceClient) UpdateClusterStatusDelta(ctx context.Context, in *UpdateClusterStatusDeltaRequest, opts ...grpc.CallOption) (*UpdateClusterStatusDeltaResponse, error) {
+	out := new(UpdateClusterStatusDeltaResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobWorkerService/UpdateClusterStatusDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobWorkerServiceClient) StreamQueuedActions(ctx context.Context, opts ...grpc.CallOption) (JobWorkerService_StreamQueuedActionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JobWorkerService_ServiceDesc.Streams[0], "/llmariner.jobs.server.v1.JobWorkerService/StreamQueuedActions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobWorkerServiceStreamQueuedActionsClient{stream}
+	return x, nil
+}
+
+type JobWorkerService_StreamQueuedActionsClient interface {
+	Send(*StreamQueuedActionsRequest) error
+	Recv() (*StreamQueuedActionsResponse, error)
+	grpc.ClientStream
+}
+
+type jobWorkerServiceStreamQueuedActionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobWorkerServiceStreamQueuedActionsClient) Send(m *StreamQueuedActionsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *jobWorkerServiceStreamQueuedActionsClient) Recv() (*StreamQueuedActionsResponse, error) {
+	m := new(StreamQueuedActionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobWorkerServiceClient) UpdateLastDispatchError(ctx context.Context, in *UpdateLastDispatchErrorRequest, opts ...grpc.CallOption) (*UpdateLastDispatchErrorResponse, error) {
+	out := new(UpdateLastDispatchErrorResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobWorkerService/UpdateLastDispatchError", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobWorkerServiceClient) ListActiveWorkloads(ctx context.Context, in *ListActiveWorkloadsRequest, opts ...grpc.CallOption) (*ListActiveWorkloadsResponse, error) {
+	out := new(ListActiveWorkloadsResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.jobs.server.v1.JobWorkerService/ListActiveWorkloads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobWorkerServiceServer is the server API for JobWorkerService service.
 // All implementations must embed UnimplementedJobWorkerServiceServer
 // for forward compatibility
 type JobWorkerServiceServer interface {
 	// UpdateClusterStatus updates the status of the cluster.
 	UpdateClusterStatus(context.Context, *UpdateClusterStatusRequest) (*UpdateClusterStatusResponse, error)
+	// UpdateClusterStatusDelta updates the status of the cluster with the changes since the last update.
+	UpdateClusterStatusDelta(context.Context, *UpdateClusterStatusDeltaRequest) (*UpdateClusterStatusDeltaResponse, error)
+	// StreamQueuedActions pushes the queued actions of the cluster as they are created. The dispatcher
+	// acknowledges each action with its result.
+	StreamQueuedActions(JobWorkerService_StreamQueuedActionsServer) error
+	// UpdateLastDispatchError updates the error of the last attempt to process the queued action of a workload.
+	UpdateLastDispatchError(context.Context, *UpdateLastDispatchErrorRequest) (*UpdateLastDispatchErrorResponse, error)
+	// ListActiveWorkloads lists the workloads of the cluster that have not reached a terminal state.
+	ListActiveWorkloads(context.Context, *ListActiveWorkloadsRequest) (*ListActiveWorkloadsResponse, error)
 	mustEmbedUnimplementedJobWorkerServiceServer()
 }
 
@@ -55,6 +131,18 @@ type UnimplementedJobWorkerServiceServer struct {
 func (UnimplementedJobWorkerServiceServer) UpdateClusterStatus(context.Context, *UpdateClusterStatusRequest) (*UpdateClusterStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterStatus not implemented")
 }
+func (UnimplementedJobWorkerServiceServer) UpdateClusterStatusDelta(context.Context, *UpdateClusterStatusDeltaRequest) (*UpdateClusterStatusDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterStatusDelta not implemented")
+}
+func (UnimplementedJobWorkerServiceServer) StreamQueuedActions(JobWorkerService_StreamQueuedActionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamQueuedActions not implemented")
+}
+func (UnimplementedJobWorkerServiceServer) UpdateLastDispatchError(context.Context, *UpdateLastDispatchErrorRequest) (*UpdateLastDispatchErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLastDispatchError not implemented")
+}
+func (UnimplementedJobWorkerServiceServer) ListActiveWorkloads(context.Context, *ListActiveWorkloadsRequest) (*ListActiveWorkloadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActiveWorkloads not implemented")
+}
 func (UnimplementedJobWorkerServiceServer) mustEmbedUnimplementedJobWorkerServiceServer() {}
 
 // UnsafeJobWorkerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -86,6 +174,86 @@ func _JobWorkerService_UpdateClusterStatus_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobWorkerService_UpdateClusterStatusDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClusterStatusDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobWorkerServiceServer).UpdateClusterStatusDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.jobs.server.v1.JobWorkerService/UpdateClusterStatusDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobWorkerServiceServer).UpdateClusterStatusDelta(ctx, req.(*UpdateClusterStatusDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobWorkerService_StreamQueuedActions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JobWorkerServiceServer).StreamQueuedActions(&jobWorkerServiceStreamQueuedActionsServer{stream})
+}
+
+type JobWorkerService_StreamQueuedActionsServer interface {
+	Send(*StreamQueuedActionsResponse) error
+	Recv() (*StreamQueuedActionsRequest, error)
+	grpc.ServerStream
+}
+
+type jobWorkerServiceStreamQueuedActionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobWorkerServiceStreamQueuedActionsServer) Send(m *StreamQueuedActionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *jobWorkerServiceStreamQueuedActionsServer) Recv() (*StreamQueuedActionsRequest, error) {
+	m := new(StreamQueuedActionsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _JobWorkerService_UpdateLastDispatchError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLastDispatchErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobWorkerServiceServer).UpdateLastDispatchError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.jobs.server.v1.JobWorkerService/UpdateLastDispatchError",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobWorkerServiceServer).UpdateLastDispatchError(ctx, req.(*UpdateLastDispatchErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobWorkerService_ListActiveWorkloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActiveWorkloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobWorkerServiceServer).ListActiveWorkloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.jobs.server.v1.JobWorkerService/ListActiveWorkloads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobWorkerServiceServer).ListActiveWorkloads(ctx, req.(*ListActiveWorkloadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobWorkerService_ServiceDesc is the grpc.ServiceDesc for JobWorkerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,7 +265,26 @@ var JobWorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateClusterStatus",
 			Handler:    _JobWorkerService_UpdateClusterStatus_Handler,
 		},
+		{
+			MethodName: "UpdateClusterStatusDelta",
+			Handler:    _JobWorkerService_UpdateClusterStatusDelta_Handler,
+		},
+		{
+			MethodName: "UpdateLastDispatchError",
+			Handler:    _JobWorkerService_UpdateLastDispatchError_Handler,
+		},
+		{
+			MethodName: "ListActiveWorkloads",
+			Handler:    _JobWorkerService_ListActiveWorkloads_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamQueuedActions",
+			Handler:       _JobWorkerService_StreamQueuedActions_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/job_manager_server_worker.proto",
 }
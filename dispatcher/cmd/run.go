@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -140,21 +141,30 @@ func run(ctx context.Context, c *config.Config) error {
 	}
 
 	bjm := dispatcher.NewBatchJobManager(dispatcher.BatchJobManagerOptions{
-		K8sClient:      mgr.GetClient(),
-		S3Client:       s3Client,
-		FileClient:     fclient,
-		BwClient:       bwClient,
-		LlmaBaseURL:    c.Notebook.LLMarinerBaseURL,
-		WandbConfig:    c.Job.WandbAPIKeySecret,
-		KueueConfig:    c.KueueIntegration,
-		WorkloadConfig: c.Workload,
-		S3Bucket:       c.ObjectStore.S3.Bucket,
+		K8sClient:        mgr.GetClient(),
+		S3Client:         s3Client,
+		FileClient:       fclient,
+		BwClient:         bwClient,
+		LlmaBaseURL:      c.Notebook.LLMarinerBaseURL,
+		WandbConfig:      c.Job.WandbAPIKeySecret,
+		KueueConfig:      c.KueueIntegration,
+		WorkloadConfig:   c.Workload,
+		PodFailureConfig: c.PodFailure,
+		S3Bucket:         c.ObjectStore.S3.Bucket,
 	})
 	if err := bjm.SetupWithManager(mgr); err != nil {
 		return err
 	}
 
-	csm := clusterstatus.NewManager(v1.NewJobWorkerServiceClient(jconn), c.ClusterStatusUpdateInterval)
+	jwClient := v1.NewJobWorkerServiceClient(jconn)
+
+	csm := clusterstatus.NewManager(
+		jwClient,
+		c.ClusterStatusUpdateInterval,
+		c.ClusterStatusFullResyncInterval,
+		c.UnhealthyNodeTaintKeys,
+		c.ClusterLabels,
+	)
 	if err := csm.SetupWithManager(mgr); err != nil {
 		return err
 	}
@@ -168,16 +178,51 @@ func run(ctx context.Context, c *config.Config) error {
 		preProcessor = dispatcher.NewPreProcessor(fclient, mclient, s3Client, c.ObjectStore.S3.Bucket, optionalS3Clients)
 		postProcessor = dispatcher.NewPostProcessor(mclient)
 	}
-	if err := dispatcher.New(ftClient, wsClient, bwClient, jc, preProcessor, nbm, bjm, c.PollingInterval).
-		SetupWithManager(mgr); err != nil {
+	if err := dispatcher.New(
+		ftClient,
+		wsClient,
+		bwClient,
+		jwClient,
+		jc,
+		preProcessor,
+		nbm,
+		bjm,
+		c.PollingInterval,
+		c.DispatchStream,
+		c.QueuedAction,
+	).SetupWithManager(mgr); err != nil {
 		return err
 	}
 
-	if err := dispatcher.NewLifecycleManager(ftClient, mgr.GetClient(), postProcessor).
+	if err := dispatcher.NewLifecycleManager(ftClient, mgr.GetClient(), postProcessor, c.PodFailure).
 		SetupWithManager(mgr); err != nil {
 		return err
 	}
 
+	if c.LogArchive.Enable {
+		clientset, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return err
+		}
+		if err := dispatcher.NewLogArchiver(mgr.GetClient(), clientset, s3Client, c.ObjectStore.S3.Bucket, c.LogArchive).
+			SetupWithManager(mgr); err != nil {
+			return err
+		}
+	}
+
+	if c.Reconciliation.Enable {
+		if err := dispatcher.NewWorkloadReconciler(jwClient, ftClient, bwClient, wsClient, c.Reconciliation).
+			SetupWithManager(mgr); err != nil {
+			return err
+		}
+	}
+
+	if c.OrphanCollection.Enable {
+		if err := dispatcher.NewOrphanCollector(jwClient, c.OrphanCollection).SetupWithManager(mgr); err != nil {
+			return err
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		return err
 	}
@@ -0,0 +1,294 @@
+package clusterstatus
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// clusterAutoscalerStatusNamespace and clusterAutoscalerStatusName are the namespace and the name of
+	// the ConfigMap where Cluster Autoscaler writes its status.
+	clusterAutoscalerStatusNamespace = "kube-system"
+	clusterAutoscalerStatusName      = "cluster-autoscaler-status"
+	clusterAutoscalerStatusKey       = "status"
+
+	// Annotations of Cluster API node templates used by Cluster Autoscaler for scaling from zero.
+	// See https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/clusterapi/README.md.
+	caGPUCountAnnotationKey = "capacity.cluster-autoscaler.kubernetes.io/gpu-count"
+	caGPUTypeAnnotationKey  = "capacity.cluster-autoscaler.kubernetes.io/gpu-type"
+	caLabelsAnnotationKey   = "capacity.cluster-autoscaler.kubernetes.io/labels"
+	caMinSizeAnnotationKey  = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size"
+	caMaxSizeAnnotationKey  = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
+)
+
+// nodeGroupLabelKeys are the node labels that cloud providers set to the name of the node group.
+var nodeGroupLabelKeys = []string{
+	"eks.amazonaws.com/nodegroup",
+	"alpha.eksctl.io/nodegroup-name",
+	"cloud.google.com/gke-nodepool",
+	"kubernetes.azure.com/agentpool",
+}
+
+// nodeTemplateListGVKs are the kinds of the Cluster API resources that serve as node templates.
+var nodeTemplateListGVKs = []schema.GroupVersionKind{
+	{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "MachineDeploymentList"},
+	{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "MachineSetList"},
+}
+
+// nodeGroup is a node group of Cluster Autoscaler.
+type nodeGroup struct {
+	name       string
+	targetSize int
+	maxSize    int
+
+	// nodeGPUs are the accelerators of each node in the node group. Nil if unknown.
+	nodeGPUs           map[string]int32
+	gpuProduct         string
+	gpuMemoryMegabytes int32
+}
+
+func (g *nodeGroup) toProvisionableResource() *v1.ProvisionableResource {
+	pr := &v1.ProvisionableResource{
+		Name:               g.name,
+		Source:             sourceClusterAutoscaler,
+		GpuProduct:         g.gpuProduct,
+		GpuMemoryMegabytes: g.gpuMemoryMegabytes,
+	}
+	if len(g.nodeGPUs) == 0 {
+		return pr
+	}
+	pr.NodeGpus = g.nodeGPUs
+	pr.GpuLimits = map[string]int32{}
+	pr.ProvisionedGpus = map[string]int32{}
+	for name, count := range g.nodeGPUs {
+		pr.GpuLimits[name] = count * int32(g.maxSize)
+		pr.ProvisionedGpus[name] = count * int32(g.targetSize)
+	}
+	return pr
+}
+
+// listClusterAutoscalerNodeGroups lists the node groups of Cluster Autoscaler from its status ConfigMap and
+// Cluster API node templates.
+func (m *Manager) listClusterAutoscalerNodeGroups(ctx context.Context, nodes []corev1.Node) ([]*v1.ProvisionableResource, error) {
+	var groups []*nodeGroup
+	cm := &corev1.ConfigMap{}
+	err := m.k8sClient.Get(ctx, client.ObjectKey{Namespace: clusterAutoscalerStatusNamespace, Name: clusterAutoscalerStatusName}, cm)
+	switch {
+	case err == nil:
+		groups, err = parseClusterAutoscalerStatus(cm.Data[clusterAutoscalerStatusKey])
+		if err != nil {
+			// Do not fail the entire status update as the format of the status is not part of the API.
+			m.logger.Error(err, "Failed to parse the Cluster Autoscaler status")
+		}
+	case apierrors.IsNotFound(err):
+		// Cluster Autoscaler is not running.
+	default:
+		return nil, err
+	}
+
+	groupsByName := map[string]*nodeGroup{}
+	for _, g := range groups {
+		groupsByName[g.name] = g
+	}
+	for _, gvk := range nodeTemplateListGVKs {
+		ul := &unstructured.UnstructuredList{}
+		ul.SetGroupVersionKind(gvk)
+		if err := m.k8sClient.List(ctx, ul); err != nil {
+			// Ignore the error as this happens when the CRD is not installed.
+			continue
+		}
+		for _, u := range ul.Items {
+			t, ok, err := toNodeTemplate(&u)
+			if err != nil {
+				m.logger.Error(err, "Failed to parse the node template", "kind", u.GetKind(), "name", u.GetName())
+				continue
+			}
+			if !ok {
+				continue
+			}
+			if g, ok := groupsByName[t.name]; ok {
+				// Prefer the sizes reported by Cluster Autoscaler.
+				t.targetSize = g.targetSize
+				t.maxSize = g.maxSize
+				*g = *t
+				continue
+			}
+			groups = append(groups, t)
+			groupsByName[t.name] = t
+		}
+	}
+
+	var gpuNodes []*v1.GpuNode
+	nodeGroupNames := map[string]string{}
+	for _, n := range nodes {
+		gpuNodes = append(gpuNodes, toGPUNodes(n, m.unhealthyTaintKeys, m.logger)...)
+		for _, k := range nodeGroupLabelKeys {
+			if v, ok := n.Labels[k]; ok {
+				nodeGroupNames[n.Name] = v
+				break
+			}
+		}
+	}
+	for _, g := range groups {
+		if g.nodeGPUs == nil {
+			setNodeGPUsFromNodes(g, gpuNodes, nodeGroupNames)
+		}
+	}
+
+	m.logger.Info("Found Cluster Autoscaler node groups", "count", len(groups))
+	var prs []*v1.ProvisionableResource
+	for _, g := range groups {
+		prs = append(prs, g.toProvisionableResource())
+	}
+	return prs, nil
+}
+
+// clusterAutoscalerStatus is the status written by Cluster Autoscaler v1.30 or later.
+type clusterAutoscalerStatus struct {
+	NodeGroups []struct {
+		Name   string `yaml:"name"`
+		Health struct {
+			CloudProviderTarget int `yaml:"cloudProviderTarget"`
+			MaxSize             int `yaml:"maxSize"`
+		} `yaml:"health"`
+	} `yaml:"nodeGroups"`
+}
+
+var (
+	legacyNodeGroupNameRE   = regexp.MustCompile(`^\s*Name:\s+(\S+)\s*$`)
+	legacyNodeGroupHealthRE = regexp.MustCompile(`cloudProviderTarget=(\d+) \(minSize=\d+, maxSize=(\d+)\)`)
+)
+
+// parseClusterAutoscalerStatus parses the status of Cluster Autoscaler. Both the YAML format of v1.30 or later
+// and the human-readable format of older versions are supported.
+func parseClusterAutoscalerStatus(s string) ([]*nodeGroup, error) {
+	var status clusterAutoscalerStatus
+	if err := yaml.Unmarshal([]byte(s), &status); err == nil && len(status.NodeGroups) > 0 {
+		var groups []*nodeGroup
+		for _, g := range status.NodeGroups {
+			groups = append(groups, &nodeGroup{
+				name:       g.Name,
+				targetSize: g.Health.CloudProviderTarget,
+				maxSize:    g.Health.MaxSize,
+			})
+		}
+		return groups, nil
+	}
+
+	var (
+		groups []*nodeGroup
+		cur    *nodeGroup
+	)
+	for _, line := range strings.Split(s, "\n") {
+		if m := legacyNodeGroupNameRE.FindStringSubmatch(line); m != nil {
+			cur = &nodeGroup{name: m[1]}
+			groups = append(groups, cur)
+			continue
+		}
+		if cur == nil {
+			continue
+		}
+		if m := legacyNodeGroupHealthRE.FindStringSubmatch(line); m != nil {
+			// The regular expression guarantees that the values are integers.
+			cur.targetSize, _ = strconv.Atoi(m[1])
+			cur.maxSize, _ = strconv.Atoi(m[2])
+		}
+	}
+	if len(groups) == 0 && strings.Contains(s, "NodeGroups:") {
+		return nil, fmt.Errorf("no node group found in the status")
+	}
+	return groups, nil
+}
+
+// toNodeTemplate converts a Cluster API MachineDeployment or MachineSet annotated for Cluster Autoscaler
+// to a node group. It returns false if the resource is not managed by Cluster Autoscaler.
+func toNodeTemplate(u *unstructured.Unstructured) (*nodeGroup, bool, error) {
+	annos := u.GetAnnotations()
+	maxSizeStr, ok := annos[caMaxSizeAnnotationKey]
+	if !ok {
+		return nil, false, nil
+	}
+	if _, ok := annos[caMinSizeAnnotationKey]; !ok {
+		return nil, false, nil
+	}
+	maxSize, err := strconv.Atoi(maxSizeStr)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid max size %q: %s", maxSizeStr, err)
+	}
+	replicas, _, err := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if err != nil {
+		return nil, false, err
+	}
+
+	g := &nodeGroup{
+		// This is the name of the node group used by Cluster Autoscaler.
+		name:       fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(u.GetKind(), "List"), u.GetNamespace(), u.GetName()),
+		targetSize: int(replicas),
+		maxSize:    maxSize,
+	}
+
+	if v, ok := annos[caGPUCountAnnotationKey]; ok {
+		count, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid GPU count %q: %s", v, err)
+		}
+		if count > 0 {
+			name := accelerator.ResourceNameOrDefault(annos[caGPUTypeAnnotationKey])
+			g.nodeGPUs = map[string]int32{name: int32(count)}
+		}
+	}
+
+	for _, kv := range strings.Split(annos[caLabelsAnnotationKey], ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(kv), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case accelerator.NvidiaGPUProductLabelKey:
+			g.gpuProduct = v
+		case accelerator.NvidiaGPUMemoryLabelKey:
+			if mem, err := strconv.ParseInt(v, 10, 32); err == nil {
+				g.gpuMemoryMegabytes = int32(mem)
+			}
+		}
+	}
+	return g, true, nil
+}
+
+// setNodeGPUsFromNodes sets the accelerators of the node group from its existing nodes. Node groups are matched
+// with nodes by the node group labels that cloud providers set, as the names of node groups contain them
+// (e.g., "eks-<nodegroup>-<id>" in EKS and "gke-<cluster>-<nodepool>-<id>-grp" in GKE).
+func setNodeGPUsFromNodes(g *nodeGroup, gpuNodes []*v1.GpuNode, nodeGroupNames map[string]string) {
+	normalized := "-" + strings.NewReplacer("/", "-", "_", "-").Replace(g.name) + "-"
+	var matched string
+	for _, n := range gpuNodes {
+		name, ok := nodeGroupNames[n.NodeName]
+		if !ok || !strings.Contains(normalized, "-"+name+"-") {
+			continue
+		}
+		if len(name) < len(matched) {
+			// Prefer the longest match to avoid matching a node group whose name is a part of another one.
+			continue
+		}
+		if len(name) > len(matched) {
+			matched = name
+			g.nodeGPUs = map[string]int32{}
+		}
+		// Use the capacity as new nodes have no unhealthy accelerators.
+		g.nodeGPUs[n.ResourceName] = max(g.nodeGPUs[n.ResourceName], n.AllocatableCount, n.CapacityCount)
+		g.gpuProduct = n.Product
+		g.gpuMemoryMegabytes = n.MemoryMegabytes
+	}
+}
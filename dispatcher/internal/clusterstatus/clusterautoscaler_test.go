@@ -0,0 +1,175 @@
+package clusterstatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/testing/protocmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseClusterAutoscalerStatus(t *testing.T) {
+	tcs := []struct {
+		name   string
+		status string
+		want   []*nodeGroup
+	}{
+		{
+			name: "yaml",
+			status: `time: 2024-10-01 00:00:00.000000000 +0000 UTC
+autoscalerStatus: Running
+clusterWide:
+  health:
+    status: Healthy
+nodeGroups:
+- name: eks-gpu-1234
+  health:
+    status: Healthy
+    nodeCounts:
+      registered:
+        total: 1
+    cloudProviderTarget: 1
+    minSize: 0
+    maxSize: 4
+- name: eks-cpu-5678
+  health:
+    status: Healthy
+    cloudProviderTarget: 2
+    minSize: 1
+    maxSize: 3
+`,
+			want: []*nodeGroup{
+				{name: "eks-gpu-1234", targetSize: 1, maxSize: 4},
+				{name: "eks-cpu-5678", targetSize: 2, maxSize: 3},
+			},
+		},
+		{
+			name: "legacy",
+			status: `Cluster-autoscaler status at 2024-10-01 00:00:00.000000000 +0000 UTC:
+Cluster-wide:
+  Health:      Healthy (ready=3 unready=0 (resourceUnready=0) notStarted=0 longNotStarted=0 registered=3 longUnregistered=0)
+
+NodeGroups:
+  Name:        eks-gpu-1234
+  Health:      Healthy (ready=1 unready=0 (resourceUnready=0) notStarted=0 longNotStarted=0 registered=1 longUnregistered=0 cloudProviderTarget=1 (minSize=0, maxSize=4))
+  ScaleUp:     NoActivity (ready=1 cloudProviderTarget=1)
+`,
+			want: []*nodeGroup{
+				{name: "eks-gpu-1234", targetSize: 1, maxSize: 4},
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseClusterAutoscalerStatus(tc.status)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestListClusterAutoscalerNodeGroups(t *testing.T) {
+	status := `nodeGroups:
+- name: eks-gpu-1234
+  health:
+    cloudProviderTarget: 1
+    maxSize: 4
+- name: eks-cpu-5678
+  health:
+    cloudProviderTarget: 2
+    maxSize: 3
+`
+	nodes := []corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node0",
+				Labels: map[string]string{
+					"eks.amazonaws.com/nodegroup": "gpu",
+					"nvidia.com/gpu.product":      "NVIDIA-A10G",
+					"nvidia.com/gpu.memory":       "23028",
+				},
+			},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					nvidiaGPU: resource.MustParse("4"),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node1",
+				Labels: map[string]string{
+					"eks.amazonaws.com/nodegroup": "cpu",
+				},
+			},
+		},
+	}
+	md := &unstructured.Unstructured{}
+	md.SetAPIVersion("cluster.x-k8s.io/v1beta1")
+	md.SetKind("MachineDeployment")
+	md.SetNamespace("default")
+	md.SetName("h100")
+	md.SetAnnotations(map[string]string{
+		"capacity.cluster-autoscaler.kubernetes.io/gpu-count":         "8",
+		"capacity.cluster-autoscaler.kubernetes.io/gpu-type":          "nvidia.com/gpu",
+		"capacity.cluster-autoscaler.kubernetes.io/labels":            "nvidia.com/gpu.product=NVIDIA-H100-80GB-HBM3,foo=bar",
+		"cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size": "0",
+		"cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size": "2",
+	})
+	assert.NoError(t, unstructured.SetNestedField(md.Object, int64(0), "spec", "replicas"))
+
+	objs := []client.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-autoscaler-status",
+				Namespace: "kube-system",
+			},
+			Data: map[string]string{
+				"status": status,
+			},
+		},
+		md,
+	}
+
+	m := &Manager{
+		k8sClient: fake.NewClientBuilder().WithObjects(objs...).Build(),
+		logger:    testr.New(t),
+	}
+	got, err := m.listClusterAutoscalerNodeGroups(context.Background(), nodes)
+	assert.NoError(t, err)
+
+	want := []*v1.ProvisionableResource{
+		{
+			Name:               "eks-gpu-1234",
+			Source:             "cluster-autoscaler",
+			NodeGpus:           map[string]int32{"nvidia.com/gpu": 4},
+			GpuProduct:         "NVIDIA-A10G",
+			GpuMemoryMegabytes: 23028,
+			GpuLimits:          map[string]int32{"nvidia.com/gpu": 16},
+			ProvisionedGpus:    map[string]int32{"nvidia.com/gpu": 4},
+		},
+		{
+			Name:   "eks-cpu-5678",
+			Source: "cluster-autoscaler",
+		},
+		{
+			Name:            "MachineDeployment/default/h100",
+			Source:          "cluster-autoscaler",
+			NodeGpus:        map[string]int32{"nvidia.com/gpu": 8},
+			GpuProduct:      "NVIDIA-H100-80GB-HBM3",
+			GpuLimits:       map[string]int32{"nvidia.com/gpu": 16},
+			ProvisionedGpus: map[string]int32{"nvidia.com/gpu": 0},
+		},
+	}
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+}
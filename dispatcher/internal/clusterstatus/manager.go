@@ -3,49 +3,222 @@ package clusterstatus
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/llmariner/common/pkg/id"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	krpv1 "sigs.k8s.io/karpenter/pkg/apis/v1"
 )
 
+// heartbeatInterval is the interval of sending an empty delta when nothing has changed
+// so that the server does not consider the cluster unreachable.
+const heartbeatInterval = time.Minute
+
+// Sources of provisionable resources.
 const (
-	nvidiaGPU corev1.ResourceName = "nvidia.com/gpu"
+	sourceKarpenterV1       = "karpenter.sh/v1"
+	sourceKarpenterV1beta1  = "karpenter.sh/v1beta1"
+	sourceClusterAutoscaler = "cluster-autoscaler"
 )
 
+// builtinUnhealthyTaintKeys are the keys of the taints that Kubernetes adds to nodes that cannot run workloads.
+var builtinUnhealthyTaintKeys = []string{
+	corev1.TaintNodeNotReady,
+	corev1.TaintNodeUnreachable,
+	corev1.TaintNodeNetworkUnavailable,
+	corev1.TaintNodeOutOfService,
+}
+
+var karpenterV1beta1NodePoolListGVK = schema.GroupVersionKind{
+	Group:   "karpenter.sh",
+	Version: "v1beta1",
+	Kind:    "NodePoolList",
+}
+
 type updater interface {
 	UpdateClusterStatus(context.Context, *v1.UpdateClusterStatusRequest, ...grpc.CallOption) (*v1.UpdateClusterStatusResponse, error)
+	UpdateClusterStatusDelta(context.Context, *v1.UpdateClusterStatusDeltaRequest, ...grpc.CallOption) (*v1.UpdateClusterStatusDeltaResponse, error)
 }
 
 // NewManager creates a new manager.
 func NewManager(
 	updater updater,
 	updateInterval time.Duration,
+	fullResyncInterval time.Duration,
+	unhealthyTaintKeys []string,
+	labels map[string]string,
 ) *Manager {
 	return &Manager{
-		updater:        updater,
-		updateInterval: updateInterval,
+		updater:            updater,
+		updateInterval:     updateInterval,
+		fullResyncInterval: fullResyncInterval,
+		unhealthyTaintKeys: append(append([]string{}, builtinUnhealthyTaintKeys...), unhealthyTaintKeys...),
+		labels:             labels,
+		gpuNodesByName:     map[string][]*v1.GpuNode{},
+		gpuPodsByName:      map[string]*v1.GpuPod{},
+		allocatableByNode:  map[string]cpuMemory{},
+		requestedByNode:    map[string]cpuMemory{},
+		podRequestsByName:  map[string]podRequest{},
+		dirtyNodes:         map[string]bool{},
+		dirtyPods:          map[string]bool{},
+		dirtyNodeResources: map[string]bool{},
 	}
 }
 
+// cpuMemory is an amount of CPU and memory.
+type cpuMemory struct {
+	cpuMilicore     int64
+	memoryMegabytes int64
+}
+
+func (c cpuMemory) add(o cpuMemory) cpuMemory {
+	return cpuMemory{
+		cpuMilicore:     c.cpuMilicore + o.cpuMilicore,
+		memoryMegabytes: c.memoryMegabytes + o.memoryMegabytes,
+	}
+}
+
+func (c cpuMemory) sub(o cpuMemory) cpuMemory {
+	return cpuMemory{
+		cpuMilicore:     c.cpuMilicore - o.cpuMilicore,
+		memoryMegabytes: c.memoryMegabytes - o.memoryMegabytes,
+	}
+}
+
+// podRequest is the CPU and memory requested by a pod bound to a node.
+type podRequest struct {
+	nodeName string
+	cpuMemory
+}
+
 // Manager is a manager.
+//
+// The manager keeps an incremental view of GPU nodes, GPU pods, and the CPU and memory of nodes with informers. It sends the changes of the view
+// to the server every update interval and the full status every full resync interval or when the server
+// has missed the changes.
 type Manager struct {
-	k8sClient      client.Client
-	updater        updater
-	updateInterval time.Duration
-	logger         logr.Logger
+	k8sClient client.Client
+	// reader reads nodes and non-terminated pods from the informer cache of the manager.
+	reader client.Reader
+	// cache is the informer cache of nodes and non-terminated pods.
+	cache cache.Cache
+
+	updater            updater
+	updateInterval     time.Duration
+	fullResyncInterval time.Duration
+	// unhealthyTaintKeys are the keys of the taints that mark nodes unhealthy.
+	unhealthyTaintKeys []string
+	// labels are the labels of the cluster sent with the full status.
+	labels map[string]string
+	logger logr.Logger
+
+	// sessionID is the ID of the session used to detect missed deltas on the server.
+	sessionID string
+
+	// mu guards the fields below.
+	mu sync.Mutex
+	// gpuNodesByName is a map from node names to the GPU nodes.
+	gpuNodesByName map[string][]*v1.GpuNode
+	// gpuPodsByName is a map from namespaced names to the GPU pods.
+	gpuPodsByName map[string]*v1.GpuPod
+	// allocatableByNode is a map from the names of schedulable nodes to their allocatable CPU and memory.
+	allocatableByNode map[string]cpuMemory
+	// requestedByNode is a map from node names to the CPU and memory requested by the pods on the nodes.
+	requestedByNode map[string]cpuMemory
+	// podRequestsByName is a map from namespaced names to the CPU and memory requested by the pods bound to nodes.
+	podRequestsByName map[string]podRequest
+	// provisionableResources are the provisionable resources sent in the last update.
+	provisionableResources []*v1.ProvisionableResource
+	// namespaceQuotas are the namespace quotas sent in the last update.
+	namespaceQuotas []*v1.NamespaceQuota
+	// dirtyNodes and dirtyPods are the names of the nodes and pods that have changed since the last update.
+	dirtyNodes map[string]bool
+	dirtyPods  map[string]bool
+	// dirtyNodeResources are the names of the nodes whose CPU or memory has changed since the last update.
+	dirtyNodeResources map[string]bool
+
+	sequenceNumber  int64
+	needsFullResync bool
+	lastFullResync  time.Time
+	// lastSent is the time when the last update was sent.
+	lastSent time.Time
 }
 
 // SetupWithManager sets up the updater with the manager.
 func (m *Manager) SetupWithManager(mgr ctrl.Manager) error {
 	m.k8sClient = mgr.GetClient()
 	m.logger = mgr.GetLogger().WithName("clusterstatus")
+
+	sessionID, err := id.GenerateID("", 16)
+	if err != nil {
+		return err
+	}
+	m.sessionID = sessionID
+
+	// Use a dedicated cache so that terminated pods are not watched. They do not allocate any resources.
+	c, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient: mgr.GetHTTPClient(),
+		Scheme:     mgr.GetScheme(),
+		Mapper:     mgr.GetRESTMapper(),
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.Pod{}: {
+				Field: fields.AndSelectors(
+					fields.OneTermNotEqualSelector("status.phase", string(corev1.PodSucceeded)),
+					fields.OneTermNotEqualSelector("status.phase", string(corev1.PodFailed)),
+				),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	m.cache = c
+	m.reader = c
+
+	ctx := context.Background()
+	ni, err := c.GetInformer(ctx, &corev1.Node{})
+	if err != nil {
+		return err
+	}
+	if _, err := ni.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    m.onNodeUpdate,
+		UpdateFunc: func(_, obj interface{}) { m.onNodeUpdate(obj) },
+		DeleteFunc: m.onNodeDelete,
+	}); err != nil {
+		return err
+	}
+	pi, err := c.GetInformer(ctx, &corev1.Pod{})
+	if err != nil {
+		return err
+	}
+	if _, err := pi.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    m.onPodUpdate,
+		UpdateFunc: func(_, obj interface{}) { m.onPodUpdate(obj) },
+		DeleteFunc: m.onPodDelete,
+	}); err != nil {
+		return err
+	}
+
+	if err := mgr.Add(c); err != nil {
+		return err
+	}
 	return mgr.Add(m)
 }
 
@@ -56,18 +229,19 @@ func (m *Manager) NeedLeaderElection() bool {
 
 // Start starts the manager.
 func (m *Manager) Start(ctx context.Context) error {
-	if err := m.updateClusterStaus(ctx); err != nil {
-		return err
+	if !m.cache.WaitForCacheSync(ctx) {
+		return fmt.Errorf("failed to wait for the cache sync")
 	}
 
+	// The first update sends the full status.
+	m.syncClusterStatus(ctx)
+
 	tick := time.NewTicker(m.updateInterval)
 	defer tick.Stop()
 	for {
 		select {
 		case <-tick.C:
-			if err := m.updateClusterStaus(ctx); err != nil {
-				return err
-			}
+			m.syncClusterStatus(ctx)
 		case <-ctx.Done():
 			return ctx.Err()
 
@@ -75,129 +249,691 @@ func (m *Manager) Start(ctx context.Context) error {
 	}
 }
 
-func (m *Manager) updateClusterStaus(ctx context.Context) error {
-	m.logger.Info("Updating cluster status")
+// syncClusterStatus updates the cluster status. A failure does not stop the manager. The full status is sent
+// on the next tick instead as the server might or might not have applied the update.
+func (m *Manager) syncClusterStatus(ctx context.Context) {
+	if err := m.updateClusterStatus(ctx); err != nil {
+		m.logger.Error(err, "Failed to update the cluster status. Retrying with a full resync")
+		m.mu.Lock()
+		m.needsFullResync = true
+		m.mu.Unlock()
+	}
+}
+
+func (m *Manager) updateClusterStatus(ctx context.Context) error {
+	m.mu.Lock()
+	full := m.needsFullResync || time.Since(m.lastFullResync) >= m.fullResyncInterval
+	m.mu.Unlock()
+	if full {
+		return m.sendFullStatus(ctx)
+	}
+
+	resync, err := m.sendDelta(ctx)
+	if err != nil {
+		return err
+	}
+	if resync {
+		m.logger.Info("Server requested a full resync")
+		return m.sendFullStatus(ctx)
+	}
+	return nil
+}
+
+func (m *Manager) sendFullStatus(ctx context.Context) error {
+	m.logger.Info("Sending full cluster status")
 
 	status, err := m.buildClusterStaus(ctx)
 	if err != nil {
 		return err
 	}
 
-	ctx = auth.AppendWorkerAuthorization(ctx)
+	m.mu.Lock()
+	seq := m.sequenceNumber + 1
+	m.mu.Unlock()
+
 	req := &v1.UpdateClusterStatusRequest{
-		ClusterStatus: status,
+		ClusterStatus:  status,
+		SessionId:      m.sessionID,
+		SequenceNumber: seq,
 	}
-	if _, err := m.updater.UpdateClusterStatus(ctx, req); err != nil {
+	if _, err := m.updater.UpdateClusterStatus(auth.AppendWorkerAuthorization(ctx), req); err != nil {
+		m.mu.Lock()
+		m.needsFullResync = true
+		m.mu.Unlock()
 		return err
 	}
 
-	m.logger.Info("Updated cluster status")
+	m.mu.Lock()
+	m.sequenceNumber = seq
+	m.needsFullResync = false
+	m.lastFullResync = time.Now()
+	m.lastSent = m.lastFullResync
+	m.mu.Unlock()
 
+	m.logger.Info("Sent full cluster status", "sequenceNumber", seq)
 	return nil
 }
 
+// sendDelta sends the changes since the last update. It returns true if the server requires a full resync.
+func (m *Manager) sendDelta(ctx context.Context) (bool, error) {
+	prs, err := m.listProvisionableResources(ctx)
+	if err != nil {
+		return false, err
+	}
+	nqs, err := m.listNamespaceQuotas(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	m.mu.Lock()
+	req := &v1.UpdateClusterStatusDeltaRequest{
+		SessionId:      m.sessionID,
+		SequenceNumber: m.sequenceNumber + 1,
+	}
+	for _, name := range sortedKeys(m.dirtyNodes) {
+		if ns, ok := m.gpuNodesByName[name]; ok {
+			req.UpdatedGpuNodes = append(req.UpdatedGpuNodes, ns...)
+		} else {
+			req.DeletedNodeNames = append(req.DeletedNodeNames, name)
+		}
+	}
+	for _, name := range sortedKeys(m.dirtyPods) {
+		if p, ok := m.gpuPodsByName[name]; ok {
+			req.UpdatedGpuPods = append(req.UpdatedGpuPods, p)
+		} else {
+			req.DeletedPodNames = append(req.DeletedPodNames, name)
+		}
+	}
+	for _, name := range sortedKeys(m.dirtyNodeResources) {
+		if n, ok := m.toNode(name); ok {
+			req.UpdatedNodes = append(req.UpdatedNodes, n)
+		} else {
+			req.DeletedNodes = append(req.DeletedNodes, name)
+		}
+	}
+	if !protoSlicesEqual(m.provisionableResources, prs) {
+		req.ProvisionableResources = prs
+		req.ProvisionableResourcesUpdated = true
+		m.provisionableResources = prs
+	}
+	if !protoSlicesEqual(m.namespaceQuotas, nqs) {
+		req.NamespaceQuotas = nqs
+		req.NamespaceQuotasUpdated = true
+		m.namespaceQuotas = nqs
+	}
+	m.dirtyNodes = map[string]bool{}
+	m.dirtyPods = map[string]bool{}
+	m.dirtyNodeResources = map[string]bool{}
+	lastSent := m.lastSent
+	m.mu.Unlock()
+
+	if len(req.UpdatedGpuNodes) == 0 && len(req.DeletedNodeNames) == 0 &&
+		len(req.UpdatedGpuPods) == 0 && len(req.DeletedPodNames) == 0 &&
+		len(req.UpdatedNodes) == 0 && len(req.DeletedNodes) == 0 &&
+		!req.ProvisionableResourcesUpdated && !req.NamespaceQuotasUpdated {
+		if time.Since(lastSent) < heartbeatInterval {
+			return false, nil
+		}
+		m.logger.V(1).Info("Sending an empty cluster status delta as a heartbeat", "sequenceNumber", req.SequenceNumber)
+	} else {
+		m.logger.Info("Sending cluster status delta",
+			"sequenceNumber", req.SequenceNumber,
+			"updatedNodes", len(req.UpdatedGpuNodes),
+			"deletedNodes", len(req.DeletedNodeNames),
+			"updatedPods", len(req.UpdatedGpuPods),
+			"deletedPods", len(req.DeletedPodNames),
+			"updatedNodeResources", len(req.UpdatedNodes),
+			"deletedNodeResources", len(req.DeletedNodes),
+			"namespaceQuotasUpdated", req.NamespaceQuotasUpdated,
+		)
+	}
+	resp, err := m.updater.UpdateClusterStatusDelta(auth.AppendWorkerAuthorization(ctx), req)
+	if err != nil {
+		// The server might or might not have applied the delta.
+		m.mu.Lock()
+		m.needsFullResync = true
+		m.mu.Unlock()
+		return false, err
+	}
+	if resp.ResyncRequired {
+		return true, nil
+	}
+
+	m.mu.Lock()
+	m.sequenceNumber = req.SequenceNumber
+	m.lastSent = time.Now()
+	m.mu.Unlock()
+	return false, nil
+}
+
+// buildClusterStaus builds the full cluster status. The view of nodes and pods is rebuilt from the cache.
 func (m *Manager) buildClusterStaus(ctx context.Context) (*v1.ClusterStatus, error) {
-	// TODO(kenji): Add pagination.
+	// Hold the lock while listing objects so that changes notified in the meantime are applied to the rebuilt view.
+	m.mu.Lock()
+	status, err := m.rebuildView(ctx)
+	m.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
 
+	prs, err := m.listProvisionableResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nqs, err := m.listNamespaceQuotas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	m.provisionableResources = prs
+	m.namespaceQuotas = nqs
+	m.mu.Unlock()
+
+	status.ProvisionableResources = prs
+	status.NamespaceQuotas = nqs
+	status.Labels = m.labels
+	return status, nil
+}
+
+// rebuildView rebuilds the view of nodes and pods from the cache and returns the cluster status without
+// provisionable resources. The caller must hold the lock.
+func (m *Manager) rebuildView(ctx context.Context) (*v1.ClusterStatus, error) {
 	nodes := &corev1.NodeList{}
-	if err := m.k8sClient.List(ctx, nodes); err != nil {
+	if err := m.reader.List(ctx, nodes); err != nil {
 		return nil, err
 	}
 	m.logger.Info("Found Nodes", "count", len(nodes.Items))
-	var gpuNodes []*v1.GpuNode
+	status := &v1.ClusterStatus{}
+	m.gpuNodesByName = map[string][]*v1.GpuNode{}
+	m.allocatableByNode = map[string]cpuMemory{}
 	for _, node := range nodes.Items {
-		if n, ok := toGPUNode(node, m.logger); ok {
-			gpuNodes = append(gpuNodes, n)
+		ns := toGPUNodes(node, m.unhealthyTaintKeys, m.logger)
+		if len(ns) > 0 {
+			m.gpuNodesByName[node.Name] = ns
+		}
+		status.GpuNodes = append(status.GpuNodes, ns...)
+		if a, ok := toAllocatable(&node, m.unhealthyTaintKeys); ok {
+			m.allocatableByNode[node.Name] = a
 		}
 	}
 
 	pods := &corev1.PodList{}
-	if err := m.k8sClient.List(ctx, pods); err != nil {
+	if err := m.reader.List(ctx, pods); err != nil {
 		return nil, err
 	}
 	m.logger.Info("Found Pods", "count", len(pods.Items))
-	var gpuPods []*v1.GpuPod
+	m.gpuPodsByName = map[string]*v1.GpuPod{}
+	m.requestedByNode = map[string]cpuMemory{}
+	m.podRequestsByName = map[string]podRequest{}
 	for _, pod := range pods.Items {
 		if p, ok := toGPUPod(&pod, m.logger); ok {
-			gpuPods = append(gpuPods, p)
+			m.gpuPodsByName[p.NamespacedName] = p
+			status.GpuPods = append(status.GpuPods, p)
+		}
+		if r, ok := toPodRequest(&pod); ok {
+			m.podRequestsByName[fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)] = r
+			m.requestedByNode[r.nodeName] = m.requestedByNode[r.nodeName].add(r.cpuMemory)
+		}
+	}
+
+	for _, node := range nodes.Items {
+		if n, ok := m.toNode(node.Name); ok {
+			status.Nodes = append(status.Nodes, n)
 		}
 	}
 
+	m.dirtyNodes = map[string]bool{}
+	m.dirtyPods = map[string]bool{}
+	m.dirtyNodeResources = map[string]bool{}
+	return status, nil
+}
+
+func (m *Manager) listProvisionableResources(ctx context.Context) ([]*v1.ProvisionableResource, error) {
+	nodes := &corev1.NodeList{}
+	if err := m.reader.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+	prs := m.listKarpenterNodePools(ctx)
+	cas, err := m.listClusterAutoscalerNodeGroups(ctx, nodes.Items)
+	if err != nil {
+		return nil, err
+	}
+	return append(prs, cas...), nil
+}
+
+func (m *Manager) onNodeUpdate(obj interface{}) {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.setGPUNodes(node.Name, toGPUNodes(*node, m.unhealthyTaintKeys, m.logger))
+	if a, ok := toAllocatable(node, m.unhealthyTaintKeys); ok {
+		m.setAllocatable(node.Name, &a)
+	} else {
+		m.setAllocatable(node.Name, nil)
+	}
+}
+
+func (m *Manager) onNodeDelete(obj interface{}) {
+	if d, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = d.Obj
+	}
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.setGPUNodes(node.Name, nil)
+	m.setAllocatable(node.Name, nil)
+}
+
+// setGPUNodes sets the GPU nodes of the node and marks the node dirty if they have changed.
+// The caller must hold the lock.
+func (m *Manager) setGPUNodes(name string, gpuNodes []*v1.GpuNode) {
+	if protoSlicesEqual(m.gpuNodesByName[name], gpuNodes) {
+		return
+	}
+	if len(gpuNodes) == 0 {
+		delete(m.gpuNodesByName, name)
+	} else {
+		m.gpuNodesByName[name] = gpuNodes
+	}
+	m.dirtyNodes[name] = true
+}
+
+func (m *Manager) onPodUpdate(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+	if p, ok := toGPUPod(pod, m.logger); ok {
+		m.setGPUPod(name, p)
+	} else {
+		m.setGPUPod(name, nil)
+	}
+	if r, ok := toPodRequest(pod); ok {
+		m.setPodRequest(name, &r)
+	} else {
+		m.setPodRequest(name, nil)
+	}
+}
+
+func (m *Manager) onPodDelete(obj interface{}) {
+	if d, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = d.Obj
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+	m.setGPUPod(name, nil)
+	m.setPodRequest(name, nil)
+}
+
+// setGPUPod sets the GPU pod and marks the pod dirty if it has changed. The caller must hold the lock.
+func (m *Manager) setGPUPod(name string, gpuPod *v1.GpuPod) {
+	cur, ok := m.gpuPodsByName[name]
+	if gpuPod == nil {
+		if !ok {
+			return
+		}
+		delete(m.gpuPodsByName, name)
+		m.dirtyPods[name] = true
+		return
+	}
+	if ok && proto.Equal(cur, gpuPod) {
+		return
+	}
+	m.gpuPodsByName[name] = gpuPod
+	m.dirtyPods[name] = true
+}
+
+// setAllocatable sets the allocatable CPU and memory of the node and marks the node dirty if they have changed.
+// A nil value removes the node. The caller must hold the lock.
+func (m *Manager) setAllocatable(name string, a *cpuMemory) {
+	cur, ok := m.allocatableByNode[name]
+	if a == nil {
+		if !ok {
+			return
+		}
+		delete(m.allocatableByNode, name)
+		m.dirtyNodeResources[name] = true
+		return
+	}
+	if ok && cur == *a {
+		return
+	}
+	m.allocatableByNode[name] = *a
+	m.dirtyNodeResources[name] = true
+}
+
+// setPodRequest sets the CPU and memory requested by the pod and marks the node of the pod dirty if they
+// have changed. A nil value removes the pod. The caller must hold the lock.
+func (m *Manager) setPodRequest(name string, r *podRequest) {
+	cur, ok := m.podRequestsByName[name]
+	if r != nil && ok && cur == *r {
+		return
+	}
+	if ok {
+		m.requestedByNode[cur.nodeName] = m.requestedByNode[cur.nodeName].sub(cur.cpuMemory)
+		if m.requestedByNode[cur.nodeName] == (cpuMemory{}) {
+			delete(m.requestedByNode, cur.nodeName)
+		}
+		delete(m.podRequestsByName, name)
+		m.dirtyNodeResources[cur.nodeName] = true
+	}
+	if r != nil {
+		m.requestedByNode[r.nodeName] = m.requestedByNode[r.nodeName].add(r.cpuMemory)
+		m.podRequestsByName[name] = *r
+		m.dirtyNodeResources[r.nodeName] = true
+	}
+}
+
+// toNode returns the CPU and memory of the node. It returns false if the node is not schedulable.
+// The caller must hold the lock.
+func (m *Manager) toNode(name string) (*v1.Node, bool) {
+	a, ok := m.allocatableByNode[name]
+	if !ok {
+		return nil, false
+	}
+	r := m.requestedByNode[name]
+	return &v1.Node{
+		Name:                       name,
+		AllocatableCpuMilicore:     a.cpuMilicore,
+		AllocatableMemoryMegabytes: a.memoryMegabytes,
+		RequestedCpuMilicore:       r.cpuMilicore,
+		RequestedMemoryMegabytes:   r.memoryMegabytes,
+	}, true
+}
+
+// protoSlicesEqual returns true if the messages of the slices are equal in the same order.
+func protoSlicesEqual[T proto.Message](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// listKarpenterNodePools lists Karpenter NodePools. NodePools of v1beta1 are listed when the v1 API is not served
+// (i.e., Karpenter older than v1.0).
+func (m *Manager) listKarpenterNodePools(ctx context.Context) []*v1.ProvisionableResource {
 	var prs []*v1.ProvisionableResource
 	nodePools := &krpv1.NodePoolList{}
-	if err := m.k8sClient.List(ctx, nodePools); err != nil {
-		// Ignore the error as this happens when the CRD is not installed.
-	} else {
+	if err := m.k8sClient.List(ctx, nodePools); err == nil {
 		m.logger.Info("Found NodePools", "count", len(nodePools.Items))
 		for _, np := range nodePools.Items {
-			prs = append(prs, toProvisionableResource(np))
+			prs = append(prs, toProvisionableResource(np, sourceKarpenterV1, m.logger))
 		}
+		return prs
 	}
 
-	// TODO(kenji): Support Cluster Autoscaler and v1beta1 Karpenter.
+	ul := &unstructured.UnstructuredList{}
+	ul.SetGroupVersionKind(karpenterV1beta1NodePoolListGVK)
+	if err := m.k8sClient.List(ctx, ul); err != nil {
+		// Ignore the error as this happens when the CRD is not installed.
+		return nil
+	}
+	m.logger.Info("Found v1beta1 NodePools", "count", len(ul.Items))
+	for _, u := range ul.Items {
+		np, err := fromV1beta1NodePool(&u)
+		if err != nil {
+			m.logger.Error(err, "Failed to convert the v1beta1 NodePool", "name", u.GetName())
+			continue
+		}
+		prs = append(prs, toProvisionableResource(*np, sourceKarpenterV1beta1, m.logger))
+	}
+	return prs
+}
 
-	return &v1.ClusterStatus{
-		GpuNodes:               gpuNodes,
-		GpuPods:                gpuPods,
-		ProvisionableResources: prs,
-	}, nil
+// fromV1beta1NodePool converts a v1beta1 NodePool to a v1 NodePool. Only the fields used for building
+// a provisionable resource are converted as the rest of the schema has changed in v1.
+func fromV1beta1NodePool(u *unstructured.Unstructured) (*krpv1.NodePool, error) {
+	obj := map[string]interface{}{}
+	for _, fields := range [][]string{
+		{"metadata", "name"},
+		{"spec", "template", "spec", "requirements"},
+		{"spec", "limits"},
+		{"status", "resources"},
+	} {
+		v, found, err := unstructured.NestedFieldNoCopy(u.Object, fields...)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		if err := unstructured.SetNestedField(obj, runtime.DeepCopyJSONValue(v), fields...); err != nil {
+			return nil, err
+		}
+	}
+
+	var np krpv1.NodePool
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, &np); err != nil {
+		return nil, err
+	}
+	return &np, nil
 }
 
-func toProvisionableResource(np krpv1.NodePool) *v1.ProvisionableResource {
-	var instType, instFamily string
+// Labels of the well-known requirements of Karpenter AWS nodepools.
+const (
+	awsInstanceTypeLabelKey     = "karpenter.k8s.aws/instance-type"
+	awsInstanceFamilyLabelKey   = "karpenter.k8s.aws/instance-family"
+	awsInstanceCategoryLabelKey = "karpenter.k8s.aws/instance-category"
+	awsInstanceGPUNameLabelKey  = "karpenter.k8s.aws/instance-gpu-name"
+	awsInstanceGPUCountLabelKey = "karpenter.k8s.aws/instance-gpu-count"
+)
+
+func toProvisionableResource(np krpv1.NodePool, source string, logger logr.Logger) *v1.ProvisionableResource {
+	pr := &v1.ProvisionableResource{
+		Name:   np.Name,
+		Source: source,
+	}
 
 	for _, t := range np.Spec.Template.Spec.Requirements {
-		if t.Operator != corev1.NodeSelectorOpIn {
-			continue
+		switch t.Key {
+		case corev1.LabelInstanceTypeStable, awsInstanceTypeLabelKey:
+			if t.Operator == corev1.NodeSelectorOpIn {
+				pr.InstanceTypes = append(pr.InstanceTypes, t.Values...)
+			}
+		case awsInstanceFamilyLabelKey:
+			if t.Operator == corev1.NodeSelectorOpIn {
+				pr.InstanceFamilies = append(pr.InstanceFamilies, t.Values...)
+			}
+		case awsInstanceCategoryLabelKey:
+			if t.Operator == corev1.NodeSelectorOpIn {
+				pr.InstanceCategories = append(pr.InstanceCategories, t.Values...)
+			}
+		case awsInstanceGPUNameLabelKey:
+			if t.Operator == corev1.NodeSelectorOpIn {
+				pr.GpuNames = append(pr.GpuNames, t.Values...)
+			}
+		case awsInstanceGPUCountLabelKey:
+			if err := setGPUCountBounds(pr, t.NodeSelectorRequirement); err != nil {
+				logger.Info("Failed to parse the GPU count requirement", "nodepool", np.Name, "error", err)
+			}
 		}
-		if len(t.Values) == 0 {
-			continue
+	}
+
+	if len(pr.InstanceTypes) > 0 {
+		pr.InstanceType = pr.InstanceTypes[0]
+	}
+	if len(pr.InstanceFamilies) > 0 {
+		pr.InstanceFamily = pr.InstanceFamilies[0]
+	}
+
+	pr.GpuLimits = toAcceleratorCounts(corev1.ResourceList(np.Spec.Limits), logger)
+	pr.ProvisionedGpus = toAcceleratorCounts(np.Status.Resources, logger)
+	return pr
+}
+
+// setGPUCountBounds sets the minimum and maximum GPU counts of the provisionable resource from the
+// given requirement.
+func setGPUCountBounds(pr *v1.ProvisionableResource, r corev1.NodeSelectorRequirement) error {
+	var vals []int32
+	for _, v := range r.Values {
+		i, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return err
 		}
+		vals = append(vals, int32(i))
+	}
 
-		v := t.Values[0]
-		switch t.Key {
-		case "karpenter.k8s.aws/instance-type":
-			instType = v
-		case "karpenter.k8s.aws/instance-family":
-			instFamily = v
+	switch r.Operator {
+	case corev1.NodeSelectorOpExists:
+		pr.MinGpuCount = 1
+	case corev1.NodeSelectorOpIn:
+		if len(vals) == 0 {
+			return fmt.Errorf("no values for the %q operator", r.Operator)
 		}
+		minV, maxV := vals[0], vals[0]
+		for _, v := range vals[1:] {
+			minV = min(minV, v)
+			maxV = max(maxV, v)
+		}
+		pr.MinGpuCount = minV
+		pr.MaxGpuCount = &maxV
+	case corev1.NodeSelectorOpGt:
+		if len(vals) != 1 {
+			return fmt.Errorf("expected one value for the %q operator, but got %d", r.Operator, len(vals))
+		}
+		pr.MinGpuCount = vals[0] + 1
+	case corev1.NodeSelectorOpLt:
+		if len(vals) != 1 {
+			return fmt.Errorf("expected one value for the %q operator, but got %d", r.Operator, len(vals))
+		}
+		maxV := vals[0] - 1
+		pr.MaxGpuCount = &maxV
+	case corev1.NodeSelectorOpDoesNotExist:
+		maxV := int32(0)
+		pr.MaxGpuCount = &maxV
 	}
+	return nil
+}
 
-	return &v1.ProvisionableResource{
-		InstanceType:   instType,
-		InstanceFamily: instFamily,
+// toAcceleratorCounts returns the counts of the supported accelerators in the given resource list.
+func toAcceleratorCounts(rl corev1.ResourceList, logger logr.Logger) map[string]int32 {
+	var counts map[string]int32
+	for _, name := range acceleratorResourceNames(rl) {
+		v := rl[name]
+		count, ok := v.AsInt64()
+		if !ok {
+			logger.Info("Failed to convert to int64", "name", name.String(), "value", v.String())
+			continue
+		}
+		if counts == nil {
+			counts = map[string]int32{}
+		}
+		counts[name.String()] = int32(count)
 	}
+	return counts
 }
 
-func toGPUNode(node corev1.Node, logger logr.Logger) (*v1.GpuNode, bool) {
+// toGPUNodes converts a node to GPU nodes. One GPU node is returned for each accelerator resource of the node
+// so that MIG devices and time-sliced GPUs are reported as separate resource pools. Unhealthy nodes are
+// reported with the reasons so that the server can exclude them from the capacity.
+func toGPUNodes(node corev1.Node, unhealthyTaintKeys []string, logger logr.Logger) []*v1.GpuNode {
 	// Ignore cordoned nodes.
 	if node.Spec.Unschedulable {
-		return nil, false
+		return nil
 	}
+	reasons := unhealthyReasons(&node, unhealthyTaintKeys)
 
-	// TODO(kenji): Support other accelerator types.
-	rs := map[corev1.ResourceName]bool{
-		nvidiaGPU: true,
+	var mem int32
+	if v, ok := node.Labels[accelerator.NvidiaGPUMemoryLabelKey]; ok {
+		m, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			logger.Info("Failed to parse the GPU memory label", "node", node.Name, "value", v)
+		} else {
+			mem = int32(m)
+		}
 	}
 
-	for name, v := range node.Status.Allocatable {
-		if !rs[name] {
-			continue
+	names := acceleratorResourceNames(node.Status.Allocatable)
+	for _, name := range acceleratorResourceNames(node.Status.Capacity) {
+		if _, ok := node.Status.Allocatable[name]; !ok {
+			// All the accelerators are unhealthy.
+			names = append(names, name)
 		}
+	}
 
-		count, ok := v.AsInt64()
-		if !ok {
-			logger.Info("Failed to convert to int64", "name", name.String(), "value", v.String())
-			continue
+	var gpuNodes []*v1.GpuNode
+	for _, name := range names {
+		var counts [2]int64
+		for i, rl := range []corev1.ResourceList{node.Status.Allocatable, node.Status.Capacity} {
+			v, ok := rl[name]
+			if !ok {
+				continue
+			}
+			count, ok := v.AsInt64()
+			if !ok {
+				logger.Info("Failed to convert to int64", "name", name.String(), "value", v.String())
+				continue
+			}
+			counts[i] = count
 		}
 
-		return &v1.GpuNode{
+		gpuNodes = append(gpuNodes, &v1.GpuNode{
 			ResourceName: name.String(),
 			// Cast to int32 is safe as one node cannot have such a large number of GPUs.
-			AllocatableCount: int32(count),
-		}, true
+			AllocatableCount: int32(counts[0]),
+			CapacityCount:    int32(counts[1]),
+			NodeName:         node.Name,
+			Product:          node.Labels[accelerator.NvidiaGPUProductLabelKey],
+			MemoryMegabytes:  mem,
+			UnhealthyReasons: reasons,
+		})
+	}
+	return gpuNodes
+}
+
+// unhealthyReasons returns the reasons why the node cannot run workloads. It returns nil if the node is healthy.
+func unhealthyReasons(node *corev1.Node, unhealthyTaintKeys []string) []string {
+	var reasons []string
+	for _, c := range node.Status.Conditions {
+		if c.Type != corev1.NodeReady || c.Status == corev1.ConditionTrue {
+			continue
+		}
+		r := "NotReady"
+		if c.Reason != "" {
+			r = fmt.Sprintf("NotReady (%s)", c.Reason)
+		}
+		reasons = append(reasons, r)
 	}
-	return nil, false
+	for _, t := range node.Spec.Taints {
+		if t.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		for _, k := range unhealthyTaintKeys {
+			if t.Key == k {
+				reasons = append(reasons, fmt.Sprintf("taint %s:%s", t.Key, t.Effect))
+				break
+			}
+		}
+	}
+	return reasons
 }
 
 func toGPUPod(pod *corev1.Pod, logger logr.Logger) (*v1.GpuPod, bool) {
@@ -206,33 +942,103 @@ func toGPUPod(pod *corev1.Pod, logger logr.Logger) (*v1.GpuPod, bool) {
 		return nil, false
 	}
 
-	total := 0
+	totals := map[corev1.ResourceName]int{}
 	for _, con := range pod.Spec.Containers {
 		limit := con.Resources.Limits
 		if limit == nil {
 			continue
 		}
 
-		// TODO(kenji): Support other accelerator types.
-		v, ok := limit[nvidiaGPU]
-		if !ok {
-			continue
-		}
-		count, ok := v.AsInt64()
-		if !ok {
-			logger.Info("Failed to convert to int64", "value", v.String())
-			continue
+		for _, name := range acceleratorResourceNames(limit) {
+			v := limit[name]
+			count, ok := v.AsInt64()
+			if !ok {
+				logger.Info("Failed to convert to int64", "name", name.String(), "value", v.String())
+				continue
+			}
+			totals[name] += int(count)
 		}
-		total += int(count)
 	}
 
+	// Report the first accelerator requested by the pod. A pod is not expected to request
+	// more than one type of accelerators.
+	var (
+		resourceName corev1.ResourceName
+		total        int
+	)
+	for _, name := range acceleratorResourceNames(totals) {
+		if totals[name] > 0 {
+			resourceName = name
+			total = totals[name]
+			break
+		}
+	}
 	if total == 0 {
 		return nil, false
 	}
 
 	return &v1.GpuPod{
-		ResourceName:   nvidiaGPU.String(),
+		ResourceName:   resourceName.String(),
 		AllocatedCount: int32(total),
 		NamespacedName: fmt.Sprintf("%s/%s", pod.Namespace, pod.Name),
+		NodeName:       pod.Spec.NodeName,
 	}, true
 }
+
+// toAllocatable returns the allocatable CPU and memory of the node. It returns false if the node is cordoned
+// or unhealthy.
+func toAllocatable(node *corev1.Node, unhealthyTaintKeys []string) (cpuMemory, bool) {
+	if node.Spec.Unschedulable || len(unhealthyReasons(node, unhealthyTaintKeys)) > 0 {
+		return cpuMemory{}, false
+	}
+	return toCPUMemory(node.Status.Allocatable), true
+}
+
+// toPodRequest returns the CPU and memory requested by the pod. It returns false if the pod is not bound to
+// a node or has terminated.
+//
+// Same as the Kubernetes scheduler, the request of a pod is the larger of the sum of its containers and
+// the largest of its init containers, plus its overhead.
+func toPodRequest(pod *corev1.Pod) (podRequest, bool) {
+	if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return podRequest{}, false
+	}
+
+	var sum cpuMemory
+	for _, con := range pod.Spec.Containers {
+		sum = sum.add(toCPUMemory(con.Resources.Requests))
+	}
+	for _, con := range pod.Spec.InitContainers {
+		r := toCPUMemory(con.Resources.Requests)
+		sum.cpuMilicore = max(sum.cpuMilicore, r.cpuMilicore)
+		sum.memoryMegabytes = max(sum.memoryMegabytes, r.memoryMegabytes)
+	}
+	sum = sum.add(toCPUMemory(pod.Spec.Overhead))
+	return podRequest{
+		nodeName:  pod.Spec.NodeName,
+		cpuMemory: sum,
+	}, true
+}
+
+func toCPUMemory(rl corev1.ResourceList) cpuMemory {
+	var c cpuMemory
+	if v, ok := rl[corev1.ResourceCPU]; ok {
+		c.cpuMilicore = v.MilliValue()
+	}
+	if v, ok := rl[corev1.ResourceMemory]; ok {
+		c.memoryMegabytes = v.ScaledValue(6)
+	}
+	return c
+}
+
+// acceleratorResourceNames returns the sorted names of the supported accelerator resources in the given map.
+func acceleratorResourceNames[T any](m map[corev1.ResourceName]T) []corev1.ResourceName {
+	var names []corev1.ResourceName
+	for name := range m {
+		if accelerator.IsSupported(name.String()) {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
@@ -2,22 +2,36 @@ package clusterstatus
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/google/go-cmp/cmp"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	krpv1 "sigs.k8s.io/karpenter/pkg/apis/v1"
 )
 
+const (
+	nvidiaGPU corev1.ResourceName = accelerator.NvidiaGPU
+	amdGPU    corev1.ResourceName = accelerator.AMDGPU
+)
+
 func TestManager(t *testing.T) {
 	tcs := []struct {
 		name string
@@ -53,12 +67,160 @@ func TestManager(t *testing.T) {
 					{
 						ResourceName:     nvidiaGPU.String(),
 						AllocatableCount: 1,
+						NodeName:         "node1",
 					},
 					{
 						ResourceName:     nvidiaGPU.String(),
 						AllocatableCount: 2,
+						NodeName:         "node2",
+					},
+				},
+				Nodes: []*v1.Node{
+					{Name: "node1"},
+					{Name: "node2"},
+				},
+			},
+		},
+		{
+			name: "gpu nodes with gpu feature discovery labels",
+			objs: []runtime.Object{
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node1",
+						Labels: map[string]string{
+							accelerator.NvidiaGPUProductLabelKey: "NVIDIA-H100-80GB-HBM3",
+							accelerator.NvidiaGPUMemoryLabelKey:  "81559",
+						},
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							nvidiaGPU: resource.MustParse("8"),
+						},
+					},
+				},
+			},
+			want: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{
+						ResourceName:     nvidiaGPU.String(),
+						AllocatableCount: 8,
+						NodeName:         "node1",
+						Product:          "NVIDIA-H100-80GB-HBM3",
+						MemoryMegabytes:  81559,
+					},
+				},
+				Nodes: []*v1.Node{
+					{Name: "node1"},
+				},
+			},
+		},
+		{
+			name: "mig and time-sliced gpu nodes",
+			objs: []runtime.Object{
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node1",
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							"nvidia.com/mig-1g.10gb": resource.MustParse("4"),
+							"nvidia.com/mig-3g.40gb": resource.MustParse("1"),
+							"cpu":                    resource.MustParse("16"),
+						},
+					},
+				},
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node2",
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							accelerator.NvidiaGPUShared: resource.MustParse("8"),
+						},
+					},
+				},
+			},
+			want: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/mig-1g.10gb",
+						AllocatableCount: 4,
+						NodeName:         "node1",
+					},
+					{
+						ResourceName:     "nvidia.com/mig-3g.40gb",
+						AllocatableCount: 1,
+						NodeName:         "node1",
+					},
+					{
+						ResourceName:     accelerator.NvidiaGPUShared,
+						AllocatableCount: 8,
+						NodeName:         "node2",
+					},
+				},
+				Nodes: []*v1.Node{
+					{Name: "node1", AllocatableCpuMilicore: 16000},
+					{Name: "node2"},
+				},
+			},
+		},
+		{
+			name: "non-nvidia accelerator nodes",
+			objs: []runtime.Object{
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node1",
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							amdGPU: resource.MustParse("8"),
+						},
+					},
+				},
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node2",
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							accelerator.IntelGaudi: resource.MustParse("8"),
+						},
+					},
+				},
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node3",
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							accelerator.AWSNeuron: resource.MustParse("16"),
+						},
+					},
+				},
+			},
+			want: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{
+						ResourceName:     accelerator.AMDGPU,
+						AllocatableCount: 8,
+						NodeName:         "node1",
+					},
+					{
+						ResourceName:     accelerator.IntelGaudi,
+						AllocatableCount: 8,
+						NodeName:         "node2",
+					},
+					{
+						ResourceName:     accelerator.AWSNeuron,
+						AllocatableCount: 16,
+						NodeName:         "node3",
 					},
 				},
+				Nodes: []*v1.Node{
+					{Name: "node1"},
+					{Name: "node2"},
+					{Name: "node3"},
+				},
 			},
 		},
 		{
@@ -77,6 +239,181 @@ func TestManager(t *testing.T) {
 			},
 			want: &v1.ClusterStatus{
 				GpuNodes: []*v1.GpuNode{},
+				Nodes: []*v1.Node{
+					{Name: "node1", AllocatableCpuMilicore: 1000},
+				},
+			},
+		},
+		{
+			name: "cpu and memory of nodes",
+			objs: []runtime.Object{
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node1",
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("8"),
+							corev1.ResourceMemory: resource.MustParse("32G"),
+						},
+					},
+				},
+				cpuPod("running", "node1", corev1.PodRunning, "500m", "1G"),
+				cpuPod("pending", "node1", corev1.PodPending, "1", "2G"),
+				cpuPod("unbound", "", corev1.PodPending, "1", "2G"),
+				cpuPod("succeeded", "node1", corev1.PodSucceeded, "1", "2G"),
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "init",
+						Namespace: "default",
+					},
+					Spec: corev1.PodSpec{
+						NodeName: "node1",
+						InitContainers: []corev1.Container{
+							{
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU: resource.MustParse("2"),
+									},
+								},
+							},
+						},
+						Containers: []corev1.Container{
+							{
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("1"),
+										corev1.ResourceMemory: resource.MustParse("1G"),
+									},
+								},
+							},
+						},
+						Overhead: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("100m"),
+						},
+					},
+					Status: corev1.PodStatus{
+						Phase: corev1.PodRunning,
+					},
+				},
+			},
+			want: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{},
+				Nodes: []*v1.Node{
+					{
+						Name:                       "node1",
+						AllocatableCpuMilicore:     8000,
+						AllocatableMemoryMegabytes: 32000,
+						RequestedCpuMilicore:       3600,
+						RequestedMemoryMegabytes:   4000,
+					},
+				},
+			},
+		},
+		{
+			name: "unhealthy gpu nodes",
+			objs: []runtime.Object{
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node1",
+					},
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Reason: "KubeletNotReady"},
+						},
+						Capacity: corev1.ResourceList{
+							nvidiaGPU: resource.MustParse("8"),
+						},
+						Allocatable: corev1.ResourceList{
+							nvidiaGPU: resource.MustParse("8"),
+						},
+					},
+				},
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node2",
+					},
+					Spec: corev1.NodeSpec{
+						Taints: []corev1.Taint{
+							{Key: "nvidia.com/gpu", Effect: corev1.TaintEffectNoSchedule},
+							{Key: "example.com/gpu-xid-error", Effect: corev1.TaintEffectNoSchedule},
+						},
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							nvidiaGPU: resource.MustParse("8"),
+						},
+					},
+				},
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node3",
+					},
+					Spec: corev1.NodeSpec{
+						Taints: []corev1.Taint{
+							{Key: corev1.TaintNodeUnreachable, Effect: corev1.TaintEffectNoExecute},
+						},
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							nvidiaGPU: resource.MustParse("8"),
+						},
+					},
+				},
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node4",
+					},
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+						},
+						Capacity: corev1.ResourceList{
+							nvidiaGPU: resource.MustParse("8"),
+							amdGPU:    resource.MustParse("1"),
+						},
+						Allocatable: corev1.ResourceList{
+							nvidiaGPU: resource.MustParse("6"),
+						},
+					},
+				},
+			},
+			want: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{
+						ResourceName:     nvidiaGPU.String(),
+						AllocatableCount: 8,
+						CapacityCount:    8,
+						NodeName:         "node1",
+						UnhealthyReasons: []string{"NotReady (KubeletNotReady)"},
+					},
+					{
+						ResourceName:     nvidiaGPU.String(),
+						AllocatableCount: 8,
+						NodeName:         "node2",
+						UnhealthyReasons: []string{"taint example.com/gpu-xid-error:NoSchedule"},
+					},
+					{
+						ResourceName:     nvidiaGPU.String(),
+						AllocatableCount: 8,
+						NodeName:         "node3",
+						UnhealthyReasons: []string{"taint node.kubernetes.io/unreachable:NoExecute"},
+					},
+					{
+						ResourceName:     nvidiaGPU.String(),
+						AllocatableCount: 6,
+						CapacityCount:    8,
+						NodeName:         "node4",
+					},
+					{
+						ResourceName:  amdGPU.String(),
+						CapacityCount: 1,
+						NodeName:      "node4",
+					},
+				},
+				Nodes: []*v1.Node{
+					{Name: "node4"},
+				},
 			},
 		},
 		{
@@ -124,7 +461,9 @@ func TestManager(t *testing.T) {
 			want: &v1.ClusterStatus{
 				ProvisionableResources: []*v1.ProvisionableResource{
 					{
-						InstanceType: "g5.4xlarge",
+						InstanceType:  "g5.4xlarge",
+						InstanceTypes: []string{"g5.4xlarge"},
+						Source:        "karpenter.sh/v1",
 					},
 				},
 			},
@@ -153,7 +492,79 @@ func TestManager(t *testing.T) {
 			want: &v1.ClusterStatus{
 				ProvisionableResources: []*v1.ProvisionableResource{
 					{
-						InstanceFamily: "g5",
+						InstanceFamily:   "g5",
+						InstanceFamilies: []string{"g5"},
+						Source:           "karpenter.sh/v1",
+					},
+				},
+			},
+		},
+		{
+			name: "provisionable resources with gpu requirements and limits",
+			objs: []runtime.Object{
+				&krpv1.NodePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "gpu",
+					},
+					Spec: krpv1.NodePoolSpec{
+						Template: krpv1.NodeClaimTemplate{
+							Spec: krpv1.NodeClaimTemplateSpec{
+								Requirements: []krpv1.NodeSelectorRequirementWithMinValues{
+									{
+										NodeSelectorRequirement: corev1.NodeSelectorRequirement{
+											Key:      "karpenter.k8s.aws/instance-category",
+											Values:   []string{"g", "p"},
+											Operator: corev1.NodeSelectorOpIn,
+										},
+									},
+									{
+										NodeSelectorRequirement: corev1.NodeSelectorRequirement{
+											Key:      "karpenter.k8s.aws/instance-gpu-name",
+											Values:   []string{"a10g"},
+											Operator: corev1.NodeSelectorOpIn,
+										},
+									},
+									{
+										NodeSelectorRequirement: corev1.NodeSelectorRequirement{
+											Key:      "karpenter.k8s.aws/instance-gpu-count",
+											Values:   []string{"1"},
+											Operator: corev1.NodeSelectorOpGt,
+										},
+									},
+									{
+										NodeSelectorRequirement: corev1.NodeSelectorRequirement{
+											Key:      "karpenter.k8s.aws/instance-gpu-count",
+											Values:   []string{"8"},
+											Operator: corev1.NodeSelectorOpLt,
+										},
+									},
+								},
+							},
+						},
+						Limits: krpv1.Limits{
+							corev1.ResourceCPU: resource.MustParse("1000"),
+							nvidiaGPU:          resource.MustParse("16"),
+						},
+					},
+					Status: krpv1.NodePoolStatus{
+						Resources: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("48"),
+							nvidiaGPU:          resource.MustParse("4"),
+						},
+					},
+				},
+			},
+			want: &v1.ClusterStatus{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						Name:               "gpu",
+						Source:             "karpenter.sh/v1",
+						InstanceCategories: []string{"g", "p"},
+						GpuNames:           []string{"a10g"},
+						MinGpuCount:        2,
+						MaxGpuCount:        ptr.To(int32(7)),
+						GpuLimits:          map[string]int32{"nvidia.com/gpu": 16},
+						ProvisionedGpus:    map[string]int32{"nvidia.com/gpu": 4},
 					},
 				},
 			},
@@ -162,9 +573,12 @@ func TestManager(t *testing.T) {
 
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := fake.NewFakeClient(tc.objs...)
 			m := &Manager{
-				k8sClient: fake.NewFakeClient(tc.objs...),
-				logger:    testr.New(t),
+				k8sClient:          k8sClient,
+				reader:             k8sClient,
+				unhealthyTaintKeys: append(builtinUnhealthyTaintKeys, "example.com/gpu-xid-error"),
+				logger:             testr.New(t),
 			}
 			got, err := m.buildClusterStaus(context.Background())
 			assert.NoError(t, err)
@@ -173,6 +587,291 @@ func TestManager(t *testing.T) {
 	}
 }
 
+type fakeUpdater struct {
+	fullReqs  []*v1.UpdateClusterStatusRequest
+	deltaReqs []*v1.UpdateClusterStatusDeltaRequest
+
+	resyncRequired bool
+	err            error
+}
+
+func (u *fakeUpdater) UpdateClusterStatus(ctx context.Context, req *v1.UpdateClusterStatusRequest, opts ...grpc.CallOption) (*v1.UpdateClusterStatusResponse, error) {
+	u.fullReqs = append(u.fullReqs, req)
+	if u.err != nil {
+		return nil, u.err
+	}
+	return &v1.UpdateClusterStatusResponse{}, nil
+}
+
+func (u *fakeUpdater) UpdateClusterStatusDelta(ctx context.Context, req *v1.UpdateClusterStatusDeltaRequest, opts ...grpc.CallOption) (*v1.UpdateClusterStatusDeltaResponse, error) {
+	u.deltaReqs = append(u.deltaReqs, req)
+	if u.err != nil {
+		return nil, u.err
+	}
+	return &v1.UpdateClusterStatusDeltaResponse{ResyncRequired: u.resyncRequired}, nil
+}
+
+func cpuPod(name, nodeName string, phase corev1.PodPhase, cpu, memory string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{
+				{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(cpu),
+							corev1.ResourceMemory: resource.MustParse(memory),
+						},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func TestUpdateClusterStatus_Delta(t *testing.T) {
+	gpuNode := func(name string, count int) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					nvidiaGPU:          *resource.NewQuantity(int64(count), resource.DecimalSI),
+					corev1.ResourceCPU: resource.MustParse("8"),
+				},
+			},
+		}
+	}
+	gpuPod := func(name, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+				Containers: []corev1.Container{
+					{
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("1"),
+							},
+							Limits: corev1.ResourceList{
+								nvidiaGPU: resource.MustParse("1"),
+							},
+						},
+					},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+			},
+		}
+	}
+
+	k8sClient := fake.NewFakeClient(gpuNode("n0", 8), gpuPod("p0", "n0"))
+	updater := &fakeUpdater{}
+	m := NewManager(updater, time.Second, time.Hour, nil, map[string]string{"region": "us-west-2"})
+	m.k8sClient = k8sClient
+	m.reader = k8sClient
+	m.logger = testr.New(t)
+	m.sessionID = "session0"
+
+	ctx := context.Background()
+	err := m.sendFullStatus(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, updater.fullReqs, 1)
+	assert.Equal(t, "session0", updater.fullReqs[0].SessionId)
+	assert.Equal(t, int64(1), updater.fullReqs[0].SequenceNumber)
+	assert.Len(t, updater.fullReqs[0].ClusterStatus.GpuNodes, 1)
+	assert.Len(t, updater.fullReqs[0].ClusterStatus.GpuPods, 1)
+	assert.Equal(t, int64(1000), updater.fullReqs[0].ClusterStatus.Nodes[0].RequestedCpuMilicore)
+	assert.Equal(t, map[string]string{"region": "us-west-2"}, updater.fullReqs[0].ClusterStatus.Labels)
+
+	// No change.
+	err = m.updateClusterStatus(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, updater.deltaReqs)
+
+	m.onNodeUpdate(gpuNode("n1", 4))
+	// Non-GPU changes are not sent.
+	m.onNodeUpdate(gpuNode("n0", 8))
+	m.onPodDelete(gpuPod("p0", "n0"))
+	m.onPodUpdate(gpuPod("p1", "n1"))
+	err = m.updateClusterStatus(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, updater.deltaReqs, 1)
+	want := &v1.UpdateClusterStatusDeltaRequest{
+		SessionId:      "session0",
+		SequenceNumber: 2,
+		UpdatedGpuNodes: []*v1.GpuNode{
+			{ResourceName: "nvidia.com/gpu", AllocatableCount: 4, NodeName: "n1"},
+		},
+		UpdatedGpuPods: []*v1.GpuPod{
+			{ResourceName: "nvidia.com/gpu", AllocatedCount: 1, NamespacedName: "default/p1", NodeName: "n1"},
+		},
+		DeletedPodNames: []string{"default/p0"},
+		UpdatedNodes: []*v1.Node{
+			{Name: "n0", AllocatableCpuMilicore: 8000},
+			{Name: "n1", AllocatableCpuMilicore: 8000, RequestedCpuMilicore: 1000},
+		},
+	}
+	assert.Truef(t, proto.Equal(want, updater.deltaReqs[0]), cmp.Diff(want, updater.deltaReqs[0], protocmp.Transform()))
+
+	// The server has missed a delta.
+	updater.resyncRequired = true
+	m.onNodeDelete(gpuNode("n1", 4))
+	err = m.updateClusterStatus(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, updater.deltaReqs, 2)
+	assert.Equal(t, []string{"n1"}, updater.deltaReqs[1].DeletedNodeNames)
+	assert.Equal(t, []string{"n1"}, updater.deltaReqs[1].DeletedNodes)
+	assert.Len(t, updater.fullReqs, 2)
+	assert.Equal(t, int64(3), updater.fullReqs[1].SequenceNumber)
+
+	// An empty delta is sent as a heartbeat when nothing has changed for a while.
+	updater.resyncRequired = false
+	m.lastSent = time.Now().Add(-2 * heartbeatInterval)
+	err = m.updateClusterStatus(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, updater.deltaReqs, 3)
+	want = &v1.UpdateClusterStatusDeltaRequest{
+		SessionId:      "session0",
+		SequenceNumber: 4,
+	}
+	assert.Truef(t, proto.Equal(want, updater.deltaReqs[2]), cmp.Diff(want, updater.deltaReqs[2], protocmp.Transform()))
+
+	// A new ResourceQuota is sent.
+	err = k8sClient.Create(ctx, &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "compute",
+			Namespace: "default",
+		},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{"requests.nvidia.com/gpu": resource.MustParse("4")},
+			Used: corev1.ResourceList{"requests.nvidia.com/gpu": resource.MustParse("1")},
+		},
+	})
+	assert.NoError(t, err)
+	err = m.updateClusterStatus(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, updater.deltaReqs, 4)
+	want = &v1.UpdateClusterStatusDeltaRequest{
+		SessionId:      "session0",
+		SequenceNumber: 5,
+		NamespaceQuotas: []*v1.NamespaceQuota{
+			{
+				Namespace: "default",
+				Hard:      map[string]int64{"nvidia.com/gpu": 4},
+				Used:      map[string]int64{"nvidia.com/gpu": 1},
+			},
+		},
+		NamespaceQuotasUpdated: true,
+	}
+	assert.Truef(t, proto.Equal(want, updater.deltaReqs[3]), cmp.Diff(want, updater.deltaReqs[3], protocmp.Transform()))
+}
+
+func TestSyncClusterStatus_Failure(t *testing.T) {
+	k8sClient := fake.NewFakeClient()
+	updater := &fakeUpdater{err: errors.New("unavailable")}
+	m := NewManager(updater, time.Second, time.Hour, nil, nil)
+	m.k8sClient = k8sClient
+	m.reader = k8sClient
+	m.logger = testr.New(t)
+	m.sessionID = "session0"
+
+	ctx := context.Background()
+	m.syncClusterStatus(ctx)
+	assert.Len(t, updater.fullReqs, 1)
+
+	// The full status is sent again after the failure.
+	updater.err = nil
+	m.syncClusterStatus(ctx)
+	assert.Len(t, updater.fullReqs, 2)
+	assert.Equal(t, int64(1), updater.fullReqs[1].SequenceNumber)
+
+	// A failed delta is followed by a full resync.
+	m.onNodeUpdate(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n0"},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{nvidiaGPU: resource.MustParse("8")},
+		},
+	})
+	updater.err = errors.New("unavailable")
+	m.syncClusterStatus(ctx)
+	assert.Len(t, updater.deltaReqs, 1)
+	assert.Len(t, updater.fullReqs, 2)
+
+	updater.err = nil
+	m.syncClusterStatus(ctx)
+	assert.Len(t, updater.deltaReqs, 1)
+	assert.Len(t, updater.fullReqs, 3)
+	assert.Equal(t, int64(2), updater.fullReqs[2].SequenceNumber)
+}
+
+func TestListKarpenterNodePools_V1beta1(t *testing.T) {
+	np := &unstructured.Unstructured{}
+	np.SetAPIVersion("karpenter.sh/v1beta1")
+	np.SetKind("NodePool")
+	np.SetName("gpu")
+	np.Object["spec"] = map[string]interface{}{
+		"template": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"nodeClassRef": map[string]interface{}{
+					"apiVersion": "karpenter.k8s.aws/v1beta1",
+					"kind":       "EC2NodeClass",
+					"name":       "default",
+				},
+				"requirements": []interface{}{
+					map[string]interface{}{
+						"key":      "karpenter.k8s.aws/instance-family",
+						"operator": "In",
+						"values":   []interface{}{"g5", "g6"},
+					},
+				},
+			},
+		},
+		"limits": map[string]interface{}{
+			"nvidia.com/gpu": "8",
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithObjects(np).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if _, ok := list.(*krpv1.NodePoolList); ok {
+					return fmt.Errorf("no matches for kind \"NodePool\" in version \"karpenter.sh/v1\"")
+				}
+				return c.List(ctx, list, opts...)
+			},
+		}).
+		Build()
+	m := &Manager{
+		k8sClient: k8sClient,
+		logger:    testr.New(t),
+	}
+	got := m.listKarpenterNodePools(context.Background())
+	want := []*v1.ProvisionableResource{
+		{
+			Name:             "gpu",
+			Source:           "karpenter.sh/v1beta1",
+			InstanceFamily:   "g5",
+			InstanceFamilies: []string{"g5", "g6"},
+			GpuLimits:        map[string]int32{"nvidia.com/gpu": 8},
+		},
+	}
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+}
+
 func TestToGPUPod(t *testing.T) {
 	tcs := []struct {
 		name   string
@@ -188,6 +887,7 @@ func TestToGPUPod(t *testing.T) {
 					Namespace: "default",
 				},
 				Spec: corev1.PodSpec{
+					NodeName: "node1",
 					Containers: []corev1.Container{
 						{
 							Resources: corev1.ResourceRequirements{
@@ -213,6 +913,69 @@ func TestToGPUPod(t *testing.T) {
 				ResourceName:   nvidiaGPU.String(),
 				AllocatedCount: 3,
 				NamespacedName: "default/pod1",
+				NodeName:       "node1",
+			},
+			wantOK: true,
+		},
+		{
+			name: "amd gpu pod",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod1",
+					Namespace: "default",
+				},
+				Spec: corev1.PodSpec{
+					NodeName: "node1",
+					Containers: []corev1.Container{
+						{
+							Resources: corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{
+									amdGPU: resource.MustParse("2"),
+								},
+							},
+						},
+					},
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+				},
+			},
+			want: &v1.GpuPod{
+				ResourceName:   amdGPU.String(),
+				AllocatedCount: 2,
+				NamespacedName: "default/pod1",
+				NodeName:       "node1",
+			},
+			wantOK: true,
+		},
+		{
+			name: "mig pod",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod1",
+					Namespace: "default",
+				},
+				Spec: corev1.PodSpec{
+					NodeName: "node1",
+					Containers: []corev1.Container{
+						{
+							Resources: corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{
+									"nvidia.com/mig-1g.10gb": resource.MustParse("1"),
+								},
+							},
+						},
+					},
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+				},
+			},
+			want: &v1.GpuPod{
+				ResourceName:   "nvidia.com/mig-1g.10gb",
+				AllocatedCount: 1,
+				NamespacedName: "default/pod1",
+				NodeName:       "node1",
 			},
 			wantOK: true,
 		},
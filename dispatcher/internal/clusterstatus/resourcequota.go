@@ -0,0 +1,97 @@
+package clusterstatus
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Keys of the resources in namespace quotas.
+const (
+	quotaKeyCPU    = "cpu"
+	quotaKeyMemory = "memory"
+)
+
+// listNamespaceQuotas lists the ResourceQuotas of all namespaces and converts them to the namespace quotas
+// sorted by namespace.
+func (m *Manager) listNamespaceQuotas(ctx context.Context) ([]*v1.NamespaceQuota, error) {
+	rqs := &corev1.ResourceQuotaList{}
+	if err := m.k8sClient.List(ctx, rqs); err != nil {
+		return nil, err
+	}
+
+	quotasByNamespace := map[string]*v1.NamespaceQuota{}
+	for _, rq := range rqs.Items {
+		if len(rq.Spec.Scopes) > 0 || rq.Spec.ScopeSelector != nil {
+			// Scoped quotas apply only to some of the pods. Ignore them to avoid rejecting workloads they do not limit.
+			continue
+		}
+		q, ok := quotasByNamespace[rq.Namespace]
+		if !ok {
+			q = &v1.NamespaceQuota{
+				Namespace: rq.Namespace,
+				Hard:      map[string]int64{},
+				Used:      map[string]int64{},
+			}
+			quotasByNamespace[rq.Namespace] = q
+		}
+		// Use the status as the hard limits in the spec are not enforced until the quota controller observes them.
+		for name, hard := range rq.Status.Hard {
+			key, ok := toQuotaKey(name)
+			if !ok {
+				continue
+			}
+			used := rq.Status.Used[name]
+			h, u := toQuotaValue(key, hard), toQuotaValue(key, used)
+			if oh, ok := q.Hard[key]; ok && oh-q.Used[key] <= h-u {
+				// Keep the quota with the smallest headroom.
+				continue
+			}
+			q.Hard[key] = h
+			q.Used[key] = u
+		}
+	}
+
+	var quotas []*v1.NamespaceQuota
+	for _, q := range quotasByNamespace {
+		if len(q.Hard) == 0 {
+			continue
+		}
+		quotas = append(quotas, q)
+	}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].Namespace < quotas[j].Namespace })
+	return quotas, nil
+}
+
+// toQuotaKey converts the name of a resource in a ResourceQuota to the key of the namespace quota.
+// Only the requests of CPU, memory, and accelerators are supported.
+func toQuotaKey(name corev1.ResourceName) (string, bool) {
+	switch name {
+	case corev1.ResourceCPU, corev1.ResourceRequestsCPU:
+		return quotaKeyCPU, true
+	case corev1.ResourceMemory, corev1.ResourceRequestsMemory:
+		return quotaKeyMemory, true
+	}
+	// Quotas of extended resources are specified with the "requests." prefix.
+	n, ok := strings.CutPrefix(name.String(), corev1.DefaultResourceRequestsPrefix)
+	if !ok || !accelerator.IsSupported(n) {
+		return "", false
+	}
+	return n, true
+}
+
+func toQuotaValue(key string, q resource.Quantity) int64 {
+	switch key {
+	case quotaKeyCPU:
+		return q.MilliValue()
+	case quotaKeyMemory:
+		return q.ScaledValue(6)
+	default:
+		return q.Value()
+	}
+}
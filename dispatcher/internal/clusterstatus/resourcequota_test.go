@@ -0,0 +1,90 @@
+package clusterstatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/testing/protocmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestListNamespaceQuotas(t *testing.T) {
+	quota := func(namespace, name string, hard, used corev1.ResourceList) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Status: corev1.ResourceQuotaStatus{
+				Hard: hard,
+				Used: used,
+			},
+		}
+	}
+
+	scoped := quota("team-a-dev", "scoped", corev1.ResourceList{
+		corev1.ResourceRequestsCPU: resource.MustParse("1"),
+	}, nil)
+	scoped.Spec.Scopes = []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort}
+
+	k8sClient := fake.NewFakeClient(
+		quota("team-a-prod", "compute", corev1.ResourceList{
+			corev1.ResourceRequestsCPU:    resource.MustParse("16"),
+			corev1.ResourceRequestsMemory: resource.MustParse("64G"),
+			"requests.nvidia.com/gpu":     resource.MustParse("8"),
+			corev1.ResourcePods:           resource.MustParse("100"),
+		}, corev1.ResourceList{
+			corev1.ResourceRequestsCPU:    resource.MustParse("4"),
+			corev1.ResourceRequestsMemory: resource.MustParse("1000M"),
+			"requests.nvidia.com/gpu":     resource.MustParse("2"),
+			corev1.ResourcePods:           resource.MustParse("3"),
+		}),
+		// The quota with the smaller headroom is reported.
+		quota("team-a-prod", "gpu", corev1.ResourceList{
+			"requests.nvidia.com/gpu": resource.MustParse("4"),
+		}, corev1.ResourceList{
+			"requests.nvidia.com/gpu": resource.MustParse("2"),
+		}),
+		quota("team-a-dev", "compute", corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("500m"),
+		}, corev1.ResourceList{}),
+		scoped,
+		quota("team-b", "objects", corev1.ResourceList{
+			corev1.ResourceConfigMaps: resource.MustParse("10"),
+		}, nil),
+	)
+	m := &Manager{
+		k8sClient: k8sClient,
+		logger:    testr.New(t),
+	}
+	got, err := m.listNamespaceQuotas(context.Background())
+	assert.NoError(t, err)
+	want := []*v1.NamespaceQuota{
+		{
+			Namespace: "team-a-dev",
+			Hard:      map[string]int64{"cpu": 500},
+			Used:      map[string]int64{"cpu": 0},
+		},
+		{
+			Namespace: "team-a-prod",
+			Hard: map[string]int64{
+				"cpu":            16000,
+				"memory":         64000,
+				"nvidia.com/gpu": 4,
+			},
+			Used: map[string]int64{
+				"cpu":            4000,
+				"memory":         1000,
+				"nvidia.com/gpu": 2,
+			},
+		},
+	}
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+}
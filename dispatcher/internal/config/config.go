@@ -191,6 +191,12 @@ type KueueConfig struct {
 	Enable bool `yaml:"enable"`
 
 	DefaultQueueName string `yaml:"defaultQueueName"`
+	// NamespaceQueueNames are the names of the LocalQueues keyed by namespaces. Workloads are submitted to
+	// the queue of their namespace unless their projects specify queues. DefaultQueueName is used for
+	// the namespaces that are not listed.
+	NamespaceQueueNames map[string]string `yaml:"namespaceQueueNames"`
+
+	Provisioning KueueProvisioningConfig `yaml:"provisioning"`
 }
 
 // validate validates the Kueue configuration.
@@ -201,6 +207,43 @@ func (c *KueueConfig) validate() error {
 	if c.DefaultQueueName == "" {
 		return fmt.Errorf("default queue name must be set")
 	}
+	for ns, name := range c.NamespaceQueueNames {
+		if name == "" {
+			return fmt.Errorf("queue name of namespace %q must be set", ns)
+		}
+	}
+	if err := c.Provisioning.validate(); err != nil {
+		return fmt.Errorf("provisioning: %s", err)
+	}
+	return nil
+}
+
+// KueueProvisioningConfig is the configuration of provisioning Kueue queues.
+type KueueProvisioningConfig struct {
+	// Enable enables creating the LocalQueue and the ClusterQueue of the namespace where a workload runs.
+	Enable bool `yaml:"enable"`
+	// ResourceFlavorName is the name of the ResourceFlavor that the quotas of the ClusterQueues are assigned to.
+	ResourceFlavorName string `yaml:"resourceFlavorName"`
+	// DefaultResourceQuotas are the nominal quotas of the ClusterQueues of the namespaces whose projects
+	// do not specify quotas.
+	DefaultResourceQuotas map[string]string `yaml:"defaultResourceQuotas"`
+}
+
+func (c *KueueProvisioningConfig) validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.ResourceFlavorName == "" {
+		return fmt.Errorf("resource flavor name must be set")
+	}
+	if len(c.DefaultResourceQuotas) == 0 {
+		return fmt.Errorf("default resource quotas must be set")
+	}
+	for name, q := range c.DefaultResourceQuotas {
+		if _, err := resource.ParseQuantity(q); err != nil {
+			return fmt.Errorf("invalid quota of %q: %s", name, err)
+		}
+	}
 	return nil
 }
 
@@ -214,9 +257,169 @@ type WorkerConfig struct {
 	TLS WorkerTLSConfig `yaml:"tls"`
 }
 
+// DispatchStreamConfig is the configuration of the stream of the queued actions pushed by the server.
+type DispatchStreamConfig struct {
+	Enable bool `yaml:"enable"`
+	// FallbackPollingInterval is the interval to poll queued actions while the stream is connected.
+	// Polling picks up the actions that are not pushed (e.g., the actions whose processing failed).
+	FallbackPollingInterval time.Duration `yaml:"fallbackPollingInterval"`
+}
+
+func (c *DispatchStreamConfig) validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.FallbackPollingInterval <= 0 {
+		return fmt.Errorf("fallback polling interval must be greater than 0")
+	}
+	return nil
+}
+
+// QueuedActionConfig is the configuration of processing the queued actions of workloads.
+type QueuedActionConfig struct {
+	// Concurrency is the maximum number of actions processed concurrently for each workload type.
+	Concurrency int `yaml:"concurrency"`
+	// InitialBackoff is the delay before retrying a failed action. The delay doubles at each failure up to MaxBackoff.
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	MaxBackoff     time.Duration `yaml:"maxBackoff"`
+	// MaxAttempts is the number of failed attempts after which an action is dead-lettered. A dead-lettered action
+	// is not retried until the workload gets a new action or the dispatcher restarts.
+	MaxAttempts int `yaml:"maxAttempts"`
+	// Timeout is the maximum duration of an attempt to process an action. An attempt that times out fails.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+func (c *QueuedActionConfig) validate() error {
+	if c.Concurrency <= 0 {
+		return fmt.Errorf("concurrency must be greater than 0")
+	}
+	if c.InitialBackoff <= 0 {
+		return fmt.Errorf("initial backoff must be greater than 0")
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("max backoff must be greater than or equal to the initial backoff")
+	}
+	if c.MaxAttempts <= 0 {
+		return fmt.Errorf("max attempts must be greater than 0")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("timeout must be greater than 0")
+	}
+	return nil
+}
+
+// PodFailureConfig is the configuration of detecting the pods of workloads that fail to start.
+type PodFailureConfig struct {
+	// UnschedulableThreshold is the duration after which an unschedulable pod is reported as a failure.
+	UnschedulableThreshold time.Duration `yaml:"unschedulableThreshold"`
+	// FailureGracePeriod is the duration after which a workload whose pod keeps failing to start is failed.
+	// Workloads are not failed if the grace period is zero.
+	FailureGracePeriod time.Duration `yaml:"failureGracePeriod"`
+}
+
+// defaultUnschedulableThreshold is the unschedulable threshold used when it is not configured.
+const defaultUnschedulableThreshold = 10 * time.Minute
+
+func (c *PodFailureConfig) validate() error {
+	if c.UnschedulableThreshold == 0 {
+		c.UnschedulableThreshold = defaultUnschedulableThreshold
+	}
+	if c.UnschedulableThreshold <= 0 {
+		return fmt.Errorf("unschedulable threshold must be greater than 0")
+	}
+	if c.FailureGracePeriod < 0 {
+		return fmt.Errorf("failure grace period must not be negative")
+	}
+	return nil
+}
+
+// LogArchiveConfig is the configuration of archiving the logs of finished workloads in object storage.
+type LogArchiveConfig struct {
+	// Enable enables uploading the container logs of finished fine-tuning jobs and batch jobs to the S3 bucket
+	// of the object store before the jobs are garbage-collected.
+	Enable bool `yaml:"enable"`
+	// PathPrefix is the key prefix of the archived logs. It must match the path prefix configured in the server.
+	PathPrefix string `yaml:"pathPrefix"`
+	// ChunkSize is the uncompressed size in bytes of the chunks that a container log is split into.
+	ChunkSize int64 `yaml:"chunkSize"`
+}
+
+func (c *LogArchiveConfig) validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.PathPrefix == "" {
+		return fmt.Errorf("path prefix must be set")
+	}
+	if c.ChunkSize <= 0 {
+		return fmt.Errorf("chunk size must be greater than 0")
+	}
+	return nil
+}
+
+// OrphanCollectionConfig is the configuration of garbage-collecting the secrets and configmaps that the server
+// creates for workloads and that no workload owns.
+type OrphanCollectionConfig struct {
+	Enable bool `yaml:"enable"`
+	// Interval is the interval to look for orphaned secrets and configmaps.
+	Interval time.Duration `yaml:"interval"`
+	// GracePeriod is the minimum age of a secret or a configmap to be deleted. It gives the dispatcher time to
+	// create the workload and set the owner reference after the server creates the secret or the configmap.
+	GracePeriod time.Duration `yaml:"gracePeriod"`
+}
+
+func (c *OrphanCollectionConfig) validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("interval must be greater than 0")
+	}
+	if c.GracePeriod <= 0 {
+		return fmt.Errorf("grace period must be greater than 0")
+	}
+	return nil
+}
+
+// ReconciliationConfig is the configuration of periodically reconciling the workloads that the server assigns to
+// the cluster with the Kubernetes jobs and deployments in the cluster.
+type ReconciliationConfig struct {
+	Enable bool `yaml:"enable"`
+	// Interval is the interval of the reconciliation.
+	Interval time.Duration `yaml:"interval"`
+	// GracePeriod is the minimum age of a job or a deployment to be deleted when its workload is no longer
+	// active in the cluster.
+	GracePeriod time.Duration `yaml:"gracePeriod"`
+}
+
+func (c *ReconciliationConfig) validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("interval must be greater than 0")
+	}
+	if c.GracePeriod <= 0 {
+		return fmt.Errorf("grace period must be greater than 0")
+	}
+	return nil
+}
+
 // Config is the configuration.
 type Config struct {
 	PollingInterval time.Duration `yaml:"pollingInterval"`
+	// DispatchStream is the configuration of the stream of the queued actions pushed by the server.
+	// Polling at PollingInterval is used while the stream is disconnected.
+	DispatchStream DispatchStreamConfig `yaml:"dispatchStream"`
+	QueuedAction   QueuedActionConfig   `yaml:"queuedAction"`
+
+	PodFailure PodFailureConfig `yaml:"podFailure"`
+
+	LogArchive LogArchiveConfig `yaml:"logArchive"`
+
+	OrphanCollection OrphanCollectionConfig `yaml:"orphanCollection"`
+
+	Reconciliation ReconciliationConfig `yaml:"reconciliation"`
 
 	Job      JobConfig       `yaml:"job"`
 	Notebook NotebooksConfig `yaml:"notebook"`
@@ -241,6 +444,15 @@ type Config struct {
 	ComponentStatusSender status.Config `yaml:"componentStatusSender"`
 
 	ClusterStatusUpdateInterval time.Duration `yaml:"clusterStatusUpdateInterval"`
+	// ClusterStatusFullResyncInterval is the interval to send the full cluster status. The changes of the cluster
+	// status are sent every ClusterStatusUpdateInterval in between.
+	ClusterStatusFullResyncInterval time.Duration `yaml:"clusterStatusFullResyncInterval"`
+	// UnhealthyNodeTaintKeys are the keys of the node taints that mark GPU nodes unhealthy in addition to
+	// the taints that Kubernetes adds to nodes that are not ready or unreachable.
+	UnhealthyNodeTaintKeys []string `yaml:"unhealthyNodeTaintKeys"`
+	// ClusterLabels are the labels of the cluster (e.g., region, provider) reported to the server.
+	// They are used by placement constraints of workloads.
+	ClusterLabels map[string]string `yaml:"clusterLabels"`
 }
 
 // Validate validates the configuration.
@@ -248,6 +460,24 @@ func (c *Config) Validate() error {
 	if c.PollingInterval <= 0 {
 		return fmt.Errorf("job polling interval must be greater than 0")
 	}
+	if err := c.DispatchStream.validate(); err != nil {
+		return fmt.Errorf("dispatch stream: %s", err)
+	}
+	if err := c.QueuedAction.validate(); err != nil {
+		return fmt.Errorf("queued action: %s", err)
+	}
+	if err := c.PodFailure.validate(); err != nil {
+		return fmt.Errorf("pod failure: %s", err)
+	}
+	if err := c.LogArchive.validate(); err != nil {
+		return fmt.Errorf("log archive: %s", err)
+	}
+	if err := c.OrphanCollection.validate(); err != nil {
+		return fmt.Errorf("orphan collection: %s", err)
+	}
+	if err := c.Reconciliation.validate(); err != nil {
+		return fmt.Errorf("reconciliation: %s", err)
+	}
 	if err := c.Job.validate(); err != nil {
 		return fmt.Errorf("job: %s", err)
 	}
@@ -286,6 +516,9 @@ func (c *Config) Validate() error {
 	if c.ClusterStatusUpdateInterval <= 0 {
 		return fmt.Errorf("cluster status update interval must be greater than 0")
 	}
+	if c.ClusterStatusFullResyncInterval < c.ClusterStatusUpdateInterval {
+		return fmt.Errorf("cluster status full resync interval must be greater than or equal to the update interval")
+	}
 
 	return nil
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -58,3 +59,92 @@ func TestNoteBookConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestKueueConfigValidate(t *testing.T) {
+	tcs := []struct {
+		name    string
+		c       *KueueConfig
+		wantErr bool
+	}{
+		{
+			name: "valid config",
+			c: &KueueConfig{
+				Enable:           true,
+				DefaultQueueName: "default",
+				NamespaceQueueNames: map[string]string{
+					"ns0": "queue0",
+				},
+				Provisioning: KueueProvisioningConfig{
+					Enable:             true,
+					ResourceFlavorName: "default-flavor",
+					DefaultResourceQuotas: map[string]string{
+						"nvidia.com/gpu": "8",
+					},
+				},
+			},
+		},
+		{
+			name: "empty namespace queue name",
+			c: &KueueConfig{
+				Enable:           true,
+				DefaultQueueName: "default",
+				NamespaceQueueNames: map[string]string{
+					"ns0": "",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no default resource quotas",
+			c: &KueueConfig{
+				Enable:           true,
+				DefaultQueueName: "default",
+				Provisioning: KueueProvisioningConfig{
+					Enable:             true,
+					ResourceFlavorName: "default-flavor",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid resource quota",
+			c: &KueueConfig{
+				Enable:           true,
+				DefaultQueueName: "default",
+				Provisioning: KueueProvisioningConfig{
+					Enable:             true,
+					ResourceFlavorName: "default-flavor",
+					DefaultResourceQuotas: map[string]string{
+						"nvidia.com/gpu": "eight",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPodFailureConfigValidate(t *testing.T) {
+	c := &PodFailureConfig{}
+	assert.NoError(t, c.validate())
+	assert.Equal(t, 10*time.Minute, c.UnschedulableThreshold)
+
+	c = &PodFailureConfig{UnschedulableThreshold: time.Minute}
+	assert.NoError(t, c.validate())
+	assert.Equal(t, time.Minute, c.UnschedulableThreshold)
+
+	c = &PodFailureConfig{UnschedulableThreshold: -time.Minute}
+	assert.Error(t, c.validate())
+}
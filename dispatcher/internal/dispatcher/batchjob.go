@@ -12,6 +12,8 @@ import (
 	v1 "github.com/llmariner/job-manager/api/v1"
 	"github.com/llmariner/job-manager/dispatcher/internal/config"
 	is3 "github.com/llmariner/job-manager/dispatcher/internal/s3"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"github.com/llmariner/job-manager/pkg/workload"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -71,6 +73,8 @@ type BatchJobManagerOptions struct {
 	KueueConfig    config.KueueConfig
 	WorkloadConfig config.WorkloadConfig
 
+	PodFailureConfig config.PodFailureConfig
+
 	S3Bucket string
 }
 
@@ -86,6 +90,9 @@ func NewBatchJobManager(opts BatchJobManagerOptions) *BatchJobManager {
 		kueueConfig:    opts.KueueConfig,
 		workloadConfig: opts.WorkloadConfig,
 		s3Bucket:       opts.S3Bucket,
+
+		kueueQueues:       newKueueQueueManager(opts.K8sClient, opts.KueueConfig),
+		podFailureChecker: newPodFailureChecker(opts.K8sClient, opts.PodFailureConfig),
 	}
 }
 
@@ -100,8 +107,11 @@ type BatchJobManager struct {
 
 	wandbConfig    config.WandbAPIKeySecretConfig
 	kueueConfig    config.KueueConfig
+	kueueQueues    *kueueQueueManager
 	workloadConfig config.WorkloadConfig
 
+	podFailureChecker *podFailureChecker
+
 	s3Bucket string
 }
 
@@ -113,6 +123,8 @@ func (m *BatchJobManager) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("batchjob").
 		For(&batchv1.Job{}, builder.WithPredicates(filterByAnno)).
+		// Watch pods to detect the failures that are not propagated to the job (e.g., image pull errors).
+		Watches(&corev1.Pod{}, enqueueJobOfPod(mgr.GetClient(), isManagedBatchJob)).
 		WithLogConstructor(func(r *reconcile.Request) logr.Logger {
 			if r != nil {
 				return mgr.GetLogger().WithValues("batchjob", r.NamespacedName)
@@ -185,20 +197,28 @@ func (m *BatchJobManager) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	}
 
 	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
-		// TODO(aya): check pod status, image pull error is not propagated to the job
 		log.V(2).Info("K8s job is still running")
-		return ctrl.Result{}, nil
+		return m.checkPodFailure(ctx, &job, ibjob)
+	}
+	if err := m.updateStatusReason(ctx, ibjob, ""); err != nil {
+		log.Error(err, "Failed to clear the status reason")
+		return ctrl.Result{}, err
 	}
 
 	upReq := &v1.UpdateBatchJobStateRequest{Id: jobID}
 	if job.Status.Failed > 0 {
-		for _, cond := range job.Status.Conditions {
-			if cond.Type == batchv1.JobFailed {
-				upReq.Reason = cond.Reason
-				upReq.Message = cond.Message
-				break
-			}
+		var podList corev1.PodList
+		if err := m.k8sClient.List(ctx, &podList,
+			client.InNamespace(job.Namespace),
+			client.MatchingLabels{"job-name": job.Name},
+		); err != nil {
+			log.Error(err, "Failed to list the pods of the k8s job")
+			return ctrl.Result{}, err
 		}
+		f := classifyJobFailure(&job, podList.Items)
+		upReq.Reason = f.code
+		upReq.Message = f.message
+		upReq.ExitCode = f.exitCode
 		upReq.State = v1.InternalBatchJob_FAILED
 	} else {
 		upReq.State = v1.InternalBatchJob_SUCCEEDED
@@ -212,6 +232,57 @@ func (m *BatchJobManager) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	return ctrl.Result{}, nil
 }
 
+// checkPodFailure checks the pods of a running batch job and reports the reason if they fail to start. The batch
+// job is failed if the failure lasts longer than the grace period.
+func (m *BatchJobManager) checkPodFailure(ctx context.Context, job *batchv1.Job, ibjob *v1.InternalBatchJob) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	result, err := m.podFailureChecker.check(ctx, job)
+	if err != nil {
+		log.Error(err, "Failed to check pod failures")
+		return ctrl.Result{}, err
+	}
+	if err := m.updateStatusReason(ctx, ibjob, result.reason); err != nil {
+		log.Error(err, "Failed to update the status reason")
+		return ctrl.Result{}, err
+	}
+	if !result.fail {
+		return ctrl.Result{RequeueAfter: result.requeueAfter}, nil
+	}
+
+	// Suspend the job to stop its pods from retrying.
+	job.Spec.Suspend = ptr.To(true)
+	if err := m.k8sClient.Update(ctx, job, client.FieldOwner(bjManagerName)); err != nil {
+		log.Error(err, "Failed to suspend the k8s job")
+		return ctrl.Result{}, err
+	}
+	if _, err := m.bwClient.UpdateBatchJobState(ctx, &v1.UpdateBatchJobStateRequest{
+		Id:      ibjob.Job.Id,
+		State:   v1.InternalBatchJob_FAILED,
+		Reason:  result.code,
+		Message: result.reason,
+	}); err != nil {
+		log.Error(err, "Failed to update the batch job state")
+		return ctrl.Result{}, err
+	}
+	log.Info("Batch job failed as its pod failed to start", "reason", result.reason)
+	return ctrl.Result{}, nil
+}
+
+func (m *BatchJobManager) updateStatusReason(ctx context.Context, ibjob *v1.InternalBatchJob, reason string) error {
+	if ibjob.Job.StatusReason == reason {
+		return nil
+	}
+	if _, err := m.bwClient.UpdateBatchJobStatusReason(ctx, &v1.UpdateBatchJobStatusReasonRequest{
+		Id:           ibjob.Job.Id,
+		StatusReason: reason,
+	}); err != nil {
+		return err
+	}
+	ibjob.Job.StatusReason = reason
+	return nil
+}
+
 // createBatchJob creates a k8s job for the internal batch job. The created k8s job has init and main containers.
 // init container downloads data files from object storage, and stores them in an shared volume. main container
 // precedes the user command with installing requirements packages.
@@ -226,7 +297,15 @@ func (m *BatchJobManager) createBatchJob(ctx context.Context, ibjob *v1.Internal
 		"app.kubernetes.io/created-by": bjManagerName,
 	}
 	if m.kueueConfig.Enable {
-		labels[kueueQueueNameLabelKey] = m.kueueConfig.DefaultQueueName
+		q := ibjob.KueueQueue
+		queueName, err := m.kueueQueues.ensureQueue(ctx, ibjob.Job.KubernetesNamespace, kueueQueue{
+			name:           q.GetName(),
+			resourceQuotas: q.GetResourceQuotas(),
+		})
+		if err != nil {
+			return fmt.Errorf("ensure kueue queue: %s", err)
+		}
+		labels[kueueQueueNameLabelKey] = queueName
 	}
 
 	var initEnvs, envs []*corev1apply.EnvVarApplyConfiguration
@@ -276,11 +355,23 @@ func (m *BatchJobManager) createBatchJob(ctx context.Context, ibjob *v1.Internal
 		}
 	}
 
+	req := corev1.ResourceList{}
 	limit := corev1.ResourceList{}
-	if r := ibjob.Job.Resources; r != nil && r.GpuCount > 0 {
-		limit["nvidia.com/gpu"] = *resource.NewQuantity(int64(r.GpuCount), resource.DecimalSI)
+	if r := ibjob.Job.Resources; r != nil {
+		if r.CpuMilicore > 0 {
+			req[corev1.ResourceCPU] = *resource.NewMilliQuantity(int64(r.CpuMilicore), resource.DecimalSI)
+		}
+		if r.MemoryMegabytes > 0 {
+			req[corev1.ResourceMemory] = *resource.NewScaledQuantity(int64(r.MemoryMegabytes), 6)
+		}
+		if r.GpuCount > 0 {
+			limit[corev1.ResourceName(accelerator.ResourceName(r.AcceleratorType, r.GpuProfile))] = *resource.NewQuantity(int64(r.GpuCount), resource.DecimalSI)
+		}
 	}
 	resources := corev1apply.ResourceRequirements()
+	if len(req) > 0 {
+		resources.WithRequests(req)
+	}
 	if len(limit) > 0 {
 		resources.WithLimits(limit)
 	}
@@ -357,10 +448,29 @@ func (m *BatchJobManager) createBatchJob(ctx context.Context, ibjob *v1.Internal
 				WithConfigMap(corev1apply.ConfigMapVolumeSource().
 					WithName(name)))
 	podSpec = applyWorkloadConfig(podSpec, m.workloadConfig)
+	podSpec = applyGPUNodeAffinity(podSpec, ibjob.Job.Resources.GetGpuType(), ibjob.Job.Resources.GetMinGpuMemoryMegabytes())
+
+	if replicas > 1 {
+		// Place each worker on a distinct node as the server schedules the workers as a gang
+		// under this assumption. With Kueue, the queue-name label of the Job makes Kueue admit
+		// all the workers as a single workload.
+		if podSpec.Affinity == nil {
+			podSpec = podSpec.WithAffinity(corev1apply.Affinity())
+		}
+		if podSpec.Affinity.PodAntiAffinity == nil {
+			podSpec.Affinity.WithPodAntiAffinity(corev1apply.PodAntiAffinity())
+		}
+		podSpec.Affinity.PodAntiAffinity.WithRequiredDuringSchedulingIgnoredDuringExecution(
+			corev1apply.PodAffinityTerm().
+				WithLabelSelector(metav1apply.LabelSelector().
+					WithMatchLabels(map[string]string{"job-name": name})).
+				WithTopologyKey(corev1.LabelHostname))
+	}
 
 	jobConf := batchv1apply.
 		Job(name, ibjob.Job.KubernetesNamespace).
 		WithLabels(labels).
+		WithLabels(workload.Labels(workload.KindBatchJob, ibjob.Job.Id)).
 		WithAnnotations(map[string]string{
 			batchJobManagedAnnotationKey: "true",
 			batchJobIDAnnotationKey:      ibjob.Job.Id}).
@@ -389,7 +499,6 @@ func (m *BatchJobManager) createBatchJob(ctx context.Context, ibjob *v1.Internal
 		WithController(true)
 
 	// Secret and ConfigMap are pre-created by server, and dispatcher only set the owner reference here.
-	// TODO(aya): garbage collect orphaned secrets
 	objs := []any{
 		corev1apply.Secret(name, ibjob.Job.KubernetesNamespace).
 			WithLabels(labels).
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -26,16 +27,36 @@ func TestReconcileBatchJob(t *testing.T) {
 
 		state       v1.InternalBatchJob_State
 		mutateJobFn func(job *batchv1.Job)
+		pod         *corev1.Pod
 
-		wantUpdate      bool
-		wantRequeue     bool
-		wantState       v1.InternalBatchJob_State
-		wantAssertJobFn func(t *testing.T, gotJob *batchv1.Job, err error)
+		wantUpdate       bool
+		wantRequeue      bool
+		wantState        v1.InternalBatchJob_State
+		wantError        *v1.BatchJob_Error
+		wantStatusReason string
+		wantAssertJobFn  func(t *testing.T, gotJob *batchv1.Job, err error)
 	}{
 		{
 			name:  "still running",
 			state: v1.InternalBatchJob_RUNNING,
 		},
+		{
+			name:             "image pull failure",
+			state:            v1.InternalBatchJob_RUNNING,
+			pod:              imagePullBackOffPod("test-job", time.Now().Add(-time.Minute)),
+			wantStatusReason: "ImagePullBackOff: Back-off pulling image",
+		},
+		{
+			name:             "image pull failure (grace period passed)",
+			state:            v1.InternalBatchJob_RUNNING,
+			pod:              imagePullBackOffPod("test-job", time.Now().Add(-time.Hour)),
+			wantState:        v1.InternalBatchJob_FAILED,
+			wantStatusReason: "ImagePullBackOff: Back-off pulling image",
+			wantAssertJobFn: func(t *testing.T, gotJob *batchv1.Job, err error) {
+				assert.NoError(t, err)
+				assert.True(t, *gotJob.Spec.Suspend)
+			},
+		},
 		{
 			name:  "job failed",
 			state: v1.InternalBatchJob_RUNNING,
@@ -44,6 +65,20 @@ func TestReconcileBatchJob(t *testing.T) {
 			},
 			wantState: v1.InternalBatchJob_FAILED,
 		},
+		{
+			name:  "job failed with a user error",
+			state: v1.InternalBatchJob_RUNNING,
+			mutateJobFn: func(job *batchv1.Job) {
+				job.Status.Failed = 1
+			},
+			pod:       terminatedJobPod("test-job", 3),
+			wantState: v1.InternalBatchJob_FAILED,
+			wantError: &v1.BatchJob_Error{
+				Code:     failureCodeUserError,
+				Message:  "container main exited with code 3",
+				ExitCode: 3,
+			},
+		},
 		{
 			name:  "successfully completed",
 			state: v1.InternalBatchJob_RUNNING,
@@ -109,7 +144,11 @@ func TestReconcileBatchJob(t *testing.T) {
 			if test.mutateJobFn != nil {
 				test.mutateJobFn(job)
 			}
-			k8sClient := fake.NewFakeClient(job)
+			objs := []client.Object{job}
+			if test.pod != nil {
+				objs = append(objs, test.pod)
+			}
+			k8sClient := fake.NewClientBuilder().WithObjects(objs...).Build()
 			req := reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Name:      job.Name,
@@ -124,8 +163,9 @@ func TestReconcileBatchJob(t *testing.T) {
 			}
 
 			mgr := NewBatchJobManager(BatchJobManagerOptions{
-				K8sClient: k8sClient,
-				BwClient:  bwClient,
+				K8sClient:        k8sClient,
+				BwClient:         bwClient,
+				PodFailureConfig: testPodFailureConfig,
 			})
 
 			ctx := ctxWithTestLogger(t)
@@ -135,6 +175,10 @@ func TestReconcileBatchJob(t *testing.T) {
 
 			gotState := bwClient.updatedState[job.Name]
 			assert.Equalf(t, test.wantState, gotState, "state: got=%s, want=%s", gotState, test.wantState)
+			if test.wantError != nil {
+				assert.Equal(t, test.wantError, bwClient.errors[job.Name])
+			}
+			assert.Equal(t, test.wantStatusReason, bwClient.statusReasons[job.Name])
 
 			var gotJob batchv1.Job
 			err = k8sClient.Get(ctx, req.NamespacedName, &gotJob)
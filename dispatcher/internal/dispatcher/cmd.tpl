@@ -33,5 +33,9 @@ python ./convert-lora-to-ggml.py ./output
 # We don't need the checkpoint files.
 rm -rf output/checkpoint-*
 
-# Upload all files under the "output" directory.
-find output -type f -exec curl {{ .CurlFlags }} --request POST {{ .OutputModelPresignFlags }} -F file=@{} "{{ .OutputModelURL }}" \;
+# Upload all files under the "output" directory. An upload failure exits with a dedicated code
+# so that the failure is classified.
+# The loop runs in a subshell of the pipeline, and pipefail propagates its exit code.
+find output -type f -print0 | while IFS= read -r -d '' f; do
+  curl {{ .CurlFlags }} --request POST {{ .OutputModelPresignFlags }} -F "file=@${f}" "{{ .OutputModelURL }}" || exit {{ .UploadFailedExitCode }}
+done
@@ -0,0 +1,303 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	minStreamBackoff = time.Second
+	maxStreamBackoff = 30 * time.Second
+
+	ackBufferSize = 100
+)
+
+type dispatchStreamClient interface {
+	StreamQueuedActions(ctx context.Context, opts ...grpc.CallOption) (v1.JobWorkerService_StreamQueuedActionsClient, error)
+}
+
+type jobWorkerServiceClient interface {
+	dispatchStreamClient
+	lastDispatchErrorUpdater
+}
+
+// pushedActions holds the actions pushed by the server that have not been processed yet.
+type pushedActions struct {
+	mu      sync.Mutex
+	actions []*v1.DispatchAction
+	// notify is signaled when an action is added.
+	notify chan struct{}
+}
+
+func newPushedActions() *pushedActions {
+	return &pushedActions{
+		notify: make(chan struct{}, 1),
+	}
+}
+
+func (p *pushedActions) add(a *v1.DispatchAction) {
+	p.mu.Lock()
+	p.actions = append(p.actions, a)
+	p.mu.Unlock()
+
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (p *pushedActions) take() []*v1.DispatchAction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	actions := p.actions
+	p.actions = nil
+	return actions
+}
+
+// dispatchCursor is the cursor of a workload type to resume the stream after. It advances only over the actions
+// that have been processed successfully so that the server pushes the unsettled actions again after a reconnect.
+type dispatchCursor struct {
+	mu sync.Mutex
+	// processed is the greatest cursor of the successfully processed actions.
+	processed int64
+	// unsettled is the pushed actions that have not been processed successfully keyed by their workload IDs.
+	unsettled map[string]*unsettledAction
+}
+
+// unsettledAction is a pushed action that has not been processed successfully.
+type unsettledAction struct {
+	// action is the last pushed action of the workload.
+	action *v1.DispatchAction
+	// cursor is the smallest cursor of the pushed actions of the workload.
+	cursor int64
+	// listed is true if the queued actions have been listed since the action was pushed. The result of
+	// processing the action is known only after the listing.
+	listed bool
+	// reportedError is the error reported to the server for the action.
+	reportedError string
+}
+
+func newDispatchCursor() *dispatchCursor {
+	return &dispatchCursor{
+		unsettled: map[string]*unsettledAction{},
+	}
+}
+
+// hold holds the cursor before the pushed actions until they are processed successfully. listed is true if
+// the queued actions have been listed after the actions were pushed.
+func (c *dispatchCursor) hold(actions []*v1.DispatchAction, listed bool) []*unsettledAction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if listed {
+		for _, u := range c.unsettled {
+			u.listed = true
+		}
+	}
+	var held []*unsettledAction
+	for _, a := range actions {
+		u, ok := c.unsettled[a.Id]
+		if !ok {
+			u = &unsettledAction{cursor: a.Cursor}
+			c.unsettled[a.Id] = u
+		}
+		u.action = a
+		u.cursor = min(u.cursor, a.Cursor)
+		u.listed = listed
+		held = append(held, u)
+	}
+	return held
+}
+
+// settle settles the listed actions whose processing has completed, and returns the acks of the actions whose
+// results have not been reported. result returns whether the processing of a workload has completed and its error.
+func (c *dispatchCursor) settle(result func(id string) (bool, string)) []*v1.StreamQueuedActionsRequest_Ack {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var acks []*v1.StreamQueuedActionsRequest_Ack
+	for id, u := range c.unsettled {
+		if !u.listed {
+			continue
+		}
+		done, errMsg := result(id)
+		if !done {
+			continue
+		}
+		if errMsg == "" {
+			delete(c.unsettled, id)
+			c.processed = max(c.processed, u.action.Cursor)
+		} else if errMsg == u.reportedError {
+			continue
+		}
+		u.reportedError = errMsg
+		acks = append(acks, newAck(u.action, errMsg))
+	}
+	return acks
+}
+
+// load returns the cursor. It precedes all the unsettled actions.
+func (c *dispatchCursor) load() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cursor := c.processed
+	for _, u := range c.unsettled {
+		cursor = min(cursor, u.cursor-1)
+	}
+	return cursor
+}
+
+func newAck(a *v1.DispatchAction, errMsg string) *v1.StreamQueuedActionsRequest_Ack {
+	return &v1.StreamQueuedActionsRequest_Ack{
+		Id:           a.Id,
+		Cursor:       a.Cursor,
+		Error:        errMsg,
+		WorkloadType: a.WorkloadType,
+	}
+}
+
+// runDispatchStream receives the queued actions pushed by the server. It reconnects with backoff when
+// the stream is disconnected, and resumes the stream after the cursors of the workload types.
+func (d *D) runDispatchStream(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx).WithName("stream")
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	backoff := minStreamBackoff
+	for {
+		start := time.Now()
+		err := d.streamQueuedActions(ctx)
+		d.streamConnected.Store(false)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Info("The server does not support the dispatch stream. Falling back to polling")
+			return nil
+		}
+
+		if time.Since(start) > maxStreamBackoff {
+			backoff = minStreamBackoff
+		}
+		log.Error(err, "Dispatch stream disconnected", "retryAfter", backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxStreamBackoff)
+	}
+}
+
+func (d *D) streamQueuedActions(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := d.streamClient.StreamQueuedActions(auth.AppendWorkerAuthorization(ctx))
+	if err != nil {
+		return fmt.Errorf("open stream: %s", err)
+	}
+	var cursors []*v1.DispatchCursor
+	for _, wtype := range []v1.DispatchAction_WorkloadType{
+		v1.DispatchAction_JOB,
+		v1.DispatchAction_NOTEBOOK,
+		v1.DispatchAction_BATCH_JOB,
+	} {
+		cursors = append(cursors, &v1.DispatchCursor{
+			WorkloadType: wtype,
+			Cursor:       d.cursors[wtype].load(),
+		})
+	}
+	if err := stream.Send(&v1.StreamQueuedActionsRequest{
+		Message: &v1.StreamQueuedActionsRequest_Subscribe_{
+			Subscribe: &v1.StreamQueuedActionsRequest_Subscribe{
+				Cursors: cursors,
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("subscribe: %s", err)
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Subscribed to the dispatch stream", "cursors", cursors)
+	d.streamConnected.Store(true)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ack := <-d.acks:
+				if err := stream.Send(&v1.StreamQueuedActionsRequest{
+					Message: &v1.StreamQueuedActionsRequest_Ack_{Ack: ack},
+				}); err != nil {
+					// Recv returns the error of the stream.
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		a := resp.Action
+		p, ok := d.pushed[a.GetWorkloadType()]
+		if !ok {
+			log.Info("Ignoring an action of an unknown workload type", "type", a.GetWorkloadType().String(), "id", a.GetId())
+			continue
+		}
+		log.V(1).Info("Received an action", "type", a.WorkloadType.String(), "id", a.Id, "action", a.Action)
+		p.add(a)
+	}
+}
+
+// ackActions holds the cursor of the workload type before the pushed actions until they are processed successfully,
+// and reports the results of the settled actions to the server. err is the error of listing the queued actions
+// after the actions were pushed.
+func (d *D) ackActions(wtype v1.DispatchAction_WorkloadType, actions []*v1.DispatchAction, err error) {
+	cursor := d.cursors[wtype]
+	if err == nil {
+		cursor.hold(actions, true)
+		d.settleActions(wtype)
+		return
+	}
+
+	var acks []*v1.StreamQueuedActionsRequest_Ack
+	for _, u := range cursor.hold(actions, false) {
+		u.reportedError = err.Error()
+		acks = append(acks, newAck(u.action, u.reportedError))
+	}
+	d.sendAcks(acks)
+}
+
+// settleActions reports the results of the pushed actions whose processing has completed to the server.
+func (d *D) settleActions(wtype v1.DispatchAction_WorkloadType) {
+	d.sendAcks(d.cursors[wtype].settle(d.processors[wtype].result))
+}
+
+func (d *D) sendAcks(acks []*v1.StreamQueuedActionsRequest_Ack) {
+	for _, ack := range acks {
+		select {
+		case d.acks <- ack:
+		default:
+			// Drop the ack as the stream is disconnected. Acks are used only for reporting.
+		}
+	}
+}
+
+// currentPollingInterval returns the interval to poll queued actions.
+func (d *D) currentPollingInterval() time.Duration {
+	if d.streamConnected.Load() {
+		return d.fallbackPollingInterval
+	}
+	return d.pollingInterval
+}
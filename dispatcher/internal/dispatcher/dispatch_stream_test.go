@@ -0,0 +1,180 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestStreamQueuedActions(t *testing.T) {
+	stream := newFakeDispatchStream()
+	d := newTestDispatcher()
+	d.streamClient = &fakeDispatchStreamClient{stream: stream}
+	assert.Equal(t, time.Second, d.currentPollingInterval())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- d.streamQueuedActions(ctx)
+	}()
+
+	req := <-stream.reqs
+	assert.Equal(t, []*v1.DispatchCursor{
+		{WorkloadType: v1.DispatchAction_JOB},
+		{WorkloadType: v1.DispatchAction_NOTEBOOK},
+		{WorkloadType: v1.DispatchAction_BATCH_JOB},
+	}, req.GetSubscribe().GetCursors())
+
+	actions := []*v1.DispatchAction{
+		{WorkloadType: v1.DispatchAction_JOB, Id: "job0", Cursor: 10},
+		{WorkloadType: v1.DispatchAction_WORKLOAD_TYPE_UNSPECIFIED, Id: "unknown", Cursor: 11},
+		{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "nb0", Cursor: 12},
+	}
+	for _, a := range actions {
+		stream.resps <- &v1.StreamQueuedActionsResponse{Action: a}
+	}
+
+	<-d.pushed[v1.DispatchAction_NOTEBOOK].notify
+	assert.Equal(t, time.Minute, d.currentPollingInterval())
+	nbActions := d.pushed[v1.DispatchAction_NOTEBOOK].take()
+	assert.Len(t, nbActions, 1)
+	assert.Equal(t, "nb0", nbActions[0].Id)
+	jobActions := d.pushed[v1.DispatchAction_JOB].take()
+	assert.Len(t, jobActions, 1)
+	assert.Equal(t, "job0", jobActions[0].Id)
+	assert.Empty(t, d.pushed[v1.DispatchAction_BATCH_JOB].take())
+
+	d.ackActions(v1.DispatchAction_NOTEBOOK, nbActions, errors.New("failed"))
+	req = <-stream.reqs
+	assert.Equal(t, "nb0", req.GetAck().GetId())
+	assert.Equal(t, v1.DispatchAction_NOTEBOOK, req.GetAck().GetWorkloadType())
+	assert.Equal(t, "failed", req.GetAck().GetError())
+	d.ackActions(v1.DispatchAction_JOB, jobActions, nil)
+	req = <-stream.reqs
+	assert.Equal(t, "job0", req.GetAck().GetId())
+	assert.Empty(t, req.GetAck().GetError())
+	// The cursor advances only over the succeeded actions of the workload type.
+	assert.Equal(t, int64(10), d.cursors[v1.DispatchAction_JOB].load())
+	assert.Equal(t, int64(0), d.cursors[v1.DispatchAction_NOTEBOOK].load())
+
+	// The processing of nb0 keeps failing while the next action succeeds.
+	p := d.processors[v1.DispatchAction_NOTEBOOK]
+	p.attempts[itemKey{id: "nb0", action: "starting"}] = &itemAttempts{count: 1, lastError: "failed"}
+	d.ackActions(v1.DispatchAction_NOTEBOOK, []*v1.DispatchAction{
+		{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "nb1", Cursor: 14},
+	}, nil)
+	<-stream.reqs
+	assert.Equal(t, int64(11), d.cursors[v1.DispatchAction_NOTEBOOK].load())
+
+	wantErr := errors.New("disconnected")
+	stream.recvErr <- wantErr
+	assert.ErrorIs(t, <-errCh, wantErr)
+
+	// The stream is resumed after the cursors so that the failed action is pushed again.
+	go func() {
+		errCh <- d.streamQueuedActions(ctx)
+	}()
+	req = <-stream.reqs
+	assert.Equal(t, []*v1.DispatchCursor{
+		{WorkloadType: v1.DispatchAction_JOB, Cursor: 10},
+		{WorkloadType: v1.DispatchAction_NOTEBOOK, Cursor: 11},
+		{WorkloadType: v1.DispatchAction_BATCH_JOB},
+	}, req.GetSubscribe().GetCursors())
+	cancel()
+	<-errCh
+
+	// The cursor advances once the failed action succeeds in a later attempt.
+	delete(p.attempts, itemKey{id: "nb0", action: "starting"})
+	d.ackActions(v1.DispatchAction_NOTEBOOK, nil, nil)
+	assert.Equal(t, int64(14), d.cursors[v1.DispatchAction_NOTEBOOK].load())
+}
+
+func TestDispatchCursor(t *testing.T) {
+	type itemResult struct {
+		done   bool
+		errMsg string
+	}
+	c := newDispatchCursor()
+	results := map[string]itemResult{}
+	result := func(id string) (bool, string) {
+		r, ok := results[id]
+		if !ok {
+			return true, ""
+		}
+		return r.done, r.errMsg
+	}
+
+	// The actions are not settled until the queued actions are listed.
+	c.hold([]*v1.DispatchAction{{Id: "job0", Cursor: 3}}, false)
+	assert.Empty(t, c.settle(result))
+	assert.Equal(t, int64(0), c.load())
+
+	// An action in flight holds the cursor.
+	results["job1"] = itemResult{done: false}
+	c.hold([]*v1.DispatchAction{{Id: "job1", Cursor: 5}, {Id: "job2", Cursor: 7}}, true)
+	acks := c.settle(result)
+	assert.Len(t, acks, 2)
+	assert.Equal(t, int64(4), c.load())
+
+	// A failure is reported once.
+	results["job1"] = itemResult{done: true, errMsg: "failed"}
+	acks = c.settle(result)
+	assert.Len(t, acks, 1)
+	assert.Equal(t, "failed", acks[0].Error)
+	assert.Empty(t, c.settle(result))
+	assert.Equal(t, int64(4), c.load())
+
+	delete(results, "job1")
+	acks = c.settle(result)
+	assert.Len(t, acks, 1)
+	assert.Empty(t, acks[0].Error)
+	assert.Equal(t, int64(7), c.load())
+}
+
+type fakeDispatchStreamClient struct {
+	stream *fakeDispatchStream
+}
+
+func (c *fakeDispatchStreamClient) StreamQueuedActions(ctx context.Context, opts ...grpc.CallOption) (v1.JobWorkerService_StreamQueuedActionsClient, error) {
+	c.stream.ctx = ctx
+	return c.stream, nil
+}
+
+type fakeDispatchStream struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	reqs    chan *v1.StreamQueuedActionsRequest
+	resps   chan *v1.StreamQueuedActionsResponse
+	recvErr chan error
+}
+
+func newFakeDispatchStream() *fakeDispatchStream {
+	return &fakeDispatchStream{
+		reqs:    make(chan *v1.StreamQueuedActionsRequest, 10),
+		resps:   make(chan *v1.StreamQueuedActionsResponse, 10),
+		recvErr: make(chan error, 1),
+	}
+}
+
+func (s *fakeDispatchStream) Send(req *v1.StreamQueuedActionsRequest) error {
+	s.reqs <- req
+	return nil
+}
+
+func (s *fakeDispatchStream) Recv() (*v1.StreamQueuedActionsResponse, error) {
+	select {
+	case resp := <-s.resps:
+		return resp, nil
+	case err := <-s.recvErr:
+		return nil, err
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
-	"github.com/avast/retry-go"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"golang.org/x/sync/errgroup"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,6 +17,7 @@ import (
 type jobManagerI interface {
 	createJob(ctx context.Context, job *v1.InternalJob, presult *PreProcessResult) error
 	cancelJob(ctx context.Context, job *v1.InternalJob) error
+	deleteJob(ctx context.Context, job *v1.InternalJob) error
 }
 
 type notebookManagerI interface {
@@ -49,12 +51,19 @@ func New(
 	ftClient v1.FineTuningWorkerServiceClient,
 	wsClient v1.WorkspaceWorkerServiceClient,
 	bwClient v1.BatchWorkerServiceClient,
+	jwClient jobWorkerServiceClient,
 	jobManager jobManagerI,
 	preProcessor PreProcessorI,
 	nbManager notebookManagerI,
 	bjManager batchJobManagerI,
 	pollingInterval time.Duration,
+	streamConfig config.DispatchStreamConfig,
+	queuedActionConfig config.QueuedActionConfig,
 ) *D {
+	var streamClient dispatchStreamClient
+	if streamConfig.Enable {
+		streamClient = jwClient
+	}
 	return &D{
 		ftClient:        ftClient,
 		wsClient:        wsClient,
@@ -64,6 +73,26 @@ func New(
 		nbManager:       nbManager,
 		bjManager:       bjManager,
 		pollingInterval: pollingInterval,
+
+		streamClient:            streamClient,
+		fallbackPollingInterval: streamConfig.FallbackPollingInterval,
+		pushed: map[v1.DispatchAction_WorkloadType]*pushedActions{
+			v1.DispatchAction_JOB:       newPushedActions(),
+			v1.DispatchAction_NOTEBOOK:  newPushedActions(),
+			v1.DispatchAction_BATCH_JOB: newPushedActions(),
+		},
+		cursors: map[v1.DispatchAction_WorkloadType]*dispatchCursor{
+			v1.DispatchAction_JOB:       newDispatchCursor(),
+			v1.DispatchAction_NOTEBOOK:  newDispatchCursor(),
+			v1.DispatchAction_BATCH_JOB: newDispatchCursor(),
+		},
+		acks: make(chan *v1.StreamQueuedActionsRequest_Ack, ackBufferSize),
+
+		processors: map[v1.DispatchAction_WorkloadType]*itemProcessor{
+			v1.DispatchAction_JOB:       newItemProcessor(v1.DispatchAction_JOB, jwClient, queuedActionConfig),
+			v1.DispatchAction_NOTEBOOK:  newItemProcessor(v1.DispatchAction_NOTEBOOK, jwClient, queuedActionConfig),
+			v1.DispatchAction_BATCH_JOB: newItemProcessor(v1.DispatchAction_BATCH_JOB, jwClient, queuedActionConfig),
+		},
 	}
 }
 
@@ -79,6 +108,18 @@ type D struct {
 	bjManager    batchJobManagerI
 
 	pollingInterval time.Duration
+
+	// streamClient receives the queued actions pushed by the server. Polling is the only way to find
+	// queued actions if nil.
+	streamClient dispatchStreamClient
+	// fallbackPollingInterval is the interval to poll queued actions while the stream is connected.
+	fallbackPollingInterval time.Duration
+	streamConnected         atomic.Bool
+	pushed                  map[v1.DispatchAction_WorkloadType]*pushedActions
+	cursors                 map[v1.DispatchAction_WorkloadType]*dispatchCursor
+	acks                    chan *v1.StreamQueuedActionsRequest_Ack
+
+	processors map[v1.DispatchAction_WorkloadType]*itemProcessor
 }
 
 // SetupWithManager registers the dispatcher with the manager.
@@ -88,35 +129,48 @@ func (d *D) SetupWithManager(mgr ctrl.Manager) error {
 
 // Start starts the dispatcher.
 func (d *D) Start(ctx context.Context) error {
-	worker := func(initialDelay time.Duration, fn func(context.Context) error) func() error {
+	worker := func(initialDelay time.Duration, wtype v1.DispatchAction_WorkloadType, fn func(context.Context) error) func() error {
 		return func() error {
-			time.Sleep(initialDelay)
-			if err := fn(ctx); err != nil {
-				return err
-			}
-			ticker := time.NewTicker(d.pollingInterval)
+			log := ctrl.LoggerFrom(ctx).WithValues("workloadType", wtype.String())
+			pushed := d.pushed[wtype]
+			p := d.processors[wtype]
+			timer := time.NewTimer(initialDelay)
+			defer timer.Stop()
 			for {
 				select {
 				case <-ctx.Done():
 					return ctx.Err()
-				case <-ticker.C:
-					if err := retry.Do(func() error { return fn(ctx) },
-						retry.Context(ctx),
-						retry.Attempts(5),
-						retry.Delay(30*time.Second),
-					); err != nil {
-						return err
-					}
+				case <-p.completed:
+					d.settleActions(wtype)
+					continue
+				case <-timer.C:
+				case <-pushed.notify:
 				}
+				// Process all queued actions including the pushed ones.
+				actions := pushed.take()
+				err := fn(ctx)
+				if err != nil {
+					// Retry at the next polling.
+					log.Error(err, "Failed to list queued actions")
+				}
+				d.ackActions(wtype, actions, err)
+				timer.Reset(d.currentPollingInterval())
 			}
 		}
 	}
 
 	maxDelay := time.Second
 	g, ctx := errgroup.WithContext(ctx)
-	g.Go(worker(time.Duration(rand.Intn(int(maxDelay))), d.processQueuedJobs))
-	g.Go(worker(time.Duration(rand.Intn(int(maxDelay))), d.processNotebooks))
-	g.Go(worker(time.Duration(rand.Intn(int(maxDelay))), d.processBatchJobs))
+	for _, p := range d.processors {
+		// The items are processed with the workers of the processors.
+		g.Go(func() error { return p.run(auth.AppendWorkerAuthorization(ctx)) })
+	}
+	g.Go(worker(time.Duration(rand.Intn(int(maxDelay))), v1.DispatchAction_JOB, d.processQueuedJobs))
+	g.Go(worker(time.Duration(rand.Intn(int(maxDelay))), v1.DispatchAction_NOTEBOOK, d.processNotebooks))
+	g.Go(worker(time.Duration(rand.Intn(int(maxDelay))), v1.DispatchAction_BATCH_JOB, d.processBatchJobs))
+	if d.streamClient != nil {
+		g.Go(func() error { return d.runDispatchStream(ctx) })
+	}
 
 	log := ctrl.LoggerFrom(ctx)
 	if err := g.Wait(); err != nil {
@@ -134,31 +188,55 @@ func (d *D) processQueuedJobs(ctx context.Context) error {
 		return err
 	}
 
+	items := make([]queuedItem, 0, len(resp.Jobs))
 	for _, job := range resp.Jobs {
-		log := ctrl.LoggerFrom(ctx).WithValues("jobID", job.Job.Id)
-		ctx = ctrl.LoggerInto(ctx, log)
+		items = append(items, queuedItem{
+			id:     job.Job.Id,
+			action: job.QueuedAction.String(),
+			process: func(ctx context.Context) error {
+				return d.processQueuedJob(ctx, job)
+			},
+		})
+	}
+	d.processors[v1.DispatchAction_JOB].enqueue(items)
+	return nil
+}
 
-		switch job.QueuedAction {
-		case v1.InternalJob_CREATING:
-			if err := d.createJob(ctx, job); err != nil {
-				return err
-			}
-		case v1.InternalJob_CANCELING:
-			log.Info("Canceling the job")
-			if err := d.jobManager.cancelJob(ctx, job); err != nil {
-				return fmt.Errorf("failed to cancel the job: %s", err)
-			}
-			if _, err := d.ftClient.UpdateJobPhase(ctx, &v1.UpdateJobPhaseRequest{
-				Id:    job.Job.Id,
-				Phase: v1.UpdateJobPhaseRequest_CANCELED,
-			}); err != nil {
-				return fmt.Errorf("failed to update the job phase: %s", err)
-			}
-		default:
-			return fmt.Errorf("unknown queued action: %s", job.QueuedAction)
+func (d *D) processQueuedJob(ctx context.Context, job *v1.InternalJob) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("jobID", job.Job.Id)
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	switch job.QueuedAction {
+	case v1.InternalJob_CREATING:
+		if err := d.createJob(ctx, job); err != nil {
+			return err
+		}
+	case v1.InternalJob_CANCELING:
+		log.Info("Canceling the job")
+		if err := d.jobManager.cancelJob(ctx, job); err != nil {
+			return fmt.Errorf("failed to cancel the job: %s", err)
+		}
+		if _, err := d.ftClient.UpdateJobPhase(ctx, &v1.UpdateJobPhaseRequest{
+			Id:    job.Job.Id,
+			Phase: v1.UpdateJobPhaseRequest_CANCELED,
+		}); err != nil {
+			return fmt.Errorf("failed to update the job phase: %s", err)
+		}
+	case v1.InternalJob_REQUEUEING:
+		log.Info("Deleting the job for requeueing")
+		if err := d.jobManager.deleteJob(ctx, job); err != nil {
+			return fmt.Errorf("failed to delete the job: %s", err)
 		}
-		log.Info("Successfully completed the action", "action", job.QueuedAction.String())
+		if _, err := d.ftClient.UpdateJobPhase(ctx, &v1.UpdateJobPhaseRequest{
+			Id:    job.Job.Id,
+			Phase: v1.UpdateJobPhaseRequest_REQUEUED,
+		}); err != nil {
+			return fmt.Errorf("failed to update the job phase: %s", err)
+		}
+	default:
+		return fmt.Errorf("unknown queued action: %s", job.QueuedAction)
 	}
+	log.Info("Successfully completed the action", "action", job.QueuedAction.String())
 	return nil
 }
 
@@ -170,9 +248,10 @@ func (d *D) createJob(ctx context.Context, job *v1.InternalJob) error {
 		// Report the error to the server as it can be caused by a user misconfiguration (e.g.,
 		// a training file points to an S3 object that doesn't exist).
 		if _, uerr := d.ftClient.UpdateJobPhase(ctx, &v1.UpdateJobPhaseRequest{
-			Id:      job.Job.Id,
-			Phase:   v1.UpdateJobPhaseRequest_FAILED,
-			Message: fmt.Sprintf("Pre-processing failed: %s", err),
+			Id:        job.Job.Id,
+			Phase:     v1.UpdateJobPhaseRequest_FAILED,
+			Message:   fmt.Sprintf("Pre-processing failed: %s", err),
+			ErrorCode: failureCodePreprocessingFailed,
 		}); uerr != nil {
 			return fmt.Errorf("pre-processing failed (also failed to update job phase: %s): %s", uerr, err)
 		}
@@ -205,47 +284,60 @@ func (d *D) processNotebooks(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	items := make([]queuedItem, 0, len(resp.Notebooks))
 	for _, nb := range resp.Notebooks {
-		log := ctrl.LoggerFrom(ctx).WithValues("notebookID", nb.Notebook.Id)
-		ctx = ctrl.LoggerInto(ctx, log)
-
-		var (
-			state v1.NotebookState
-			err   error
-		)
-		switch nb.QueuedAction {
-		case v1.NotebookQueuedAction_STARTING:
-			log.Info("Creating a k8s notebook resources")
-			err = d.nbManager.createNotebook(ctx, nb)
-			state = v1.NotebookState_INITIALIZING
-		case v1.NotebookQueuedAction_STOPPING:
-			log.Info("Stopping a k8s notebook resources")
-			err = d.nbManager.stopNotebook(ctx, nb)
-			state = v1.NotebookState_STOPPED
-		case v1.NotebookQueuedAction_DELETING:
-			log.Info("Deleting a k8s notebook resources")
-			err = d.nbManager.deleteNotebook(ctx, nb)
-			state = v1.NotebookState_DELETED
-		case v1.NotebookQueuedAction_REQUEUEING:
-			log.Info("Deleting a k8s notebook resources for requeueing")
-			err = d.nbManager.deleteNotebook(ctx, nb)
-			state = v1.NotebookState_REQUEUED
-		case v1.NotebookQueuedAction_ACTION_UNSPECIFIED:
-			return fmt.Errorf("notebook queued action is not specified")
-		default:
-			return fmt.Errorf("unknown notebook queued action: %s", nb.QueuedAction)
-		}
-		if err != nil {
-			return fmt.Errorf("failed to %s the notebook: %s", nb.QueuedAction.String(), err)
-		}
-		log.Info("Successfully completed the action", "action", nb.QueuedAction.String())
+		items = append(items, queuedItem{
+			id:     nb.Notebook.Id,
+			action: nb.QueuedAction.String(),
+			process: func(ctx context.Context) error {
+				return d.processNotebook(ctx, nb)
+			},
+		})
+	}
+	d.processors[v1.DispatchAction_NOTEBOOK].enqueue(items)
+	return nil
+}
 
-		if _, err := d.wsClient.UpdateNotebookState(ctx, &v1.UpdateNotebookStateRequest{
-			Id:    nb.Notebook.Id,
-			State: state,
-		}); err != nil {
-			return err
-		}
+func (d *D) processNotebook(ctx context.Context, nb *v1.InternalNotebook) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("notebookID", nb.Notebook.Id)
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	var (
+		state v1.NotebookState
+		err   error
+	)
+	switch nb.QueuedAction {
+	case v1.NotebookQueuedAction_STARTING:
+		log.Info("Creating a k8s notebook resources")
+		err = d.nbManager.createNotebook(ctx, nb)
+		state = v1.NotebookState_INITIALIZING
+	case v1.NotebookQueuedAction_STOPPING:
+		log.Info("Stopping a k8s notebook resources")
+		err = d.nbManager.stopNotebook(ctx, nb)
+		state = v1.NotebookState_STOPPED
+	case v1.NotebookQueuedAction_DELETING:
+		log.Info("Deleting a k8s notebook resources")
+		err = d.nbManager.deleteNotebook(ctx, nb)
+		state = v1.NotebookState_DELETED
+	case v1.NotebookQueuedAction_REQUEUEING:
+		log.Info("Deleting a k8s notebook resources for requeueing")
+		err = d.nbManager.deleteNotebook(ctx, nb)
+		state = v1.NotebookState_REQUEUED
+	case v1.NotebookQueuedAction_ACTION_UNSPECIFIED:
+		return fmt.Errorf("notebook queued action is not specified")
+	default:
+		return fmt.Errorf("unknown notebook queued action: %s", nb.QueuedAction)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s the notebook: %s", nb.QueuedAction.String(), err)
+	}
+	log.Info("Successfully completed the action", "action", nb.QueuedAction.String())
+
+	if _, err := d.wsClient.UpdateNotebookState(ctx, &v1.UpdateNotebookStateRequest{
+		Id:    nb.Notebook.Id,
+		State: state,
+	}); err != nil {
+		return err
 	}
 	return nil
 }
@@ -256,43 +348,60 @@ func (d *D) processBatchJobs(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	items := make([]queuedItem, 0, len(resp.Jobs))
 	for _, job := range resp.Jobs {
-		log := ctrl.LoggerFrom(ctx).WithValues("batchJobID", job.Job.Id)
-		ctx = ctrl.LoggerInto(ctx, log)
-
-		var (
-			state v1.InternalBatchJob_State
-			err   error
-		)
-		switch job.QueuedAction {
-		case v1.InternalBatchJob_CREATING:
-			log.Info("Creating a new batch job")
-			err = d.bjManager.createBatchJob(ctx, job)
-			state = v1.InternalBatchJob_RUNNING
-		case v1.InternalBatchJob_CANCELING:
-			log.Info("Canceling a batch job")
-			err = d.bjManager.cancelBatchJob(ctx, job)
-			state = v1.InternalBatchJob_CANCELED
-		case v1.InternalBatchJob_DELETING:
-			log.Info("Deleting a batch job")
-			err = d.bjManager.deleteBatchJob(ctx, job)
-			state = v1.InternalBatchJob_DELETED
-		case v1.InternalBatchJob_ACTION_UNSPECIFIED:
-			return fmt.Errorf("batch job queued action is not specified")
-		default:
-			return fmt.Errorf("unknown batch job queued action: %s", job.QueuedAction)
-		}
-		if err != nil {
-			return fmt.Errorf("failed to %s the batch job: %s", job.QueuedAction, err)
-		}
-		log.Info("Successfully completed the action", "action", job.QueuedAction.String())
+		items = append(items, queuedItem{
+			id:     job.Job.Id,
+			action: job.QueuedAction.String(),
+			process: func(ctx context.Context) error {
+				return d.processBatchJob(ctx, job)
+			},
+		})
+	}
+	d.processors[v1.DispatchAction_BATCH_JOB].enqueue(items)
+	return nil
+}
 
-		if _, err := d.bwClient.UpdateBatchJobState(ctx, &v1.UpdateBatchJobStateRequest{
-			Id:    job.Job.Id,
-			State: state,
-		}); err != nil {
-			return err
-		}
+func (d *D) processBatchJob(ctx context.Context, job *v1.InternalBatchJob) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("batchJobID", job.Job.Id)
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	var (
+		state v1.InternalBatchJob_State
+		err   error
+	)
+	switch job.QueuedAction {
+	case v1.InternalBatchJob_CREATING:
+		log.Info("Creating a new batch job")
+		err = d.bjManager.createBatchJob(ctx, job)
+		state = v1.InternalBatchJob_RUNNING
+	case v1.InternalBatchJob_CANCELING:
+		log.Info("Canceling a batch job")
+		err = d.bjManager.cancelBatchJob(ctx, job)
+		state = v1.InternalBatchJob_CANCELED
+	case v1.InternalBatchJob_DELETING:
+		log.Info("Deleting a batch job")
+		err = d.bjManager.deleteBatchJob(ctx, job)
+		state = v1.InternalBatchJob_DELETED
+	case v1.InternalBatchJob_REQUEUEING:
+		log.Info("Deleting a batch job for requeueing")
+		err = d.bjManager.deleteBatchJob(ctx, job)
+		state = v1.InternalBatchJob_REQUEUED
+	case v1.InternalBatchJob_ACTION_UNSPECIFIED:
+		return fmt.Errorf("batch job queued action is not specified")
+	default:
+		return fmt.Errorf("unknown batch job queued action: %s", job.QueuedAction)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s the batch job: %s", job.QueuedAction, err)
+	}
+	log.Info("Successfully completed the action", "action", job.QueuedAction.String())
+
+	if _, err := d.bwClient.UpdateBatchJobState(ctx, &v1.UpdateBatchJobStateRequest{
+		Id:    job.Job.Id,
+		State: state,
+	}); err != nil {
+		return err
 	}
 	return nil
 }
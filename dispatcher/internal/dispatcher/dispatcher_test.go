@@ -2,10 +2,12 @@ package dispatcher
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -28,6 +30,13 @@ func TestProcessQueuedJobs(t *testing.T) {
 			State:        v1.InternalJob_QUEUED,
 			QueuedAction: v1.InternalJob_CANCELING,
 		},
+		{
+			Job: &v1.Job{
+				Id: "job2",
+			},
+			State:        v1.InternalJob_QUEUED,
+			QueuedAction: v1.InternalJob_REQUEUEING,
+		},
 	}
 
 	jc := &noopJobCreator{}
@@ -38,12 +47,15 @@ func TestProcessQueuedJobs(t *testing.T) {
 	d := newTestDispatcher()
 	d.ftClient = ft
 	d.jobManager = jc
+	runProcessors(t, d)
 	err := d.processQueuedJobs(context.Background())
 	assert.NoError(t, err)
+	waitProcessors(d)
 
 	wants := map[string]v1.UpdateJobPhaseRequest_Phase{
 		jobs[0].Job.Id: v1.UpdateJobPhaseRequest_JOB_CREATED,
 		jobs[1].Job.Id: v1.UpdateJobPhaseRequest_CANCELED,
+		jobs[2].Job.Id: v1.UpdateJobPhaseRequest_REQUEUED,
 	}
 	for jobID, want := range wants {
 		got, ok := ft.updatedPhases[jobID]
@@ -52,6 +64,7 @@ func TestProcessQueuedJobs(t *testing.T) {
 	}
 	assert.Equal(t, 1, jc.createCounter)
 	assert.Equal(t, 1, jc.cancelCounter)
+	assert.Equal(t, 1, jc.deleteCounter)
 }
 
 func TestProcessQueuedNotebooks(t *testing.T) {
@@ -82,8 +95,10 @@ func TestProcessQueuedNotebooks(t *testing.T) {
 	ws := &fakeWorkspaceWorkerServiceClient{notebooks: nbs, updatedState: map[string]v1.NotebookState{}}
 	d := newTestDispatcher()
 	d.wsClient = ws
+	runProcessors(t, d)
 	err := d.processNotebooks(context.Background())
 	assert.NoError(t, err)
+	waitProcessors(d)
 
 	wants := map[string]v1.NotebookState{
 		nbs[0].Notebook.Id: v1.NotebookState_INITIALIZING,
@@ -120,6 +135,13 @@ func TestProcessQueuedBatchJobs(t *testing.T) {
 			State:        v1.InternalBatchJob_QUEUED,
 			QueuedAction: v1.InternalBatchJob_DELETING,
 		},
+		{
+			Job: &v1.BatchJob{
+				Id: "job3",
+			},
+			State:        v1.InternalBatchJob_QUEUED,
+			QueuedAction: v1.InternalBatchJob_REQUEUEING,
+		},
 	}
 
 	ws := &fakeBatchWorkerServiceClient{
@@ -128,13 +150,16 @@ func TestProcessQueuedBatchJobs(t *testing.T) {
 	}
 	d := newTestDispatcher()
 	d.bwClient = ws
+	runProcessors(t, d)
 	err := d.processBatchJobs(context.Background())
 	assert.NoError(t, err)
+	waitProcessors(d)
 
 	wants := map[string]v1.InternalBatchJob_State{
 		jobs[0].Job.Id: v1.InternalBatchJob_RUNNING,
 		jobs[1].Job.Id: v1.InternalBatchJob_CANCELED,
 		jobs[2].Job.Id: v1.InternalBatchJob_DELETED,
+		jobs[3].Job.Id: v1.InternalBatchJob_REQUEUED,
 	}
 	for nbID, want := range wants {
 		got, ok := ws.updatedState[nbID]
@@ -148,16 +173,42 @@ func newTestDispatcher() *D {
 		&fakeFineTuningWorkerServiceClient{},
 		&fakeWorkspaceWorkerServiceClient{},
 		&fakeBatchWorkerServiceClient{},
+		&fakeJobWorkerServiceClient{},
 		&noopJobCreator{},
 		&NoopPreProcessor{},
 		&noopNotebookManager{},
 		&noopBatchJobManager{},
-		time.Second)
+		time.Second,
+		config.DispatchStreamConfig{
+			FallbackPollingInterval: time.Minute,
+		},
+		config.QueuedActionConfig{
+			Concurrency:    2,
+			InitialBackoff: time.Second,
+			MaxBackoff:     time.Minute,
+			MaxAttempts:    3,
+			Timeout:        time.Minute,
+		})
+}
+
+// runProcessors runs the item processors of the dispatcher until the test finishes.
+func runProcessors(t *testing.T, d *D) {
+	for _, p := range d.processors {
+		runItemProcessor(t, p)
+	}
+}
+
+// waitProcessors waits until the item processors of the dispatcher have no item in flight.
+func waitProcessors(d *D) {
+	for _, p := range d.processors {
+		waitIdle(p)
+	}
 }
 
 type noopJobCreator struct {
 	createCounter int
 	cancelCounter int
+	deleteCounter int
 }
 
 func (n *noopJobCreator) createJob(ctx context.Context, job *v1.InternalJob, presult *PreProcessResult) error {
@@ -170,6 +221,11 @@ func (n *noopJobCreator) cancelJob(ctx context.Context, job *v1.InternalJob) err
 	return nil
 }
 
+func (n *noopJobCreator) deleteJob(ctx context.Context, job *v1.InternalJob) error {
+	n.deleteCounter++
+	return nil
+}
+
 type noopNotebookManager struct {
 	createCounter int
 	stopCounter   int
@@ -209,6 +265,9 @@ func (n *noopBatchJobManager) deleteBatchJob(ctx context.Context, job *v1.Intern
 type fakeFineTuningWorkerServiceClient struct {
 	jobs          []*v1.InternalJob
 	updatedPhases map[string]v1.UpdateJobPhaseRequest_Phase
+	errorCodes    map[string]string
+	exitCodes     map[string]int32
+	statusReasons map[string]string
 }
 
 func (c *fakeFineTuningWorkerServiceClient) ListQueuedInternalJobs(ctx context.Context, in *v1.ListQueuedInternalJobsRequest, opts ...grpc.CallOption) (*v1.ListQueuedInternalJobsResponse, error) {
@@ -226,12 +285,33 @@ func (c *fakeFineTuningWorkerServiceClient) GetInternalJob(ctx context.Context,
 
 func (c *fakeFineTuningWorkerServiceClient) UpdateJobPhase(ctx context.Context, in *v1.UpdateJobPhaseRequest, opts ...grpc.CallOption) (*v1.UpdateJobPhaseResponse, error) {
 	c.updatedPhases[in.Id] = in.Phase
+	if in.ErrorCode != "" {
+		if c.errorCodes == nil {
+			c.errorCodes = map[string]string{}
+		}
+		c.errorCodes[in.Id] = in.ErrorCode
+	}
+	if in.ExitCode != 0 {
+		if c.exitCodes == nil {
+			c.exitCodes = map[string]int32{}
+		}
+		c.exitCodes[in.Id] = in.ExitCode
+	}
 	return &v1.UpdateJobPhaseResponse{}, nil
 }
 
+func (c *fakeFineTuningWorkerServiceClient) UpdateJobStatusReason(ctx context.Context, in *v1.UpdateJobStatusReasonRequest, opts ...grpc.CallOption) (*v1.UpdateJobStatusReasonResponse, error) {
+	if c.statusReasons == nil {
+		c.statusReasons = map[string]string{}
+	}
+	c.statusReasons[in.Id] = in.StatusReason
+	return &v1.UpdateJobStatusReasonResponse{}, nil
+}
+
 type fakeWorkspaceWorkerServiceClient struct {
-	notebooks    []*v1.InternalNotebook
-	updatedState map[string]v1.NotebookState
+	notebooks     []*v1.InternalNotebook
+	updatedState  map[string]v1.NotebookState
+	updatedErrors map[string]*v1.Notebook_Error
 }
 
 func (c *fakeWorkspaceWorkerServiceClient) ListQueuedInternalNotebooks(ctx context.Context, in *v1.ListQueuedInternalNotebooksRequest, opts ...grpc.CallOption) (*v1.ListQueuedInternalNotebooksResponse, error) {
@@ -242,12 +322,20 @@ func (c *fakeWorkspaceWorkerServiceClient) ListQueuedInternalNotebooks(ctx conte
 
 func (c *fakeWorkspaceWorkerServiceClient) UpdateNotebookState(ctx context.Context, in *v1.UpdateNotebookStateRequest, opts ...grpc.CallOption) (*v1.UpdateNotebookStateResponse, error) {
 	c.updatedState[in.Id] = in.State
+	if in.Error != nil {
+		if c.updatedErrors == nil {
+			c.updatedErrors = map[string]*v1.Notebook_Error{}
+		}
+		c.updatedErrors[in.Id] = in.Error
+	}
 	return &v1.UpdateNotebookStateResponse{}, nil
 }
 
 type fakeBatchWorkerServiceClient struct {
-	jobs         []*v1.InternalBatchJob
-	updatedState map[string]v1.InternalBatchJob_State
+	jobs          []*v1.InternalBatchJob
+	updatedState  map[string]v1.InternalBatchJob_State
+	errors        map[string]*v1.BatchJob_Error
+	statusReasons map[string]string
 }
 
 func (c *fakeBatchWorkerServiceClient) ListQueuedInternalBatchJobs(ctx context.Context, in *v1.ListQueuedInternalBatchJobsRequest, opts ...grpc.CallOption) (*v1.ListQueuedInternalBatchJobsResponse, error) {
@@ -267,5 +355,38 @@ func (c *fakeBatchWorkerServiceClient) GetInternalBatchJob(ctx context.Context,
 
 func (c *fakeBatchWorkerServiceClient) UpdateBatchJobState(ctx context.Context, in *v1.UpdateBatchJobStateRequest, opts ...grpc.CallOption) (*v1.UpdateBatchJobStateResponse, error) {
 	c.updatedState[in.Id] = in.State
+	if in.Reason != "" {
+		if c.errors == nil {
+			c.errors = map[string]*v1.BatchJob_Error{}
+		}
+		c.errors[in.Id] = &v1.BatchJob_Error{Code: in.Reason, Message: in.Message, ExitCode: in.ExitCode}
+	}
 	return &v1.UpdateBatchJobStateResponse{}, nil
 }
+
+func (c *fakeBatchWorkerServiceClient) UpdateBatchJobStatusReason(ctx context.Context, in *v1.UpdateBatchJobStatusReasonRequest, opts ...grpc.CallOption) (*v1.UpdateBatchJobStatusReasonResponse, error) {
+	if c.statusReasons == nil {
+		c.statusReasons = map[string]string{}
+	}
+	c.statusReasons[in.Id] = in.StatusReason
+	return &v1.UpdateBatchJobStatusReasonResponse{}, nil
+}
+
+type fakeJobWorkerServiceClient struct {
+	mu                 sync.Mutex
+	lastDispatchErrors map[string]string
+}
+
+func (c *fakeJobWorkerServiceClient) StreamQueuedActions(ctx context.Context, opts ...grpc.CallOption) (v1.JobWorkerService_StreamQueuedActionsClient, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (c *fakeJobWorkerServiceClient) UpdateLastDispatchError(ctx context.Context, in *v1.UpdateLastDispatchErrorRequest, opts ...grpc.CallOption) (*v1.UpdateLastDispatchErrorResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lastDispatchErrors == nil {
+		c.lastDispatchErrors = map[string]string{}
+	}
+	c.lastDispatchErrors[in.Id] = in.Error
+	return &v1.UpdateLastDispatchErrorResponse{}, nil
+}
@@ -0,0 +1,142 @@
+package dispatcher
+
+import (
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Failure codes classify the failures of workloads. They are set to the error codes of workloads so that
+// users and retry policies can act on the failure type.
+const (
+	failureCodeOOMKilled           = "oom_killed"
+	failureCodeEvicted             = "evicted"
+	failureCodeNodeLost            = "node_lost"
+	failureCodeDeadlineExceeded    = "deadline_exceeded"
+	failureCodeImagePull           = "image_pull"
+	failureCodeUserError           = "user_error"
+	failureCodePreprocessingFailed = "preprocessing_failed"
+	failureCodeUploadFailed        = "upload_failed"
+)
+
+// uploadFailedExitCode is the exit code of a fine-tuning job that fails to upload the output model.
+const uploadFailedExitCode = 120
+
+// maxTerminationMessageLines is the number of the last lines of a termination message included in a failure.
+const maxTerminationMessageLines = 3
+
+// imagePullWaitingReasons are the waiting reasons of containers whose image cannot be pulled.
+var imagePullWaitingReasons = map[string]bool{
+	"ErrImagePull":     true,
+	"ImagePullBackOff": true,
+	"InvalidImageName": true,
+}
+
+// failure is a classified failure of a workload.
+type failure struct {
+	code    string
+	message string
+	// exitCode is the exit code of the failed container. It is only set for user errors.
+	exitCode int32
+}
+
+// classifyJobFailure classifies the failure of a failed k8s job from its conditions and the states of its pods.
+// The code is empty if the failure cannot be classified.
+func classifyJobFailure(job *batchv1.Job, pods []corev1.Pod) failure {
+	var cond *batchv1.JobCondition
+	for i, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			cond = &job.Status.Conditions[i]
+			break
+		}
+	}
+	if cond != nil && cond.Reason == batchv1.JobReasonDeadlineExceeded {
+		return failure{code: failureCodeDeadlineExceeded, message: cond.Message}
+	}
+
+	for i := range pods {
+		if f, ok := classifyPodFailure(&pods[i]); ok {
+			return f
+		}
+	}
+
+	if cond == nil {
+		return failure{}
+	}
+	return failure{message: formatPodFailureReason(cond.Reason, cond.Message)}
+}
+
+// classifyPodFailure classifies the failure of a pod. It returns false if the pod has not failed.
+func classifyPodFailure(pod *corev1.Pod) (failure, bool) {
+	switch pod.Status.Reason {
+	case "Evicted":
+		return failure{code: failureCodeEvicted, message: pod.Status.Message}, true
+	case "NodeLost":
+		return failure{code: failureCodeNodeLost, message: pod.Status.Message}, true
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type != corev1.DisruptionTarget || cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Reason {
+		case corev1.PodReasonTerminationByKubelet, corev1.PodReasonPreemptionByScheduler, "EvictionByEvictionAPI":
+			return failure{code: failureCodeEvicted, message: cond.Message}, true
+		case "DeletionByTaintManager", "DeletionByPodGC":
+			return failure{code: failureCodeNodeLost, message: cond.Message}, true
+		}
+	}
+
+	var statuses []corev1.ContainerStatus
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, s := range statuses {
+		if f, ok := classifyContainerFailure(s); ok {
+			return f, true
+		}
+	}
+	return failure{}, false
+}
+
+// classifyContainerFailure classifies the failure of a container. It returns false if the container has not failed.
+func classifyContainerFailure(s corev1.ContainerStatus) (failure, bool) {
+	if w := s.State.Waiting; w != nil {
+		if imagePullWaitingReasons[w.Reason] {
+			return failure{code: failureCodeImagePull, message: formatPodFailureReason(w.Reason, w.Message)}, true
+		}
+	}
+
+	t := s.State.Terminated
+	if t == nil {
+		// A crash-looping container is waiting to restart after the last termination.
+		t = s.LastTerminationState.Terminated
+	}
+	if t == nil || (t.ExitCode == 0 && t.Reason != "OOMKilled") {
+		return failure{}, false
+	}
+	if t.Reason == "OOMKilled" {
+		return failure{
+			code:    failureCodeOOMKilled,
+			message: fmt.Sprintf("container %s was OOM killed", s.Name),
+		}, true
+	}
+	msg := fmt.Sprintf("container %s exited with code %d", s.Name, t.ExitCode)
+	if m := lastLines(t.Message, maxTerminationMessageLines); m != "" {
+		msg = fmt.Sprintf("%s: %s", msg, m)
+	}
+	return failure{
+		code:     failureCodeUserError,
+		message:  msg,
+		exitCode: t.ExitCode,
+	}, true
+}
+
+// lastLines returns the last n lines of s to avoid including large logs.
+func lastLines(s string, n int) string {
+	l := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(l) > n {
+		return strings.Join(l[len(l)-n:], "\n")
+	}
+	return strings.Join(l, "\n")
+}
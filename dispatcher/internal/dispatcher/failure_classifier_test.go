@@ -0,0 +1,172 @@
+package dispatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestClassifyJobFailure(t *testing.T) {
+	backoffLimitExceeded := batchv1.JobCondition{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  batchv1.JobReasonBackoffLimitExceeded,
+		Message: "Job has reached the specified backoff limit",
+	}
+	terminatedPod := func(t *corev1.ContainerStateTerminated) corev1.Pod {
+		return corev1.Pod{
+			Status: corev1.PodStatus{
+				Phase: corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:  "main",
+						State: corev1.ContainerState{Terminated: t},
+					},
+				},
+			},
+		}
+	}
+
+	tcs := []struct {
+		name string
+		cond batchv1.JobCondition
+		pods []corev1.Pod
+		want failure
+	}{
+		{
+			name: "deadline exceeded",
+			cond: batchv1.JobCondition{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  batchv1.JobReasonDeadlineExceeded,
+				Message: "Job was active longer than specified deadline",
+			},
+			pods: []corev1.Pod{terminatedPod(&corev1.ContainerStateTerminated{ExitCode: 143})},
+			want: failure{
+				code:    failureCodeDeadlineExceeded,
+				message: "Job was active longer than specified deadline",
+			},
+		},
+		{
+			name: "oom killed",
+			cond: backoffLimitExceeded,
+			pods: []corev1.Pod{terminatedPod(&corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"})},
+			want: failure{
+				code:    failureCodeOOMKilled,
+				message: "container main was OOM killed",
+			},
+		},
+		{
+			name: "user error",
+			cond: backoffLimitExceeded,
+			pods: []corev1.Pod{terminatedPod(&corev1.ContainerStateTerminated{
+				ExitCode: 1,
+				Reason:   "Error",
+				Message:  "line0\nline1\nline2\nValueError: invalid dataset\n",
+			})},
+			want: failure{
+				code:     failureCodeUserError,
+				message:  "container main exited with code 1: line1\nline2\nValueError: invalid dataset",
+				exitCode: 1,
+			},
+		},
+		{
+			name: "evicted",
+			cond: backoffLimitExceeded,
+			pods: []corev1.Pod{
+				{
+					Status: corev1.PodStatus{
+						Phase:   corev1.PodFailed,
+						Reason:  "Evicted",
+						Message: "The node was low on resource: memory.",
+					},
+				},
+			},
+			want: failure{
+				code:    failureCodeEvicted,
+				message: "The node was low on resource: memory.",
+			},
+		},
+		{
+			name: "node lost",
+			cond: backoffLimitExceeded,
+			pods: []corev1.Pod{
+				{
+					Status: corev1.PodStatus{
+						Phase: corev1.PodFailed,
+						Conditions: []corev1.PodCondition{
+							{
+								Type:    corev1.DisruptionTarget,
+								Status:  corev1.ConditionTrue,
+								Reason:  "DeletionByTaintManager",
+								Message: "Taint manager: deleting due to NoExecute taint",
+							},
+						},
+					},
+				},
+			},
+			want: failure{
+				code:    failureCodeNodeLost,
+				message: "Taint manager: deleting due to NoExecute taint",
+			},
+		},
+		{
+			name: "image pull",
+			cond: backoffLimitExceeded,
+			pods: []corev1.Pod{*imagePullBackOffPod("job0", time.Now())},
+			want: failure{
+				code:    failureCodeImagePull,
+				message: "ImagePullBackOff: Back-off pulling image",
+			},
+		},
+		{
+			name: "unclassified",
+			cond: backoffLimitExceeded,
+			want: failure{
+				message: "BackoffLimitExceeded: Job has reached the specified backoff limit",
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			job := &batchv1.Job{
+				Status: batchv1.JobStatus{
+					Failed:     1,
+					Conditions: []batchv1.JobCondition{tc.cond},
+				},
+			}
+			got := classifyJobFailure(job, tc.pods)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestClassifyContainerFailure_CrashLoopBackOff(t *testing.T) {
+	got, ok := classifyContainerFailure(corev1.ContainerStatus{
+		Name: "main",
+		State: corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+		},
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 2},
+		},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, failure{
+		code:     failureCodeUserError,
+		message:  "container main exited with code 2",
+		exitCode: 2,
+	}, got)
+
+	_, ok = classifyContainerFailure(corev1.ContainerStatus{
+		Name: "main",
+		State: corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{},
+		},
+	})
+	assert.False(t, ok)
+}
@@ -0,0 +1,258 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"google.golang.org/grpc"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type lastDispatchErrorUpdater interface {
+	UpdateLastDispatchError(ctx context.Context, in *v1.UpdateLastDispatchErrorRequest, opts ...grpc.CallOption) (*v1.UpdateLastDispatchErrorResponse, error)
+}
+
+// queuedItem is a queued action of a workload.
+type queuedItem struct {
+	id      string
+	action  string
+	process func(ctx context.Context) error
+}
+
+type itemKey struct {
+	id     string
+	action string
+}
+
+// itemAttempts is the failed attempts to process an item.
+type itemAttempts struct {
+	count       int
+	nextAttempt time.Time
+	lastError   string
+}
+
+// itemProcessor processes queued items independently with a fixed number of long-lived workers. Items are queued
+// without waiting for their completion, and an item is not queued again while it is queued or being processed.
+// Each attempt to process an item is bounded by a timeout. A failed item is retried with exponential backoff, and
+// dead-lettered after the maximum number of attempts so that it does not block other items. Errors are reported
+// to the server.
+//
+// The attempts are kept only in memory. A dead-lettered item is retried after the dispatcher restarts while the
+// server keeps its last error as the last dispatch error of the workload.
+type itemProcessor struct {
+	workloadType v1.DispatchAction_WorkloadType
+	updater      lastDispatchErrorUpdater
+	config       config.QueuedActionConfig
+	now          func() time.Time
+
+	mu sync.Mutex
+	// attempts are the failed attempts of the items that have not succeeded yet.
+	attempts map[itemKey]*itemAttempts
+	// queue is the items waiting for a worker.
+	queue []queuedItem
+	// inFlight is the keys of the items that are queued or being processed.
+	inFlight map[itemKey]bool
+
+	// ready is signaled when an item is queued.
+	ready chan struct{}
+	// completed is signaled when an attempt to process an item completes.
+	completed chan struct{}
+}
+
+func newItemProcessor(
+	workloadType v1.DispatchAction_WorkloadType,
+	updater lastDispatchErrorUpdater,
+	config config.QueuedActionConfig,
+) *itemProcessor {
+	return &itemProcessor{
+		workloadType: workloadType,
+		updater:      updater,
+		config:       config,
+		now:          time.Now,
+		attempts:     map[itemKey]*itemAttempts{},
+		inFlight:     map[itemKey]bool{},
+		ready:        make(chan struct{}, 1),
+		completed:    make(chan struct{}, 1),
+	}
+}
+
+// run runs the workers until the context is canceled.
+func (p *itemProcessor) run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for range p.config.Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				item, ok := p.next()
+				if !ok {
+					select {
+					case <-ctx.Done():
+						return
+					case <-p.ready:
+					}
+					continue
+				}
+				p.processItem(ctx, item)
+			}
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// enqueue queues the items that are not in flight, backing off or dead-lettered. It does not wait for the items
+// to be processed. The items must be all queued items of the workload type.
+func (p *itemProcessor) enqueue(items []queuedItem) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	queued := map[itemKey]bool{}
+	for _, item := range items {
+		queued[itemKey{id: item.id, action: item.action}] = true
+	}
+	for k := range p.attempts {
+		if !queued[k] {
+			// Forget the items that are no longer queued or whose actions have changed.
+			delete(p.attempts, k)
+		}
+	}
+	// Drop the items waiting for a worker that are no longer queued. The items being processed are not canceled.
+	var waiting []queuedItem
+	for _, item := range p.queue {
+		k := itemKey{id: item.id, action: item.action}
+		if queued[k] {
+			waiting = append(waiting, item)
+		} else {
+			delete(p.inFlight, k)
+		}
+	}
+	p.queue = waiting
+
+	now := p.now()
+	for _, item := range items {
+		k := itemKey{id: item.id, action: item.action}
+		if p.inFlight[k] {
+			continue
+		}
+		if a, ok := p.attempts[k]; ok && (a.count >= p.config.MaxAttempts || now.Before(a.nextAttempt)) {
+			continue
+		}
+		p.inFlight[k] = true
+		p.queue = append(p.queue, item)
+	}
+	if len(p.queue) > 0 {
+		signal(p.ready)
+	}
+}
+
+// next takes an item from the queue. It returns false if the queue is empty.
+func (p *itemProcessor) next() (queuedItem, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.queue) == 0 {
+		return queuedItem{}, false
+	}
+	item := p.queue[0]
+	p.queue = p.queue[1:]
+	if len(p.queue) > 0 {
+		// Wake up another worker for the remaining items.
+		signal(p.ready)
+	}
+	return item, true
+}
+
+func (p *itemProcessor) processItem(ctx context.Context, item queuedItem) {
+	defer signal(p.completed)
+
+	pctx, cancel := context.WithTimeout(ctx, p.config.Timeout)
+	err := item.process(pctx)
+	cancel()
+
+	k := itemKey{id: item.id, action: item.action}
+	p.mu.Lock()
+	delete(p.inFlight, k)
+	a, failedBefore := p.attempts[k]
+	if err == nil {
+		delete(p.attempts, k)
+		p.mu.Unlock()
+		if failedBefore {
+			p.updateLastDispatchError(ctx, item.id, "")
+		}
+		return
+	}
+	if ctx.Err() != nil {
+		// The dispatcher is stopping. The item is processed again after the restart.
+		p.mu.Unlock()
+		return
+	}
+
+	if !failedBefore {
+		a = &itemAttempts{}
+		p.attempts[k] = a
+	}
+	a.count++
+	backoff := p.backoff(a.count)
+	a.nextAttempt = p.now().Add(backoff)
+	log := ctrl.LoggerFrom(ctx).WithValues("id", item.id, "action", item.action, "attempts", a.count)
+	if a.count >= p.config.MaxAttempts {
+		a.lastError = fmt.Sprintf("gave up after %d attempts: %s", a.count, err)
+		log.Error(err, "Dead-lettered the action")
+	} else {
+		a.lastError = fmt.Sprintf("attempt %d failed: %s", a.count, err)
+		log.Error(err, "Failed to process the action", "retryAfter", backoff)
+	}
+	msg := a.lastError
+	p.mu.Unlock()
+
+	p.updateLastDispatchError(ctx, item.id, msg)
+}
+
+func (p *itemProcessor) backoff(count int) time.Duration {
+	b := p.config.InitialBackoff
+	for i := 1; i < count && b < p.config.MaxBackoff; i++ {
+		b *= 2
+	}
+	return min(b, p.config.MaxBackoff)
+}
+
+func (p *itemProcessor) updateLastDispatchError(ctx context.Context, id, msg string) {
+	if _, err := p.updater.UpdateLastDispatchError(ctx, &v1.UpdateLastDispatchErrorRequest{
+		WorkloadType: p.workloadType,
+		Id:           id,
+		Error:        msg,
+	}); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to update the last dispatch error", "id", id)
+	}
+}
+
+// result returns whether the processing of the item of the workload is settled and the error of its last attempt.
+// The processing is not settled while the item is in flight. The error is empty if the last attempt succeeded
+// or the item is not queued.
+func (p *itemProcessor) result(id string) (bool, string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for k := range p.inFlight {
+		if k.id == id {
+			return false, ""
+		}
+	}
+	for k, a := range p.attempts {
+		if k.id == id {
+			return true, a.lastError
+		}
+	}
+	return true, ""
+}
+
+// signal signals the channel without blocking. Signals are coalesced while the channel is not received.
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,257 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemProcessor(t *testing.T) {
+	updater := &fakeJobWorkerServiceClient{}
+	p := newItemProcessor(v1.DispatchAction_JOB, updater, config.QueuedActionConfig{
+		Concurrency:    2,
+		InitialBackoff: time.Second,
+		MaxBackoff:     2 * time.Second,
+		MaxAttempts:    3,
+		Timeout:        time.Minute,
+	})
+	runItemProcessor(t, p)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	var (
+		mu        sync.Mutex
+		processed = map[string]int{}
+		failing   = true
+	)
+	newItems := func() []queuedItem {
+		var items []queuedItem
+		for _, id := range []string{"job0", "job1", "job2"} {
+			items = append(items, queuedItem{
+				id:     id,
+				action: "CREATING",
+				process: func(ctx context.Context) error {
+					mu.Lock()
+					defer mu.Unlock()
+					processed[id]++
+					if id == "job1" && failing {
+						return errors.New("failed")
+					}
+					return nil
+				},
+			})
+		}
+		return items
+	}
+
+	// A failed item does not block other items.
+	enqueueAndWait(p, newItems())
+	assert.Equal(t, map[string]int{"job0": 1, "job1": 1, "job2": 1}, processed)
+	assert.Equal(t, "attempt 1 failed: failed", lastError(p, "job1"))
+	assert.Empty(t, lastError(p, "job0"))
+	assert.Equal(t, "attempt 1 failed: failed", updater.lastDispatchErrors["job1"])
+	assert.NotContains(t, updater.lastDispatchErrors, "job0")
+
+	// The failed item is skipped while backing off.
+	enqueueAndWait(p, newItems())
+	assert.Equal(t, 1, processed["job1"])
+
+	now = now.Add(time.Second)
+	enqueueAndWait(p, newItems())
+	assert.Equal(t, 2, processed["job1"])
+	assert.Equal(t, "attempt 2 failed: failed", lastError(p, "job1"))
+
+	now = now.Add(2 * time.Second)
+	enqueueAndWait(p, newItems())
+	assert.Equal(t, 3, processed["job1"])
+	assert.Equal(t, "gave up after 3 attempts: failed", lastError(p, "job1"))
+
+	// The dead-lettered item is no longer processed.
+	now = now.Add(time.Hour)
+	enqueueAndWait(p, newItems())
+	assert.Equal(t, 3, processed["job1"])
+
+	// The item is processed again once its action changes.
+	failing = false
+	items := newItems()
+	items[1].action = "CANCELING"
+	enqueueAndWait(p, items)
+	assert.Equal(t, 4, processed["job1"])
+	assert.Empty(t, lastError(p, "job1"))
+}
+
+func TestItemProcessor_ClearError(t *testing.T) {
+	updater := &fakeJobWorkerServiceClient{}
+	p := newItemProcessor(v1.DispatchAction_NOTEBOOK, updater, config.QueuedActionConfig{
+		Concurrency:    1,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		MaxAttempts:    3,
+		Timeout:        time.Minute,
+	})
+	runItemProcessor(t, p)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	err := errors.New("failed")
+	items := []queuedItem{
+		{
+			id:     "nb0",
+			action: "STARTING",
+			process: func(ctx context.Context) error {
+				return err
+			},
+		},
+	}
+	enqueueAndWait(p, items)
+	assert.Equal(t, "attempt 1 failed: failed", updater.lastDispatchErrors["nb0"])
+
+	err = nil
+	now = now.Add(time.Second)
+	enqueueAndWait(p, items)
+	assert.Empty(t, lastError(p, "nb0"))
+	v, ok := updater.lastDispatchErrors["nb0"]
+	assert.True(t, ok)
+	assert.Empty(t, v)
+}
+
+func TestItemProcessorBackoff(t *testing.T) {
+	p := newItemProcessor(v1.DispatchAction_JOB, &fakeJobWorkerServiceClient{}, config.QueuedActionConfig{
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+	})
+	assert.Equal(t, time.Second, p.backoff(1))
+	assert.Equal(t, 2*time.Second, p.backoff(2))
+	assert.Equal(t, 4*time.Second, p.backoff(3))
+	assert.Equal(t, 5*time.Second, p.backoff(4))
+	assert.Equal(t, 5*time.Second, p.backoff(100))
+}
+
+func TestItemProcessor_InFlight(t *testing.T) {
+	p := newItemProcessor(v1.DispatchAction_JOB, &fakeJobWorkerServiceClient{}, config.QueuedActionConfig{
+		Concurrency:    2,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		MaxAttempts:    3,
+		Timeout:        time.Minute,
+	})
+	runItemProcessor(t, p)
+
+	var (
+		mu        sync.Mutex
+		processed = map[string]int{}
+	)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	items := []queuedItem{
+		{
+			id:     "job0",
+			action: "CREATING",
+			process: func(ctx context.Context) error {
+				mu.Lock()
+				processed["job0"]++
+				mu.Unlock()
+				close(started)
+				<-release
+				return nil
+			},
+		},
+		{
+			id:     "job1",
+			action: "CREATING",
+			process: func(ctx context.Context) error {
+				mu.Lock()
+				defer mu.Unlock()
+				processed["job1"]++
+				return nil
+			},
+		},
+	}
+
+	// Queuing does not wait for the items, and a slow item does not block other items.
+	p.enqueue(items)
+	<-started
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return processed["job1"] == 1
+	}, time.Second, 10*time.Millisecond)
+	done, _ := p.result("job0")
+	assert.False(t, done)
+
+	// The item in flight is not queued again.
+	p.enqueue(items[:1])
+	close(release)
+	waitIdle(p)
+	assert.Equal(t, 1, processed["job0"])
+	done, errMsg := p.result("job0")
+	assert.True(t, done)
+	assert.Empty(t, errMsg)
+}
+
+func TestItemProcessor_Timeout(t *testing.T) {
+	p := newItemProcessor(v1.DispatchAction_JOB, &fakeJobWorkerServiceClient{}, config.QueuedActionConfig{
+		Concurrency:    1,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		MaxAttempts:    3,
+		Timeout:        10 * time.Millisecond,
+	})
+	runItemProcessor(t, p)
+
+	enqueueAndWait(p, []queuedItem{
+		{
+			id:     "job0",
+			action: "CREATING",
+			process: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		},
+	})
+	assert.Equal(t, "attempt 1 failed: context deadline exceeded", lastError(p, "job0"))
+}
+
+// runItemProcessor runs the workers of the processor until the test finishes.
+func runItemProcessor(t *testing.T, p *itemProcessor) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		_ = p.run(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+// enqueueAndWait queues the items and waits until no item is in flight.
+func enqueueAndWait(p *itemProcessor, items []queuedItem) {
+	p.enqueue(items)
+	waitIdle(p)
+}
+
+// waitIdle waits until no item is in flight.
+func waitIdle(p *itemProcessor) {
+	for {
+		p.mu.Lock()
+		idle := len(p.inFlight) == 0
+		p.mu.Unlock()
+		if idle {
+			return
+		}
+		<-p.completed
+	}
+}
+
+func lastError(p *itemProcessor, id string) string {
+	_, errMsg := p.result(id)
+	return errMsg
+}
@@ -13,9 +13,12 @@ import (
 
 	v1 "github.com/llmariner/job-manager/api/v1"
 	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"github.com/llmariner/job-manager/pkg/workload"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -52,6 +55,7 @@ func NewJobClient(
 		jobConfig:      jobConfig,
 		kueueConfig:    kueueConfig,
 		workloadConfig: workloadConfig,
+		kueueQueues:    newKueueQueueManager(k8sClient, kueueConfig),
 	}
 }
 
@@ -61,6 +65,7 @@ type JobClient struct {
 	jobConfig      config.JobConfig
 	kueueConfig    config.KueueConfig
 	workloadConfig config.WorkloadConfig
+	kueueQueues    *kueueQueueManager
 }
 
 func (p *JobClient) createJob(ctx context.Context, ijob *v1.InternalJob, presult *PreProcessResult) error {
@@ -75,14 +80,23 @@ func (p *JobClient) createJob(ctx context.Context, ijob *v1.InternalJob, presult
 
 	obj := batchv1apply.
 		Job(ijob.Job.Id, ijob.Job.KubernetesNamespace).
+		WithLabels(workload.Labels(workload.KindFineTuningJob, ijob.Job.Id)).
 		WithAnnotations(map[string]string{
 			managedJobAnnotationKey: "true",
 			jobIDAnnotationKey:      ijob.Job.Id}).
 		WithSpec(spec)
 
 	if p.kueueConfig.Enable {
+		q := ijob.KueueQueue
+		name, err := p.kueueQueues.ensureQueue(ctx, ijob.Job.KubernetesNamespace, kueueQueue{
+			name:           q.GetName(),
+			resourceQuotas: q.GetResourceQuotas(),
+		})
+		if err != nil {
+			return fmt.Errorf("ensure kueue queue: %s", err)
+		}
 		obj.WithLabels(map[string]string{
-			kueueQueueNameLabelKey: p.getQueueName(ijob.Job.KubernetesNamespace),
+			kueueQueueNameLabelKey: name,
 		})
 	}
 
@@ -106,7 +120,7 @@ func (p *JobClient) jobSpec(job *v1.Job, presult *PreProcessResult) (*batchv1app
 		WithImage(fmt.Sprintf("%s:%s", p.jobConfig.Image, p.jobConfig.Version)).
 		WithImagePullPolicy(p.jobConfig.ImagePullPolicy).
 		WithCommand("/bin/bash", "-c", cmd).
-		WithResources(p.res(gpuCount)).
+		WithResources(p.res(job.Resources, gpuCount)).
 		WithTerminationMessagePolicy(corev1.TerminationMessageFallbackToLogsOnError)
 
 	if s := p.jobConfig.WandbAPIKeySecret; s.Name != "" {
@@ -128,6 +142,7 @@ func (p *JobClient) jobSpec(job *v1.Job, presult *PreProcessResult) (*batchv1app
 		WithContainers(container).
 		WithRestartPolicy(corev1.RestartPolicyNever)
 	podSpec = applyWorkloadConfig(podSpec, p.workloadConfig)
+	podSpec = applyGPUNodeAffinity(podSpec, job.Resources.GetGpuType(), job.Resources.GetMinGpuMemoryMegabytes())
 
 	jobSpec := batchv1apply.JobSpec().
 		WithTTLSecondsAfterFinished(int32(jobTTL.Seconds())).
@@ -140,14 +155,27 @@ func (p *JobClient) jobSpec(job *v1.Job, presult *PreProcessResult) (*batchv1app
 	return jobSpec, nil
 }
 
-func (p *JobClient) res(gpuCount int) *corev1apply.ResourceRequirementsApplyConfiguration {
-	if gpuCount == 0 {
+func (p *JobClient) res(r *v1.Job_Resources, gpuCount int) *corev1apply.ResourceRequirementsApplyConfiguration {
+	req := corev1.ResourceList{}
+	if cpu := r.GetCpuMilicore(); cpu > 0 {
+		req[corev1.ResourceCPU] = *resource.NewMilliQuantity(int64(cpu), resource.DecimalSI)
+	}
+	if mem := r.GetMemoryMegabytes(); mem > 0 {
+		req[corev1.ResourceMemory] = *resource.NewScaledQuantity(int64(mem), 6)
+	}
+	if gpuCount == 0 && len(req) == 0 {
 		return nil
 	}
-	return corev1apply.ResourceRequirements().
-		WithLimits(corev1.ResourceList{
-			"nvidia.com/gpu": *resource.NewQuantity(int64(gpuCount), resource.DecimalSI),
+	res := corev1apply.ResourceRequirements()
+	if len(req) > 0 {
+		res.WithRequests(req)
+	}
+	if gpuCount > 0 {
+		res.WithLimits(corev1.ResourceList{
+			corev1.ResourceName(accelerator.ResourceNameOrDefault(r.GetAcceleratorType())): *resource.NewQuantity(int64(gpuCount), resource.DecimalSI),
 		})
+	}
+	return res
 }
 
 func (p *JobClient) cmd(job *v1.Job, presult *PreProcessResult) (string, int, error) {
@@ -164,6 +192,8 @@ func (p *JobClient) cmd(job *v1.Job, presult *PreProcessResult) (string, int, er
 		AdditionalSFTArgs string
 
 		CurlFlags string
+
+		UploadFailedExitCode int
 	}
 	numProcessors, err := getGpuCount(job)
 	if err != nil {
@@ -191,6 +221,8 @@ func (p *JobClient) cmd(job *v1.Job, presult *PreProcessResult) (string, int, er
 		AdditionalSFTArgs: additionalSFTArgs,
 
 		CurlFlags: curlFlags,
+
+		UploadFailedExitCode: uploadFailedExitCode,
 	}
 	var buf bytes.Buffer
 	if err := t.Execute(&buf, &params); err != nil {
@@ -213,11 +245,6 @@ func getGpuCount(job *v1.Job) (int, error) {
 	return numProcessors, nil
 }
 
-func (p *JobClient) getQueueName(namespace string) string {
-	// TODO(aya): rethink how to get queue name
-	return p.kueueConfig.DefaultQueueName
-}
-
 func (p *JobClient) cancelJob(ctx context.Context, ijob *v1.InternalJob) error {
 	var kjob batchv1.Job
 	if err := p.k8sClient.Get(ctx, types.NamespacedName{
@@ -232,6 +259,19 @@ func (p *JobClient) cancelJob(ctx context.Context, ijob *v1.InternalJob) error {
 	return p.k8sClient.Update(ctx, &kjob, client.FieldOwner(jobManagerName))
 }
 
+func (p *JobClient) deleteJob(ctx context.Context, ijob *v1.InternalJob) error {
+	var kjob batchv1.Job
+	if err := p.k8sClient.Get(ctx, types.NamespacedName{
+		Name:      ijob.Job.Id,
+		Namespace: ijob.Job.KubernetesNamespace,
+	}, &kjob); err != nil {
+		log := ctrl.LoggerFrom(ctx)
+		log.V(2).Info("Failed to get the k8s job", "error", err)
+		return client.IgnoreNotFound(err)
+	}
+	return p.k8sClient.Delete(ctx, &kjob, client.PropagationPolicy(metav1.DeletePropagationBackground))
+}
+
 func toAddtionalSFTArgs(job *v1.Job, config config.JobConfig) (string, error) {
 	args := []string{}
 	if hp := job.Hyperparameters; hp != nil {
@@ -0,0 +1,174 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	kueueClusterQueueGVK = schema.GroupVersionKind{Group: "kueue.x-k8s.io", Version: "v1beta1", Kind: "ClusterQueue"}
+	kueueLocalQueueGVK   = schema.GroupVersionKind{Group: "kueue.x-k8s.io", Version: "v1beta1", Kind: "LocalQueue"}
+)
+
+// kueueQueue is the Kueue queue of the project that a workload belongs to.
+type kueueQueue struct {
+	name           string
+	resourceQuotas map[string]string
+}
+
+func newKueueQueueManager(k8sClient client.Client, config config.KueueConfig) *kueueQueueManager {
+	return &kueueQueueManager{
+		k8sClient:   k8sClient,
+		config:      config,
+		provisioned: map[string]string{},
+	}
+}
+
+// kueueQueueManager resolves the Kueue LocalQueues that workloads are submitted to. It also creates the queues
+// if provisioning is enabled.
+type kueueQueueManager struct {
+	k8sClient client.Client
+	config    config.KueueConfig
+
+	// mu protects provisioned.
+	mu sync.Mutex
+	// provisioned is the spec of the queues last applied to each namespace. The queues are applied only when
+	// a namespace is seen for the first time or the configuration of its queues changes.
+	provisioned map[string]string
+}
+
+// queueName returns the name of the LocalQueue for a workload in the namespace. The queue of the project takes
+// precedence over the queue configured for the namespace.
+func (m *kueueQueueManager) queueName(namespace string, q kueueQueue) string {
+	if q.name != "" {
+		return q.name
+	}
+	if name, ok := m.config.NamespaceQueueNames[namespace]; ok {
+		return name
+	}
+	return m.config.DefaultQueueName
+}
+
+// ensureQueue returns the name of the LocalQueue for a workload in the namespace. If provisioning is enabled,
+// it creates or updates the LocalQueue and the ClusterQueue of the namespace. The quotas of the ClusterQueue
+// are the quotas of the project if specified.
+func (m *kueueQueueManager) ensureQueue(ctx context.Context, namespace string, q kueueQueue) (string, error) {
+	name := m.queueName(namespace, q)
+	if !m.config.Provisioning.Enable {
+		return name, nil
+	}
+
+	quotas := q.resourceQuotas
+	if len(quotas) == 0 {
+		quotas = m.config.Provisioning.DefaultResourceQuotas
+	}
+	// Create a ClusterQueue for each namespace so that the quotas of namespaces are isolated.
+	cqName := namespace
+	cq, err := m.clusterQueue(cqName, namespace, quotas)
+	if err != nil {
+		return "", err
+	}
+	lq := localQueue(name, namespace, cqName)
+	spec, err := json.Marshal([]any{cq.Object, lq.Object})
+	if err != nil {
+		return "", fmt.Errorf("marshal queues: %s", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.provisioned[namespace] == string(spec) {
+		return name, nil
+	}
+	for _, obj := range []*unstructured.Unstructured{cq, lq} {
+		if err := m.apply(ctx, obj); err != nil {
+			return "", err
+		}
+	}
+	m.provisioned[namespace] = string(spec)
+	ctrl.LoggerFrom(ctx).Info("Provisioned the Kueue queue", "localQueue", name, "clusterQueue", cqName)
+	return name, nil
+}
+
+// apply applies the queue. The fields owned by other managers (e.g., the queues edited by administrators) are
+// not overwritten. The queue is left as it is in that case.
+func (m *kueueQueueManager) apply(ctx context.Context, obj *unstructured.Unstructured) error {
+	opts := &client.PatchOptions{FieldManager: jobManagerName}
+	if err := m.k8sClient.Patch(ctx, obj, client.Apply, opts); err != nil {
+		if !apierrors.IsConflict(err) {
+			return fmt.Errorf("apply %s: %s", obj.GetKind(), err)
+		}
+		ctrl.LoggerFrom(ctx).Info("Skipped the Kueue queue managed by others", "kind", obj.GetKind(), "name", obj.GetName(), "reason", err)
+	}
+	return nil
+}
+
+func (m *kueueQueueManager) clusterQueue(name, namespace string, quotas map[string]string) (*unstructured.Unstructured, error) {
+	names := make([]string, 0, len(quotas))
+	for n := range quotas {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	var (
+		covered   []any
+		resources []any
+	)
+	for _, n := range names {
+		q, err := resource.ParseQuantity(quotas[n])
+		if err != nil {
+			return nil, fmt.Errorf("invalid quota of %q: %s", n, err)
+		}
+		covered = append(covered, n)
+		resources = append(resources, map[string]any{
+			"name":         n,
+			"nominalQuota": q.String(),
+		})
+	}
+
+	cq := &unstructured.Unstructured{}
+	cq.SetGroupVersionKind(kueueClusterQueueGVK)
+	cq.SetName(name)
+	cq.SetLabels(map[string]string{"app.kubernetes.io/managed-by": jobManagerName})
+	cq.Object["spec"] = map[string]any{
+		"namespaceSelector": map[string]any{
+			"matchLabels": map[string]any{
+				"kubernetes.io/metadata.name": namespace,
+			},
+		},
+		"resourceGroups": []any{
+			map[string]any{
+				"coveredResources": covered,
+				"flavors": []any{
+					map[string]any{
+						"name":      m.config.Provisioning.ResourceFlavorName,
+						"resources": resources,
+					},
+				},
+			},
+		},
+	}
+	return cq, nil
+}
+
+func localQueue(name, namespace, clusterQueueName string) *unstructured.Unstructured {
+	lq := &unstructured.Unstructured{}
+	lq.SetGroupVersionKind(kueueLocalQueueGVK)
+	lq.SetName(name)
+	lq.SetNamespace(namespace)
+	lq.SetLabels(map[string]string{"app.kubernetes.io/managed-by": jobManagerName})
+	lq.Object["spec"] = map[string]any{
+		"clusterQueue": clusterQueueName,
+	}
+	return lq
+}
@@ -0,0 +1,188 @@
+package dispatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestKueueQueueName(t *testing.T) {
+	m := &kueueQueueManager{
+		config: config.KueueConfig{
+			Enable:           true,
+			DefaultQueueName: "default",
+			NamespaceQueueNames: map[string]string{
+				"ns0": "queue0",
+			},
+		},
+	}
+
+	assert.Equal(t, "queue0", m.queueName("ns0", kueueQueue{}))
+	assert.Equal(t, "default", m.queueName("ns1", kueueQueue{}))
+	assert.Equal(t, "project-queue", m.queueName("ns0", kueueQueue{name: "project-queue"}))
+}
+
+func TestKueueEnsureQueue(t *testing.T) {
+	tcs := []struct {
+		name       string
+		q          kueueQueue
+		wantQuotas map[string]any
+	}{
+		{
+			name: "default quotas",
+			wantQuotas: map[string]any{
+				"nvidia.com/gpu": "4",
+			},
+		},
+		{
+			name: "project quotas",
+			q: kueueQueue{
+				name: "project-queue",
+				resourceQuotas: map[string]string{
+					"nvidia.com/gpu": "8",
+					"memory":         "1024Mi",
+				},
+			},
+			wantQuotas: map[string]any{
+				"nvidia.com/gpu": "8",
+				"memory":         "1Gi",
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			applied := map[string]*unstructured.Unstructured{}
+			k8sClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(ctx context.Context, client client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					u := obj.(*unstructured.Unstructured)
+					applied[u.GetKind()] = u
+					return nil
+				},
+			}).Build()
+			m := newKueueQueueManager(k8sClient, provisioningKueueConfig())
+
+			name, err := m.ensureQueue(context.Background(), "ns0", tc.q)
+			assert.NoError(t, err)
+
+			lq, ok := applied["LocalQueue"]
+			assert.True(t, ok)
+			assert.Equal(t, name, lq.GetName())
+			assert.Equal(t, "ns0", lq.GetNamespace())
+			cqName, _, err := unstructured.NestedString(lq.Object, "spec", "clusterQueue")
+			assert.NoError(t, err)
+			assert.Equal(t, "ns0", cqName)
+
+			cq, ok := applied["ClusterQueue"]
+			assert.True(t, ok)
+			assert.Equal(t, "ns0", cq.GetName())
+			groups, _, err := unstructured.NestedSlice(cq.Object, "spec", "resourceGroups")
+			assert.NoError(t, err)
+			assert.Len(t, groups, 1)
+			flavors := groups[0].(map[string]any)["flavors"].([]any)
+			assert.Len(t, flavors, 1)
+			flavor := flavors[0].(map[string]any)
+			assert.Equal(t, "flavor0", flavor["name"])
+			got := map[string]any{}
+			for _, r := range flavor["resources"].([]any) {
+				r := r.(map[string]any)
+				got[r["name"].(string)] = r["nominalQuota"]
+			}
+			assert.Equal(t, tc.wantQuotas, got)
+		})
+	}
+}
+
+func TestKueueEnsureQueue_Provisioned(t *testing.T) {
+	var applied []string
+	k8sClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, _ client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			po := &client.PatchOptions{}
+			po.ApplyOptions(opts)
+			assert.Nil(t, po.Force)
+			applied = append(applied, obj.GetNamespace()+"/"+obj.GetName())
+			return nil
+		},
+	}).Build()
+	m := newKueueQueueManager(k8sClient, provisioningKueueConfig())
+
+	ctx := context.Background()
+	_, err := m.ensureQueue(ctx, "ns0", kueueQueue{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/ns0", "ns0/default"}, applied)
+
+	// The queues are not applied again for the same namespace.
+	applied = nil
+	_, err = m.ensureQueue(ctx, "ns0", kueueQueue{})
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+
+	// The queues are applied when the quotas change.
+	_, err = m.ensureQueue(ctx, "ns0", kueueQueue{resourceQuotas: map[string]string{"nvidia.com/gpu": "8"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/ns0", "ns0/default"}, applied)
+
+	applied = nil
+	_, err = m.ensureQueue(ctx, "ns1", kueueQueue{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/ns1", "ns1/default"}, applied)
+}
+
+func TestKueueEnsureQueue_Conflict(t *testing.T) {
+	var applied int
+	k8sClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, client client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			applied++
+			if obj.GetObjectKind().GroupVersionKind().Kind == "ClusterQueue" {
+				return apierrors.NewConflict(schema.GroupResource{Group: "kueue.x-k8s.io", Resource: "clusterqueues"}, obj.GetName(), nil)
+			}
+			return nil
+		},
+	}).Build()
+	m := newKueueQueueManager(k8sClient, provisioningKueueConfig())
+
+	name, err := m.ensureQueue(context.Background(), "ns0", kueueQueue{})
+	assert.NoError(t, err)
+	assert.Equal(t, "default", name)
+	assert.Equal(t, 2, applied)
+}
+
+func TestKueueEnsureQueue_ProvisioningDisabled(t *testing.T) {
+	m := &kueueQueueManager{
+		k8sClient: fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, client client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				t.Fatalf("unexpected patch of %s", obj.GetName())
+				return nil
+			},
+		}).Build(),
+		config: config.KueueConfig{
+			Enable:           true,
+			DefaultQueueName: "default",
+		},
+	}
+	name, err := m.ensureQueue(context.Background(), "ns0", kueueQueue{})
+	assert.NoError(t, err)
+	assert.Equal(t, "default", name)
+}
+
+func provisioningKueueConfig() config.KueueConfig {
+	return config.KueueConfig{
+		Enable:           true,
+		DefaultQueueName: "default",
+		Provisioning: config.KueueProvisioningConfig{
+			Enable:             true,
+			ResourceFlavorName: "flavor0",
+			DefaultResourceQuotas: map[string]string{
+				"nvidia.com/gpu": "4",
+			},
+		},
+	}
+}
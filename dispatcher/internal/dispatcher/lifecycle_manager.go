@@ -3,17 +3,18 @@ package dispatcher
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,11 +41,13 @@ func NewLifecycleManager(
 	ftClient v1.FineTuningWorkerServiceClient,
 	client client.Client,
 	postProcessor PostProcessorI,
+	podFailureConfig config.PodFailureConfig,
 ) *LifecycleManager {
 	return &LifecycleManager{
-		ftClient:      ftClient,
-		k8sClient:     client,
-		postProcessor: postProcessor,
+		ftClient:          ftClient,
+		k8sClient:         client,
+		postProcessor:     postProcessor,
+		podFailureChecker: newPodFailureChecker(client, podFailureConfig),
 	}
 }
 
@@ -53,6 +56,8 @@ type LifecycleManager struct {
 	ftClient      v1.FineTuningWorkerServiceClient
 	k8sClient     client.Client
 	postProcessor PostProcessorI
+
+	podFailureChecker *podFailureChecker
 }
 
 // SetupWithManager registers the LifecycleManager with the manager.
@@ -63,6 +68,8 @@ func (s *LifecycleManager) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("ftjobs").
 		For(&batchv1.Job{}, builder.WithPredicates(filterByAnno)).
+		// Watch pods to detect the failures that are not propagated to the job (e.g., image pull errors).
+		Watches(&corev1.Pod{}, enqueueJobOfPod(mgr.GetClient(), isManagedJob)).
 		WithLogConstructor(func(r *reconcile.Request) logr.Logger {
 			if r != nil {
 				return mgr.GetLogger().WithValues("job", r.NamespacedName)
@@ -157,26 +164,31 @@ func (s *LifecycleManager) Reconcile(
 	}
 
 	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
-		// TODO(aya): check pod status, image pull error is not propagated to the job
 		log.V(2).Info("K8s job is still running")
-		return ctrl.Result{}, nil
+		return s.checkPodFailure(ctx, &job, ijob)
+	}
+	if err := s.updateStatusReason(ctx, ijob, ""); err != nil {
+		log.Error(err, "Failed to clear the status reason")
+		return ctrl.Result{}, err
 	}
 
 	if job.Status.Failed > 0 {
-		message, err := s.getTerminationLog(ctx, req.Name, req.Namespace)
+		f, err := s.classifyFailure(ctx, &job)
 		if err != nil {
-			log.Error(err, "Failed to get termination log")
+			log.Error(err, "Failed to classify the failure")
 			return ctrl.Result{}, err
 		}
 		if _, err = s.ftClient.UpdateJobPhase(ctx, &v1.UpdateJobPhaseRequest{
-			Id:      jobID,
-			Phase:   v1.UpdateJobPhaseRequest_FAILED,
-			Message: message,
+			Id:        jobID,
+			Phase:     v1.UpdateJobPhaseRequest_FAILED,
+			Message:   f.message,
+			ErrorCode: f.code,
+			ExitCode:  f.exitCode,
 		}); err != nil {
 			log.Error(err, "Failed to update job phase")
 			return ctrl.Result{}, err
 		}
-		log.Info("Job failed", "msg", message)
+		log.Info("Job failed", "code", f.code, "msg", f.message)
 		return ctrl.Result{}, nil
 	}
 	log.Info("Job successfully completed")
@@ -200,34 +212,71 @@ func (s *LifecycleManager) Reconcile(
 	return ctrl.Result{}, nil
 }
 
-func (s *LifecycleManager) getTerminationLog(ctx context.Context, jobName, namespace string) (string, error) {
-	// Find a pod that has a label job-name = jobName
+// checkPodFailure checks the pods of a running job and reports the reason if they fail to start. The job is
+// failed if the failure lasts longer than the grace period.
+func (s *LifecycleManager) checkPodFailure(ctx context.Context, job *batchv1.Job, ijob *v1.InternalJob) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	result, err := s.podFailureChecker.check(ctx, job)
+	if err != nil {
+		log.Error(err, "Failed to check pod failures")
+		return ctrl.Result{}, err
+	}
+	if err := s.updateStatusReason(ctx, ijob, result.reason); err != nil {
+		log.Error(err, "Failed to update the status reason")
+		return ctrl.Result{}, err
+	}
+	if !result.fail {
+		return ctrl.Result{RequeueAfter: result.requeueAfter}, nil
+	}
+
+	// Suspend the job to stop its pods from retrying.
+	job.Spec.Suspend = ptr.To(true)
+	if err := s.k8sClient.Update(ctx, job, client.FieldOwner(jobManagerName)); err != nil {
+		log.Error(err, "Failed to suspend the job")
+		return ctrl.Result{}, err
+	}
+	if _, err := s.ftClient.UpdateJobPhase(ctx, &v1.UpdateJobPhaseRequest{
+		Id:        ijob.Job.Id,
+		Phase:     v1.UpdateJobPhaseRequest_FAILED,
+		Message:   result.reason,
+		ErrorCode: result.code,
+	}); err != nil {
+		log.Error(err, "Failed to update job phase")
+		return ctrl.Result{}, err
+	}
+	log.Info("Job failed as its pod failed to start", "reason", result.reason)
+	return ctrl.Result{}, nil
+}
+
+func (s *LifecycleManager) updateStatusReason(ctx context.Context, ijob *v1.InternalJob, reason string) error {
+	if ijob.Job.StatusReason == reason {
+		return nil
+	}
+	if _, err := s.ftClient.UpdateJobStatusReason(ctx, &v1.UpdateJobStatusReasonRequest{
+		Id:           ijob.Job.Id,
+		StatusReason: reason,
+	}); err != nil {
+		return err
+	}
+	ijob.Job.StatusReason = reason
+	return nil
+}
+
+// classifyFailure classifies the failure of a failed fine-tuning job.
+func (s *LifecycleManager) classifyFailure(ctx context.Context, job *batchv1.Job) (failure, error) {
 	var podList corev1.PodList
 	if err := s.k8sClient.List(ctx, &podList,
-		client.InNamespace(namespace),
-		client.MatchingLabels{"job-name": jobName},
+		client.InNamespace(job.Namespace),
+		client.MatchingLabels{"job-name": job.Name},
 	); err != nil {
-		return "", err
-	}
-
-	if len(podList.Items) == 0 {
-		// Empty error message
-		return "", nil
-	}
-	pod := podList.Items[0]
-	for _, c := range pod.Status.ContainerStatuses {
-		if t := c.State.Terminated; t != nil && t.ExitCode != 0 {
-			// Only return the last few lines of the log to avoid large logs.
-			// TODO(kenji): Have a better way to get the error message.
-			l := strings.Split(t.Message, "\n")
-			const maxLines = 3
-			if len(l) > maxLines {
-				return strings.Join(l[len(l)-maxLines:], "\n"), nil
-			}
-			return t.Message, nil
-		}
+		return failure{}, err
 	}
-
-	// Empty error message
-	return "", nil
+	f := classifyJobFailure(job, podList.Items)
+	if f.code == failureCodeUserError && f.exitCode == uploadFailedExitCode {
+		f.code = failureCodeUploadFailed
+		f.message = "failed to upload the output model"
+		f.exitCode = 0
+	}
+	return f, nil
 }
@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -23,16 +24,38 @@ func TestReconcileJob(t *testing.T) {
 
 		state       v1.InternalJob_State
 		mutateJobFn func(job *batchv1.Job)
+		pod         *corev1.Pod
 
-		wantErr         bool
-		wantRequeue     bool
-		wantUpdatePhase v1.UpdateJobPhaseRequest_Phase
-		wantAssertJobFn func(t *testing.T, gotJob *batchv1.Job, err error)
+		wantErr          bool
+		wantRequeue      bool
+		wantUpdatePhase  v1.UpdateJobPhaseRequest_Phase
+		wantErrorCode    string
+		wantExitCode     int32
+		wantStatusReason string
+		wantAssertJobFn  func(t *testing.T, gotJob *batchv1.Job, err error)
 	}{
 		{
 			name:  "still running",
 			state: v1.InternalJob_RUNNING,
 		},
+		{
+			name:             "image pull failure",
+			state:            v1.InternalJob_RUNNING,
+			pod:              imagePullBackOffPod("test-job", time.Now().Add(-time.Minute)),
+			wantStatusReason: "ImagePullBackOff: Back-off pulling image",
+		},
+		{
+			name:             "image pull failure (grace period passed)",
+			state:            v1.InternalJob_RUNNING,
+			pod:              imagePullBackOffPod("test-job", time.Now().Add(-time.Hour)),
+			wantUpdatePhase:  v1.UpdateJobPhaseRequest_FAILED,
+			wantErrorCode:    failureCodeImagePull,
+			wantStatusReason: "ImagePullBackOff: Back-off pulling image",
+			wantAssertJobFn: func(t *testing.T, gotJob *batchv1.Job, err error) {
+				assert.NoError(t, err)
+				assert.True(t, *gotJob.Spec.Suspend)
+			},
+		},
 		{
 			name:  "job failed",
 			state: v1.InternalJob_RUNNING,
@@ -41,6 +64,27 @@ func TestReconcileJob(t *testing.T) {
 			},
 			wantUpdatePhase: v1.UpdateJobPhaseRequest_FAILED,
 		},
+		{
+			name:  "job failed with a user error",
+			state: v1.InternalJob_RUNNING,
+			mutateJobFn: func(job *batchv1.Job) {
+				job.Status.Failed = 1
+			},
+			pod:             terminatedJobPod("test-job", 3),
+			wantUpdatePhase: v1.UpdateJobPhaseRequest_FAILED,
+			wantErrorCode:   failureCodeUserError,
+			wantExitCode:    3,
+		},
+		{
+			name:  "job failed to upload the output model",
+			state: v1.InternalJob_RUNNING,
+			mutateJobFn: func(job *batchv1.Job) {
+				job.Status.Failed = 1
+			},
+			pod:             terminatedJobPod("test-job", uploadFailedExitCode),
+			wantUpdatePhase: v1.UpdateJobPhaseRequest_FAILED,
+			wantErrorCode:   failureCodeUploadFailed,
+		},
 		{
 			name:  "successfully completed",
 			state: v1.InternalJob_RUNNING,
@@ -107,7 +151,11 @@ func TestReconcileJob(t *testing.T) {
 			if test.mutateJobFn != nil {
 				test.mutateJobFn(job)
 			}
-			k8sClient := fake.NewFakeClient(job)
+			objs := []client.Object{job}
+			if test.pod != nil {
+				objs = append(objs, test.pod)
+			}
+			k8sClient := fake.NewClientBuilder().WithObjects(objs...).Build()
 			req := reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Name:      job.Name,
@@ -126,7 +174,7 @@ func TestReconcileJob(t *testing.T) {
 				updatedPhases: map[string]v1.UpdateJobPhaseRequest_Phase{},
 			}
 
-			mgr := NewLifecycleManager(wsClient, k8sClient, &NoopPostProcessor{})
+			mgr := NewLifecycleManager(wsClient, k8sClient, &NoopPostProcessor{}, testPodFailureConfig)
 			result, err := mgr.Reconcile(context.Background(), req)
 			assert.Equal(t, test.wantRequeue, result.Requeue, "requeue event")
 			if test.wantErr {
@@ -137,6 +185,9 @@ func TestReconcileJob(t *testing.T) {
 
 			gotPhase := wsClient.updatedPhases[job.Name]
 			assert.Equal(t, test.wantUpdatePhase, gotPhase)
+			assert.Equal(t, test.wantErrorCode, wsClient.errorCodes[job.Name])
+			assert.Equal(t, test.wantExitCode, wsClient.exitCodes[job.Name])
+			assert.Equal(t, test.wantStatusReason, wsClient.statusReasons[job.Name])
 
 			var gotJob batchv1.Job
 			err = k8sClient.Get(context.Background(), req.NamespacedName, &gotJob)
@@ -146,3 +197,25 @@ func TestReconcileJob(t *testing.T) {
 		})
 	}
 }
+
+// terminatedJobPod returns a failed pod of the k8s job whose container exited with the exit code.
+func terminatedJobPod(jobName string, exitCode int32) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName + "-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": jobName},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "main",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode},
+					},
+				},
+			},
+		},
+	}
+}
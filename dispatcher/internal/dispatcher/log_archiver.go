@@ -0,0 +1,188 @@
+package dispatcher
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/llmariner/job-manager/pkg/logarchive"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	logArchiverName = "log-archiver"
+
+	// logsArchivedAnnotationKey is set to the k8s jobs whose logs have been archived.
+	logsArchivedAnnotationKey = "llmariner/logs-archived"
+)
+
+// LogUploader uploads archived logs.
+type LogUploader interface {
+	Upload(ctx context.Context, r io.Reader, bucket, key string) error
+}
+
+// NewLogArchiver returns a new LogArchiver.
+func NewLogArchiver(
+	k8sClient client.Client,
+	clientset kubernetes.Interface,
+	uploader LogUploader,
+	bucket string,
+	config config.LogArchiveConfig,
+) *LogArchiver {
+	return &LogArchiver{
+		k8sClient: k8sClient,
+		clientset: clientset,
+		uploader:  uploader,
+		bucket:    bucket,
+		config:    config,
+	}
+}
+
+// LogArchiver archives the container logs of finished fine-tuning jobs and batch jobs in object storage so that
+// the logs are available after the jobs are garbage-collected.
+type LogArchiver struct {
+	k8sClient client.Client
+	// clientset is used to read the pod logs, which the controller-runtime client does not support.
+	clientset kubernetes.Interface
+	uploader  LogUploader
+	bucket    string
+	config    config.LogArchiveConfig
+}
+
+// SetupWithManager registers the LogArchiver with the manager.
+func (a *LogArchiver) SetupWithManager(mgr ctrl.Manager) error {
+	filter := predicate.NewPredicateFuncs(func(object client.Object) bool {
+		annos := object.GetAnnotations()
+		return (isManagedJob(annos) || isManagedBatchJob(annos)) && annos[logsArchivedAnnotationKey] != "true"
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("logarchiver").
+		For(&batchv1.Job{}, builder.WithPredicates(filter)).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger {
+			if r != nil {
+				return mgr.GetLogger().WithValues("job", r.NamespacedName)
+			}
+			return mgr.GetLogger()
+		}).
+		Complete(a)
+}
+
+// Reconcile archives the logs of the pods of a finished job.
+func (a *LogArchiver) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	var job batchv1.Job
+	if err := a.k8sClient.Get(ctx, req.NamespacedName, &job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get job")
+		return ctrl.Result{}, err
+	}
+	if job.Annotations[logsArchivedAnnotationKey] == "true" || !isJobFinished(&job) {
+		return ctrl.Result{}, nil
+	}
+
+	var kind, id string
+	switch {
+	case isManagedJob(job.Annotations):
+		kind, id = logarchive.KindFineTuningJob, job.Annotations[jobIDAnnotationKey]
+	case isManagedBatchJob(job.Annotations):
+		kind, id = logarchive.KindBatchJob, job.Annotations[batchJobIDAnnotationKey]
+	default:
+		return ctrl.Result{}, nil
+	}
+
+	var podList corev1.PodList
+	if err := a.k8sClient.List(ctx, &podList,
+		client.InNamespace(job.Namespace),
+		client.MatchingLabels{"job-name": job.Name},
+	); err != nil {
+		log.Error(err, "Failed to list pods")
+		return ctrl.Result{}, err
+	}
+	for _, pod := range podList.Items {
+		var containers []corev1.Container
+		containers = append(containers, pod.Spec.InitContainers...)
+		containers = append(containers, pod.Spec.Containers...)
+		for _, c := range containers {
+			if err := a.archiveContainerLog(ctx, kind, id, &pod, c.Name); err != nil {
+				log.Error(err, "Failed to archive the log", "pod", pod.Name, "container", c.Name)
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[logsArchivedAnnotationKey] = "true"
+	if err := a.k8sClient.Update(ctx, &job, client.FieldOwner(logArchiverName)); err != nil {
+		log.Error(err, "Failed to annotate the job")
+		return ctrl.Result{}, err
+	}
+	log.Info("Archived the logs", "pods", len(podList.Items))
+	return ctrl.Result{}, nil
+}
+
+// archiveContainerLog uploads the log of a container as gzip-compressed chunks. Concatenating the decompressed
+// chunks in the order of their indexes reproduces the log. A log that cannot be read (e.g., the node of the pod
+// is gone) is skipped as retrying does not help.
+func (a *LogArchiver) archiveContainerLog(ctx context.Context, kind, id string, pod *corev1.Pod, container string) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("pod", pod.Name, "container", container)
+
+	r, err := a.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Container: container}).Stream(ctx)
+	if err != nil {
+		log.Error(err, "Skipping the log that cannot be read")
+		return nil
+	}
+	defer func() { _ = r.Close() }()
+
+	for i := 0; ; i++ {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		n, err := io.CopyN(gw, r, a.config.ChunkSize)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("read log: %s", err)
+		}
+		eof := err != nil
+		if eof && n == 0 && i > 0 {
+			// The previous chunk ended at the end of the log.
+			return nil
+		}
+		if err := gw.Close(); err != nil {
+			return fmt.Errorf("compress log: %s", err)
+		}
+		key := logarchive.ChunkKey(a.config.PathPrefix, kind, id, pod.Name, container, i)
+		if err := a.uploader.Upload(ctx, bytes.NewReader(buf.Bytes()), a.bucket, key); err != nil {
+			return err
+		}
+		log.V(1).Info("Uploaded a log chunk", "key", key, "size", n)
+		if eof {
+			return nil
+		}
+	}
+}
+
+// isJobFinished returns true if the k8s job has completed or failed.
+func isJobFinished(job *batchv1.Job) bool {
+	for _, cond := range job.Status.Conditions {
+		if (cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed) && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package dispatcher
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestLogArchiver(t *testing.T) {
+	finished := batchv1.JobStatus{
+		Conditions: []batchv1.JobCondition{
+			{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			},
+		},
+	}
+	tcs := []struct {
+		name      string
+		job       *batchv1.Job
+		chunkSize int64
+		want      map[string]string
+	}{
+		{
+			name: "batch job",
+			job: &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "job0",
+					Namespace: "default",
+					Annotations: map[string]string{
+						batchJobManagedAnnotationKey: "true",
+						batchJobIDAnnotationKey:      "job0",
+					},
+				},
+				Status: finished,
+			},
+			chunkSize: 1024,
+			want: map[string]string{
+				"logs/batch-jobs/job0/job0-abcde/init/00000.log.gz": "fake logs",
+				"logs/batch-jobs/job0/job0-abcde/main/00000.log.gz": "fake logs",
+			},
+		},
+		{
+			name: "fine-tuning job with multiple chunks",
+			job: &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "job0",
+					Namespace: "default",
+					Annotations: map[string]string{
+						managedJobAnnotationKey: "true",
+						jobIDAnnotationKey:      "job0",
+					},
+				},
+				Status: finished,
+			},
+			chunkSize: 4,
+			want: map[string]string{
+				"logs/fine-tuning-jobs/job0/job0-abcde/init/00000.log.gz": "fake",
+				"logs/fine-tuning-jobs/job0/job0-abcde/init/00001.log.gz": " log",
+				"logs/fine-tuning-jobs/job0/job0-abcde/init/00002.log.gz": "s",
+				"logs/fine-tuning-jobs/job0/job0-abcde/main/00000.log.gz": "fake",
+				"logs/fine-tuning-jobs/job0/job0-abcde/main/00001.log.gz": " log",
+				"logs/fine-tuning-jobs/job0/job0-abcde/main/00002.log.gz": "s",
+			},
+		},
+		{
+			name: "running job",
+			job: &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "job0",
+					Namespace: "default",
+					Annotations: map[string]string{
+						batchJobManagedAnnotationKey: "true",
+						batchJobIDAnnotationKey:      "job0",
+					},
+				},
+			},
+			chunkSize: 1024,
+			want:      map[string]string{},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "job0-abcde",
+					Namespace: "default",
+					Labels:    map[string]string{"job-name": "job0"},
+				},
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init"}},
+					Containers:     []corev1.Container{{Name: "main"}},
+				},
+			}
+			k8sClient := fake.NewFakeClient(tc.job, pod)
+			uploader := &fakeLogUploader{uploaded: map[string]string{}}
+			a := NewLogArchiver(k8sClient, k8sfake.NewClientset(pod), uploader, "bucket", config.LogArchiveConfig{
+				Enable:     true,
+				PathPrefix: "logs",
+				ChunkSize:  tc.chunkSize,
+			})
+
+			key := types.NamespacedName{Name: "job0", Namespace: "default"}
+			_, err := a.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, uploader.uploaded)
+
+			var got batchv1.Job
+			err = k8sClient.Get(context.Background(), key, &got)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tc.want) > 0, got.Annotations[logsArchivedAnnotationKey] == "true")
+		})
+	}
+}
+
+type fakeLogUploader struct {
+	// uploaded is the decompressed data keyed by the object keys.
+	uploaded map[string]string
+}
+
+func (u *fakeLogUploader) Upload(ctx context.Context, r io.Reader, bucket, key string) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	b, err := io.ReadAll(gr)
+	if err != nil {
+		return err
+	}
+	u.uploaded[key] = string(b)
+	return nil
+}
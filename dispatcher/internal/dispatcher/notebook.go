@@ -8,6 +8,8 @@ import (
 	"github.com/go-logr/logr"
 	v1 "github.com/llmariner/job-manager/api/v1"
 	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"github.com/llmariner/job-manager/pkg/workload"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
@@ -125,6 +127,7 @@ func (n *NotebookManager) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 	reason := ""
 	state := v1.NotebookState_RUNNING
+	var nbErr *v1.Notebook_Error
 
 	// Try to extract a more specific reason from the Pod status
 	if len(podList.Items) > 0 {
@@ -138,11 +141,23 @@ func (n *NotebookManager) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 				break
 			}
 		}
+		// Report the failure of the pod (e.g., the container was OOM killed).
+		if f, ok := classifyPodFailure(&pod); ok {
+			nbErr = &v1.Notebook_Error{
+				Code:     f.code,
+				Message:  f.message,
+				ExitCode: f.exitCode,
+			}
+		}
 	}
 
 	if nb.Status.ReadyReplicas < replicas && reason == "" {
-		log.V(4).Info("Notebook deployment is not ready yet")
-		return ctrl.Result{}, nil
+		if nbErr == nil {
+			log.V(4).Info("Notebook deployment is not ready yet")
+			return ctrl.Result{}, nil
+		}
+		// Only report the error as the state does not change.
+		state = v1.NotebookState_STATE_UNSPECIFIED
 	}
 
 	ctx = auth.AppendWorkerAuthorization(ctx)
@@ -150,6 +165,7 @@ func (n *NotebookManager) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		Id:     req.Name,
 		State:  state,
 		Reason: reason,
+		Error:  nbErr,
 	}); err != nil {
 		log.Error(err, "Failed to update the notebook state")
 		return ctrl.Result{}, err
@@ -206,7 +222,7 @@ func (n *NotebookManager) createNotebook(ctx context.Context, nb *v1.InternalNot
 			}
 		}
 		if r.GpuCount > 0 {
-			limit["nvidia.com/gpu"] = *resource.NewQuantity(int64(r.GpuCount), resource.DecimalSI)
+			limit[corev1.ResourceName(accelerator.ResourceName(r.AcceleratorType, r.GpuProfile))] = *resource.NewQuantity(int64(r.GpuCount), resource.DecimalSI)
 		}
 	}
 	resources := corev1apply.ResourceRequirements()
@@ -274,6 +290,7 @@ func (n *NotebookManager) createNotebook(ctx context.Context, nb *v1.InternalNot
 	podSpec := corev1apply.PodSpec().
 		WithContainers(containerConf)
 	podSpec = applyWorkloadConfig(podSpec, n.workloadConfig)
+	podSpec = applyGPUNodeAffinity(podSpec, nb.Notebook.Resources.GetGpuType(), nb.Notebook.Resources.GetMinGpuMemoryMegabytes())
 
 	if n.enablePVC {
 		podSpec = podSpec.
@@ -291,6 +308,8 @@ func (n *NotebookManager) createNotebook(ctx context.Context, nb *v1.InternalNot
 	deployConf := appsv1apply.
 		Deployment(name, nb.Notebook.KubernetesNamespace).
 		WithLabels(labels).
+		// The workload labels are not added to the selector as the selector of an existing deployment cannot be changed.
+		WithLabels(workload.Labels(workload.KindNotebook, nb.Notebook.Id)).
 		WithAnnotations(map[string]string{
 			notebookManagedAnnotationKey: "true",
 			notebookIDAnnotationKey:      nb.Notebook.Id}).
@@ -343,7 +362,6 @@ func (n *NotebookManager) createNotebook(ctx context.Context, nb *v1.InternalNot
 	svcConf.WithOwnerReferences(ownerRef)
 
 	// Secret is pre-created by server, and dispatcher only set the owner reference here.
-	// TODO(aya): garbage collect orphaned secrets
 	secConf := corev1apply.Secret(nb.Notebook.Id, nb.Notebook.KubernetesNamespace).
 		WithOwnerReferences(ownerRef)
 
@@ -24,8 +24,10 @@ func TestReconcileNotebook(t *testing.T) {
 		mutateNotebookFn func(nb *appsv1.Deployment)
 		mutatePodFn      func(pod *corev1.Pod)
 
-		wantUpdate bool
-		wantState  v1.NotebookState
+		wantUpdate    bool
+		wantState     v1.NotebookState
+		wantErrorCode string
+		wantExitCode  int32
 	}{
 		{
 			name:  "notebook is ready",
@@ -91,6 +93,76 @@ func TestReconcileNotebook(t *testing.T) {
 			wantUpdate: true,
 			wantState:  v1.NotebookState_INITIALIZING,
 		},
+		{
+			name:  "notebook is crash-looping",
+			state: v1.NotebookState_RUNNING,
+			mutateNotebookFn: func(nb *appsv1.Deployment) {
+				nb.Spec.Replicas = ptr.To(int32(1))
+				nb.Status.ReadyReplicas = 0
+				nb.Spec.Selector = &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"app.kubernetes.io/instance": nb.Name,
+					},
+				}
+			},
+			mutatePodFn: func(pod *corev1.Pod) {
+				pod.Status.Phase = corev1.PodRunning
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "jupyterlab",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{
+								Reason: "CrashLoopBackOff",
+							},
+						},
+						LastTerminationState: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								Reason:   "OOMKilled",
+								ExitCode: 137,
+							},
+						},
+					},
+				}
+			},
+			wantUpdate:    true,
+			wantState:     v1.NotebookState_STATE_UNSPECIFIED,
+			wantErrorCode: failureCodeOOMKilled,
+		},
+		{
+			name:  "notebook exits with an error",
+			state: v1.NotebookState_RUNNING,
+			mutateNotebookFn: func(nb *appsv1.Deployment) {
+				nb.Spec.Replicas = ptr.To(int32(1))
+				nb.Status.ReadyReplicas = 0
+				nb.Spec.Selector = &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"app.kubernetes.io/instance": nb.Name,
+					},
+				}
+			},
+			mutatePodFn: func(pod *corev1.Pod) {
+				pod.Status.Phase = corev1.PodRunning
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "jupyterlab",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{
+								Reason: "CrashLoopBackOff",
+							},
+						},
+						LastTerminationState: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode: 2,
+							},
+						},
+					},
+				}
+			},
+			wantUpdate:    true,
+			wantState:     v1.NotebookState_STATE_UNSPECIFIED,
+			wantErrorCode: failureCodeUserError,
+			wantExitCode:  2,
+		},
 		{
 			name:  "notebook is not ready",
 			state: v1.NotebookState_INITIALIZING,
@@ -178,6 +250,8 @@ func TestReconcileNotebook(t *testing.T) {
 			gotState, ok := wsClient.updatedState[nb.Name]
 			assert.Equal(t, test.wantUpdate, ok)
 			assert.Equal(t, test.wantState, gotState)
+			assert.Equal(t, test.wantErrorCode, wsClient.updatedErrors[nb.Name].GetCode())
+			assert.Equal(t, test.wantExitCode, wsClient.updatedErrors[nb.Name].GetExitCode())
 		})
 	}
 }
@@ -0,0 +1,143 @@
+package dispatcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/llmariner/job-manager/pkg/workload"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type activeWorkloadLister interface {
+	ListActiveWorkloads(ctx context.Context, in *v1.ListActiveWorkloadsRequest, opts ...grpc.CallOption) (*v1.ListActiveWorkloadsResponse, error)
+}
+
+// NewOrphanCollector returns a new OrphanCollector.
+func NewOrphanCollector(lister activeWorkloadLister, config config.OrphanCollectionConfig) *OrphanCollector {
+	return &OrphanCollector{
+		lister: lister,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// OrphanCollector deletes the secrets and configmaps that the server creates for workloads when no workload owns
+// them. The dispatcher sets the owner references of the secrets and configmaps when it creates the workloads,
+// but they are left behind if the workloads are canceled, failed, or moved to another cluster before that.
+type OrphanCollector struct {
+	k8sClient client.Client
+	// reader reads secrets and configmaps from the API server so that all of them are not cached.
+	reader client.Reader
+	lister activeWorkloadLister
+	config config.OrphanCollectionConfig
+	logger logr.Logger
+
+	now func() time.Time
+}
+
+// SetupWithManager registers the OrphanCollector with the manager.
+func (c *OrphanCollector) SetupWithManager(mgr ctrl.Manager) error {
+	c.k8sClient = mgr.GetClient()
+	c.reader = mgr.GetAPIReader()
+	c.logger = mgr.GetLogger().WithName("orphancollector")
+	return mgr.Add(c)
+}
+
+// NeedLeaderElection implements LeaderElectionRunnable and always returns true.
+func (c *OrphanCollector) NeedLeaderElection() bool {
+	return true
+}
+
+// Start starts the OrphanCollector.
+func (c *OrphanCollector) Start(ctx context.Context) error {
+	ctx = ctrl.LoggerInto(ctx, c.logger)
+
+	tick := time.NewTicker(c.config.Interval)
+	defer tick.Stop()
+	for {
+		if err := c.collect(ctx); err != nil {
+			c.logger.Error(err, "Failed to collect orphaned secrets and configmaps")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick.C:
+		}
+	}
+}
+
+// collect deletes the orphaned secrets and configmaps whose workloads are no longer active in the cluster.
+func (c *OrphanCollector) collect(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	resp, err := c.lister.ListActiveWorkloads(auth.AppendWorkerAuthorization(ctx), &v1.ListActiveWorkloadsRequest{})
+	if err != nil {
+		return err
+	}
+	active := map[string]bool{}
+	for _, w := range resp.Workloads {
+		switch w.WorkloadType {
+		case v1.DispatchAction_NOTEBOOK:
+			active[workload.KindNotebook+"/"+w.Id] = true
+		case v1.DispatchAction_BATCH_JOB:
+			active[workload.KindBatchJob+"/"+w.Id] = true
+		}
+	}
+
+	var objs []client.Object
+	var secrets corev1.SecretList
+	if err := c.reader.List(ctx, &secrets, client.HasLabels{workload.KindLabelKey, workload.IDLabelKey}); err != nil {
+		return err
+	}
+	for i := range secrets.Items {
+		objs = append(objs, &secrets.Items[i])
+	}
+	var cms corev1.ConfigMapList
+	if err := c.reader.List(ctx, &cms, client.HasLabels{workload.KindLabelKey, workload.IDLabelKey}); err != nil {
+		return err
+	}
+	for i := range cms.Items {
+		objs = append(objs, &cms.Items[i])
+	}
+
+	for _, obj := range objs {
+		if len(obj.GetOwnerReferences()) > 0 {
+			continue
+		}
+		if c.now().Sub(obj.GetCreationTimestamp().Time) < c.config.GracePeriod {
+			continue
+		}
+		labels := obj.GetLabels()
+		if active[labels[workload.KindLabelKey]+"/"+labels[workload.IDLabelKey]] {
+			continue
+		}
+
+		kind := "Secret"
+		if _, ok := obj.(*corev1.ConfigMap); ok {
+			kind = "ConfigMap"
+		}
+		log := log.WithValues("kind", kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+		// Do not delete the object if it has been updated (e.g., the owner reference has been set) since it was listed.
+		if err := c.k8sClient.Delete(ctx, obj, client.Preconditions{
+			UID:             ptr.To(obj.GetUID()),
+			ResourceVersion: ptr.To(obj.GetResourceVersion()),
+		}); err != nil {
+			if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+				continue
+			}
+			return err
+		}
+		log.Info("Deleted an orphaned object", "workloadKind", labels[workload.KindLabelKey], "workloadID", labels[workload.IDLabelKey])
+	}
+	return nil
+}
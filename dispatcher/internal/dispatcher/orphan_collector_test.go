@@ -0,0 +1,109 @@
+package dispatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/llmariner/job-manager/pkg/workload"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestOrphanCollector(t *testing.T) {
+	now := time.Now()
+	old := metav1.NewTime(now.Add(-time.Hour))
+	objMeta := func(name, kind string, created metav1.Time) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: created,
+			Labels:            workload.Labels(kind, name),
+		}
+	}
+
+	owned := objMeta("bjob1", workload.KindBatchJob, old)
+	owned.OwnerReferences = []metav1.OwnerReference{{Kind: "Job", Name: "bjob1"}}
+	unlabeled := objMeta("bjob5", workload.KindBatchJob, old)
+	unlabeled.Labels = nil
+
+	objs := []client.Object{
+		// An active batch job.
+		&corev1.Secret{ObjectMeta: objMeta("bjob0", workload.KindBatchJob, old)},
+		&corev1.ConfigMap{ObjectMeta: objMeta("bjob0", workload.KindBatchJob, old)},
+		// An owned batch job.
+		&corev1.Secret{ObjectMeta: owned},
+		// A recently created batch job.
+		&corev1.Secret{ObjectMeta: objMeta("bjob2", workload.KindBatchJob, metav1.NewTime(now))},
+		// An inactive batch job. The active notebook with the same ID does not own it.
+		&corev1.Secret{ObjectMeta: objMeta("bjob3", workload.KindBatchJob, old)},
+		&corev1.ConfigMap{ObjectMeta: objMeta("bjob3", workload.KindBatchJob, old)},
+		// An active notebook.
+		&corev1.Secret{ObjectMeta: objMeta("nb0", workload.KindNotebook, old)},
+		// An inactive notebook.
+		&corev1.Secret{ObjectMeta: objMeta("nb1", workload.KindNotebook, old)},
+		// A secret not created for workloads.
+		&corev1.Secret{ObjectMeta: unlabeled},
+	}
+	k8sClient := fake.NewClientBuilder().WithObjects(objs...).Build()
+
+	lister := &fakeActiveWorkloadLister{
+		resp: &v1.ListActiveWorkloadsResponse{
+			Workloads: []*v1.ListActiveWorkloadsResponse_Workload{
+				{WorkloadType: v1.DispatchAction_BATCH_JOB, Id: "bjob0"},
+				{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "nb0"},
+				{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "bjob3"},
+			},
+		},
+	}
+	c := NewOrphanCollector(lister, config.OrphanCollectionConfig{
+		Enable:      true,
+		Interval:    time.Minute,
+		GracePeriod: 10 * time.Minute,
+	})
+	c.k8sClient = k8sClient
+	c.reader = k8sClient
+	c.now = func() time.Time { return now }
+
+	ctx := ctrl.LoggerInto(context.Background(), testr.New(t))
+	err := c.collect(ctx)
+	assert.NoError(t, err)
+
+	tcs := []struct {
+		obj         client.Object
+		wantDeleted bool
+	}{
+		{obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bjob0"}}, wantDeleted: false},
+		{obj: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bjob0"}}, wantDeleted: false},
+		{obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bjob1"}}, wantDeleted: false},
+		{obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bjob2"}}, wantDeleted: false},
+		{obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bjob3"}}, wantDeleted: true},
+		{obj: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bjob3"}}, wantDeleted: true},
+		{obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "nb0"}}, wantDeleted: false},
+		{obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "nb1"}}, wantDeleted: true},
+		{obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bjob5"}}, wantDeleted: false},
+	}
+	for _, tc := range tcs {
+		key := types.NamespacedName{Name: tc.obj.GetName(), Namespace: "default"}
+		err := k8sClient.Get(ctx, key, tc.obj)
+		assert.Equal(t, tc.wantDeleted, apierrors.IsNotFound(err), "%T %s", tc.obj, key)
+	}
+}
+
+type fakeActiveWorkloadLister struct {
+	resp *v1.ListActiveWorkloadsResponse
+}
+
+func (l *fakeActiveWorkloadLister) ListActiveWorkloads(ctx context.Context, in *v1.ListActiveWorkloadsRequest, opts ...grpc.CallOption) (*v1.ListActiveWorkloadsResponse, error) {
+	return l.resp, nil
+}
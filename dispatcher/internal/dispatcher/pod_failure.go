@@ -0,0 +1,188 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// podFailureWaitingReasons are the waiting reasons of containers that fail to start. Kubernetes does not propagate
+// these failures to the Job, so pods keep retrying until the failures are resolved.
+var podFailureWaitingReasons = map[string]bool{
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+	"CrashLoopBackOff":           true,
+}
+
+// podFailure is a failure of a pod to start.
+type podFailure struct {
+	// reason is formatted as "<reason>: <message>".
+	reason string
+	// code is the failure code that classifies the failure.
+	code string
+	// since is the time when the failure started.
+	since time.Time
+}
+
+// podFailureResult is the result of checking the pods of a k8s job.
+type podFailureResult struct {
+	// reason is the reason of the earliest failure of the pods. It is empty if no pod is failing.
+	reason string
+	// code is the failure code of the earliest failure of the pods.
+	code string
+	// fail is true if the failure lasts longer than the grace period.
+	fail bool
+	// requeueAfter is the duration after which the pods should be checked again. It is zero if no check is needed.
+	requeueAfter time.Duration
+}
+
+// podFailureChecker detects the pods of k8s jobs that fail to start.
+type podFailureChecker struct {
+	k8sClient client.Client
+	config    config.PodFailureConfig
+	now       func() time.Time
+}
+
+func newPodFailureChecker(k8sClient client.Client, config config.PodFailureConfig) *podFailureChecker {
+	return &podFailureChecker{
+		k8sClient: k8sClient,
+		config:    config,
+		now:       time.Now,
+	}
+}
+
+// check checks the pods of the k8s job.
+func (c *podFailureChecker) check(ctx context.Context, job *batchv1.Job) (podFailureResult, error) {
+	var podList corev1.PodList
+	if err := c.k8sClient.List(ctx, &podList,
+		client.InNamespace(job.Namespace),
+		client.MatchingLabels{"job-name": job.Name},
+	); err != nil {
+		return podFailureResult{}, fmt.Errorf("list pods: %s", err)
+	}
+
+	now := c.now()
+	var (
+		result  podFailureResult
+		failure *podFailure
+	)
+	for _, pod := range podList.Items {
+		if !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		f, requeueAfter := c.podFailure(&pod, now)
+		result.requeueAfter = minRequeueAfter(result.requeueAfter, requeueAfter)
+		if f != nil && (failure == nil || f.since.Before(failure.since)) {
+			failure = f
+		}
+	}
+	if failure == nil {
+		return result, nil
+	}
+
+	result.reason = failure.reason
+	result.code = failure.code
+	if c.config.FailureGracePeriod == 0 {
+		return result, nil
+	}
+	if elapsed := now.Sub(failure.since); elapsed < c.config.FailureGracePeriod {
+		result.requeueAfter = minRequeueAfter(result.requeueAfter, c.config.FailureGracePeriod-elapsed)
+	} else {
+		result.fail = true
+	}
+	ctrl.LoggerFrom(ctx).V(1).Info("Detected a pod failure", "reason", result.reason, "since", failure.since, "fail", result.fail)
+	return result, nil
+}
+
+// podFailure returns the failure of the pod. If the pod is unschedulable but the unschedulable threshold has not
+// passed yet, it returns the duration until the threshold passes.
+func (c *podFailureChecker) podFailure(pod *corev1.Pod, now time.Time) (*podFailure, time.Duration) {
+	var statuses []corev1.ContainerStatus
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, s := range statuses {
+		w := s.State.Waiting
+		if w == nil || !podFailureWaitingReasons[w.Reason] {
+			continue
+		}
+		f, _ := classifyContainerFailure(s)
+		return &podFailure{
+			reason: formatPodFailureReason(w.Reason, w.Message),
+			code:   f.code,
+			since:  podNotReadySince(pod),
+		}, 0
+	}
+
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type != corev1.PodScheduled || cond.Status != corev1.ConditionFalse || cond.Reason != corev1.PodReasonUnschedulable {
+			continue
+		}
+		since := cond.LastTransitionTime.Add(c.config.UnschedulableThreshold)
+		if now.Before(since) {
+			return nil, since.Sub(now)
+		}
+		return &podFailure{
+			reason: formatPodFailureReason(cond.Reason, cond.Message),
+			since:  since,
+		}, 0
+	}
+	return nil, 0
+}
+
+// podNotReadySince returns the time when the pod became not ready.
+func podNotReadySince(pod *corev1.Pod) time.Time {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionFalse && !cond.LastTransitionTime.IsZero() {
+			return cond.LastTransitionTime.Time
+		}
+	}
+	if t := pod.Status.StartTime; t != nil {
+		return t.Time
+	}
+	return pod.CreationTimestamp.Time
+}
+
+func formatPodFailureReason(reason, message string) string {
+	if message == "" {
+		return reason
+	}
+	return fmt.Sprintf("%s: %s", reason, message)
+}
+
+func minRequeueAfter(a, b time.Duration) time.Duration {
+	if a == 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// enqueueJobOfPod returns an event handler that enqueues the k8s job of a pod if the job is managed.
+func enqueueJobOfPod(k8sClient client.Client, isManaged func(annotations map[string]string) bool) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		name, ok := obj.GetLabels()["job-name"]
+		if !ok {
+			return nil
+		}
+		key := types.NamespacedName{Name: name, Namespace: obj.GetNamespace()}
+		var job batchv1.Job
+		if err := k8sClient.Get(ctx, key, &job); err != nil {
+			return nil
+		}
+		if !isManaged(job.Annotations) {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: key}}
+	})
+}
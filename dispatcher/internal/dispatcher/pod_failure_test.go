@@ -0,0 +1,165 @@
+package dispatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var testPodFailureConfig = config.PodFailureConfig{
+	UnschedulableThreshold: 10 * time.Minute,
+	FailureGracePeriod:     15 * time.Minute,
+}
+
+func imagePullBackOffPod(jobName string, notReadySince time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName + "-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": jobName},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionFalse,
+					LastTransitionTime: metav1.NewTime(notReadySince),
+				},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "main",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason:  "ImagePullBackOff",
+							Message: "Back-off pulling image",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func unschedulablePod(jobName string, since time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName + "-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": jobName},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodScheduled,
+					Status:             corev1.ConditionFalse,
+					Reason:             corev1.PodReasonUnschedulable,
+					Message:            "0/3 nodes are available",
+					LastTransitionTime: metav1.NewTime(since),
+				},
+			},
+		},
+	}
+}
+
+func TestPodFailureCheck(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	tcs := []struct {
+		name string
+		pods []client.Object
+		want podFailureResult
+	}{
+		{
+			name: "no pod",
+		},
+		{
+			name: "image pull failure",
+			pods: []client.Object{imagePullBackOffPod("job0", now.Add(-5*time.Minute))},
+			want: podFailureResult{
+				reason:       "ImagePullBackOff: Back-off pulling image",
+				code:         failureCodeImagePull,
+				requeueAfter: 10 * time.Minute,
+			},
+		},
+		{
+			name: "image pull failure past the grace period",
+			pods: []client.Object{imagePullBackOffPod("job0", now.Add(-15*time.Minute))},
+			want: podFailureResult{
+				reason: "ImagePullBackOff: Back-off pulling image",
+				code:   failureCodeImagePull,
+				fail:   true,
+			},
+		},
+		{
+			name: "pod of another job",
+			pods: []client.Object{imagePullBackOffPod("job1", now.Add(-15*time.Minute))},
+		},
+		{
+			name: "unschedulable within the threshold",
+			pods: []client.Object{unschedulablePod("job0", now.Add(-4*time.Minute))},
+			want: podFailureResult{
+				requeueAfter: 6 * time.Minute,
+			},
+		},
+		{
+			name: "unschedulable past the threshold",
+			pods: []client.Object{unschedulablePod("job0", now.Add(-20*time.Minute))},
+			want: podFailureResult{
+				reason:       "Unschedulable: 0/3 nodes are available",
+				requeueAfter: 5 * time.Minute,
+			},
+		},
+		{
+			name: "unschedulable past the grace period",
+			pods: []client.Object{unschedulablePod("job0", now.Add(-25*time.Minute))},
+			want: podFailureResult{
+				reason: "Unschedulable: 0/3 nodes are available",
+				fail:   true,
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newPodFailureChecker(fake.NewClientBuilder().WithObjects(tc.pods...).Build(), testPodFailureConfig)
+			c.now = func() time.Time { return now }
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "job0",
+					Namespace: "default",
+				},
+			}
+			got, err := c.check(context.Background(), job)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPodFailureCheck_NoGracePeriod(t *testing.T) {
+	now := time.Now()
+	c := newPodFailureChecker(
+		fake.NewFakeClient(imagePullBackOffPod("job0", now.Add(-time.Hour))),
+		config.PodFailureConfig{UnschedulableThreshold: time.Minute},
+	)
+	c.now = func() time.Time { return now }
+	got, err := c.check(context.Background(), &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "job0",
+			Namespace: "default",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, podFailureResult{
+		reason: "ImagePullBackOff: Back-off pulling image",
+		code:   failureCodeImagePull,
+	}, got)
+}
@@ -1,7 +1,10 @@
 package dispatcher
 
 import (
+	"strconv"
+
 	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/llmariner/job-manager/pkg/accelerator"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1apply "k8s.io/client-go/applyconfigurations/core/v1"
@@ -40,6 +43,52 @@ func applyWorkloadConfig(
 	return podSpec
 }
 
+// applyGPUNodeAffinity adds a required node affinity that selects the nodes with the requested GPU model.
+// The GPU model is identified by the node labels set by NVIDIA GPU Feature Discovery. As node selector terms
+// are ORed, the requirements are added to every existing term.
+func applyGPUNodeAffinity(
+	podSpec *corev1apply.PodSpecApplyConfiguration,
+	gpuType string,
+	minGPUMemoryMegabytes int32,
+) *corev1apply.PodSpecApplyConfiguration {
+	var reqs []*corev1apply.NodeSelectorRequirementApplyConfiguration
+	if gpuType != "" {
+		reqs = append(reqs, corev1apply.NodeSelectorRequirement().
+			WithKey(accelerator.NvidiaGPUProductLabelKey).
+			WithOperator(corev1.NodeSelectorOpIn).
+			WithValues(gpuType))
+	}
+	if minGPUMemoryMegabytes > 0 {
+		// Use "Gt" with the value minus one as there is no "Ge" operator.
+		reqs = append(reqs, corev1apply.NodeSelectorRequirement().
+			WithKey(accelerator.NvidiaGPUMemoryLabelKey).
+			WithOperator(corev1.NodeSelectorOpGt).
+			WithValues(strconv.Itoa(int(minGPUMemoryMegabytes)-1)))
+	}
+	if len(reqs) == 0 {
+		return podSpec
+	}
+
+	if podSpec.Affinity == nil {
+		podSpec = podSpec.WithAffinity(corev1apply.Affinity())
+	}
+	if podSpec.Affinity.NodeAffinity == nil {
+		podSpec.Affinity.WithNodeAffinity(corev1apply.NodeAffinity())
+	}
+	na := podSpec.Affinity.NodeAffinity
+	if na.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		na.WithRequiredDuringSchedulingIgnoredDuringExecution(corev1apply.NodeSelector())
+	}
+	ns := na.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(ns.NodeSelectorTerms) == 0 {
+		ns.WithNodeSelectorTerms(corev1apply.NodeSelectorTerm())
+	}
+	for i := range ns.NodeSelectorTerms {
+		ns.NodeSelectorTerms[i].WithMatchExpressions(reqs...)
+	}
+	return podSpec
+}
+
 func buildAffinityApplyConfig(affinity *corev1.Affinity) *corev1apply.AffinityApplyConfiguration {
 	nslrAC := func(nslr corev1.NodeSelectorRequirement) *corev1apply.NodeSelectorRequirementApplyConfiguration {
 		ac := corev1apply.NodeSelectorRequirement()
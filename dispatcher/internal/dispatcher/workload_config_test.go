@@ -89,3 +89,85 @@ func TestBuildAffinityApplyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyGPUNodeAffinity(t *testing.T) {
+	gpuReqs := []*corev1apply.NodeSelectorRequirementApplyConfiguration{
+		corev1apply.NodeSelectorRequirement().
+			WithKey("nvidia.com/gpu.product").
+			WithOperator(corev1.NodeSelectorOpIn).
+			WithValues("NVIDIA-H100-80GB-HBM3"),
+		corev1apply.NodeSelectorRequirement().
+			WithKey("nvidia.com/gpu.memory").
+			WithOperator(corev1.NodeSelectorOpGt).
+			WithValues("81919"),
+	}
+
+	var tests = []struct {
+		name    string
+		podSpec *corev1apply.PodSpecApplyConfiguration
+		gpuType string
+		minMem  int32
+		want    *corev1apply.PodSpecApplyConfiguration
+	}{
+		{
+			name:    "no gpu model",
+			podSpec: corev1apply.PodSpec(),
+			want:    corev1apply.PodSpec(),
+		},
+		{
+			name:    "no existing affinity",
+			podSpec: corev1apply.PodSpec(),
+			gpuType: "NVIDIA-H100-80GB-HBM3",
+			minMem:  81920,
+			want: corev1apply.PodSpec().
+				WithAffinity(corev1apply.Affinity().
+					WithNodeAffinity(corev1apply.NodeAffinity().
+						WithRequiredDuringSchedulingIgnoredDuringExecution(corev1apply.NodeSelector().
+							WithNodeSelectorTerms(corev1apply.NodeSelectorTerm().
+								WithMatchExpressions(gpuReqs...))))),
+		},
+		{
+			name: "existing node selector terms",
+			podSpec: corev1apply.PodSpec().
+				WithAffinity(corev1apply.Affinity().
+					WithNodeAffinity(corev1apply.NodeAffinity().
+						WithRequiredDuringSchedulingIgnoredDuringExecution(corev1apply.NodeSelector().
+							WithNodeSelectorTerms(
+								corev1apply.NodeSelectorTerm().
+									WithMatchExpressions(corev1apply.NodeSelectorRequirement().
+										WithKey("zone").
+										WithOperator(corev1.NodeSelectorOpIn).
+										WithValues("a")),
+								corev1apply.NodeSelectorTerm().
+									WithMatchExpressions(corev1apply.NodeSelectorRequirement().
+										WithKey("zone").
+										WithOperator(corev1.NodeSelectorOpIn).
+										WithValues("b")))))),
+			gpuType: "NVIDIA-H100-80GB-HBM3",
+			minMem:  81920,
+			want: corev1apply.PodSpec().
+				WithAffinity(corev1apply.Affinity().
+					WithNodeAffinity(corev1apply.NodeAffinity().
+						WithRequiredDuringSchedulingIgnoredDuringExecution(corev1apply.NodeSelector().
+							WithNodeSelectorTerms(
+								corev1apply.NodeSelectorTerm().
+									WithMatchExpressions(corev1apply.NodeSelectorRequirement().
+										WithKey("zone").
+										WithOperator(corev1.NodeSelectorOpIn).
+										WithValues("a")).
+									WithMatchExpressions(gpuReqs...),
+								corev1apply.NodeSelectorTerm().
+									WithMatchExpressions(corev1apply.NodeSelectorRequirement().
+										WithKey("zone").
+										WithOperator(corev1.NodeSelectorOpIn).
+										WithValues("b")).
+									WithMatchExpressions(gpuReqs...))))),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := applyGPUNodeAffinity(test.podSpec, test.gpuType, test.minMem)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -0,0 +1,364 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/llmariner/job-manager/pkg/workload"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconciliationPageSize is the maximum number of k8s jobs or deployments listed by a request.
+const reconciliationPageSize = 500
+
+type jobPhaseUpdater interface {
+	UpdateJobPhase(ctx context.Context, in *v1.UpdateJobPhaseRequest, opts ...grpc.CallOption) (*v1.UpdateJobPhaseResponse, error)
+}
+
+type batchJobStateUpdater interface {
+	GetInternalBatchJob(ctx context.Context, in *v1.GetInternalBatchJobRequest, opts ...grpc.CallOption) (*v1.InternalBatchJob, error)
+	UpdateBatchJobState(ctx context.Context, in *v1.UpdateBatchJobStateRequest, opts ...grpc.CallOption) (*v1.UpdateBatchJobStateResponse, error)
+}
+
+type notebookStateUpdater interface {
+	UpdateNotebookState(ctx context.Context, in *v1.UpdateNotebookStateRequest, opts ...grpc.CallOption) (*v1.UpdateNotebookStateResponse, error)
+}
+
+// NewWorkloadReconciler returns a new WorkloadReconciler.
+func NewWorkloadReconciler(
+	lister activeWorkloadLister,
+	ftClient jobPhaseUpdater,
+	bwClient batchJobStateUpdater,
+	wsClient notebookStateUpdater,
+	config config.ReconciliationConfig,
+) *WorkloadReconciler {
+	return &WorkloadReconciler{
+		lister:   lister,
+		ftClient: ftClient,
+		bwClient: bwClient,
+		wsClient: wsClient,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+// WorkloadReconciler periodically compares the workloads that the server assigns to the cluster with the k8s jobs
+// and deployments in the cluster, and corrects the drift that the controllers miss (e.g., a job deleted while the
+// dispatcher is down).
+//
+// The drift is corrected with the following rules:
+//   - A running fine-tuning job without its k8s job is requeued to recreate the k8s job.
+//   - A running batch job without its k8s job is failed. The k8s job cannot be recreated as the secret and
+//     the configmap of the batch job are deleted with the k8s job.
+//   - An initializing or running notebook without its deployment is requeued to be rescheduled.
+//   - A k8s job or a deployment whose workload is no longer active in the cluster is deleted. Finished and
+//     suspended k8s jobs are kept as they are deleted after their TTL.
+//
+// Only the k8s jobs and deployments with the workload labels are listed. The ones created before the labels were
+// added are not deleted, but they are looked up by name so that their workloads are not corrected by mistake.
+type WorkloadReconciler struct {
+	k8sClient client.Client
+	// reader reads jobs and deployments from the API server so that a stale cache does not cause a false drift.
+	reader   client.Reader
+	lister   activeWorkloadLister
+	ftClient jobPhaseUpdater
+	bwClient batchJobStateUpdater
+	wsClient notebookStateUpdater
+	config   config.ReconciliationConfig
+	logger   logr.Logger
+
+	now func() time.Time
+}
+
+// workloadKey identifies a workload.
+type workloadKey struct {
+	workloadType v1.DispatchAction_WorkloadType
+	id           string
+}
+
+// reconciliationReport is the corrections made by a reconciliation.
+type reconciliationReport struct {
+	recreatedJobs      []string
+	failedBatchJobs    []string
+	requeuedNotebooks  []string
+	deletedJobs        []string
+	deletedDeployments []string
+}
+
+func (r *reconciliationReport) empty() bool {
+	return len(r.recreatedJobs) == 0 &&
+		len(r.failedBatchJobs) == 0 &&
+		len(r.requeuedNotebooks) == 0 &&
+		len(r.deletedJobs) == 0 &&
+		len(r.deletedDeployments) == 0
+}
+
+// SetupWithManager registers the WorkloadReconciler with the manager.
+func (r *WorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.k8sClient = mgr.GetClient()
+	r.reader = mgr.GetAPIReader()
+	r.logger = mgr.GetLogger().WithName("workloadreconciler")
+	return mgr.Add(r)
+}
+
+// NeedLeaderElection implements LeaderElectionRunnable and always returns true.
+func (r *WorkloadReconciler) NeedLeaderElection() bool {
+	return true
+}
+
+// Start starts the WorkloadReconciler.
+func (r *WorkloadReconciler) Start(ctx context.Context) error {
+	ctx = ctrl.LoggerInto(ctx, r.logger)
+
+	tick := time.NewTicker(r.config.Interval)
+	defer tick.Stop()
+	for {
+		report, err := r.reconcile(ctx)
+		if err != nil {
+			r.logger.Error(err, "Failed to reconcile workloads")
+		} else if !report.empty() {
+			r.logger.Info("Corrected the drift of workloads",
+				"recreatedJobs", report.recreatedJobs,
+				"failedBatchJobs", report.failedBatchJobs,
+				"requeuedNotebooks", report.requeuedNotebooks,
+				"deletedJobs", report.deletedJobs,
+				"deletedDeployments", report.deletedDeployments,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick.C:
+		}
+	}
+}
+
+// reconcile corrects the drift between the active workloads of the cluster and the k8s jobs and deployments.
+// A correction that fails is logged and retried at the next reconciliation.
+func (r *WorkloadReconciler) reconcile(ctx context.Context) (*reconciliationReport, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ctx = auth.AppendWorkerAuthorization(ctx)
+
+	resp, err := r.lister.ListActiveWorkloads(ctx, &v1.ListActiveWorkloadsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	active := map[workloadKey]*v1.ListActiveWorkloadsResponse_Workload{}
+	for _, w := range resp.Workloads {
+		active[workloadKey{workloadType: w.WorkloadType, id: w.Id}] = w
+	}
+
+	var jobs []batchv1.Job
+	if err := r.listPages(ctx, func() client.ObjectList { return &batchv1.JobList{} }, func(l client.ObjectList) {
+		jobs = append(jobs, l.(*batchv1.JobList).Items...)
+	}); err != nil {
+		return nil, err
+	}
+	var deploys []appsv1.Deployment
+	if err := r.listPages(ctx, func() client.ObjectList { return &appsv1.DeploymentList{} }, func(l client.ObjectList) {
+		deploys = append(deploys, l.(*appsv1.DeploymentList).Items...)
+	}); err != nil {
+		return nil, err
+	}
+
+	report := &reconciliationReport{}
+	existing := map[workloadKey]bool{}
+	for i := range jobs {
+		job := &jobs[i]
+		var key workloadKey
+		switch {
+		case isManagedJob(job.Annotations):
+			key = workloadKey{workloadType: v1.DispatchAction_JOB, id: job.Annotations[jobIDAnnotationKey]}
+		case isManagedBatchJob(job.Annotations):
+			key = workloadKey{workloadType: v1.DispatchAction_BATCH_JOB, id: job.Annotations[batchJobIDAnnotationKey]}
+		default:
+			continue
+		}
+		existing[key] = true
+
+		if _, ok := active[key]; ok || !r.isDeletable(job) || isJobFinished(job) || ptr.Deref(job.Spec.Suspend, false) {
+			continue
+		}
+		if deleted, err := r.deleteObject(ctx, job); err != nil {
+			log.Error(err, "Failed to delete the orphaned job", "name", job.Name, "namespace", job.Namespace)
+		} else if deleted {
+			report.deletedJobs = append(report.deletedJobs, job.Namespace+"/"+job.Name)
+		}
+	}
+	for i := range deploys {
+		deploy := &deploys[i]
+		if !isManagedNotebook(deploy.Annotations) {
+			continue
+		}
+		key := workloadKey{workloadType: v1.DispatchAction_NOTEBOOK, id: deploy.Annotations[notebookIDAnnotationKey]}
+		existing[key] = true
+
+		if _, ok := active[key]; ok || !r.isDeletable(deploy) {
+			continue
+		}
+		if deleted, err := r.deleteObject(ctx, deploy); err != nil {
+			log.Error(err, "Failed to delete the orphaned deployment", "name", deploy.Name, "namespace", deploy.Namespace)
+		} else if deleted {
+			report.deletedDeployments = append(report.deletedDeployments, deploy.Namespace+"/"+deploy.Name)
+		}
+	}
+
+	for _, w := range resp.Workloads {
+		if !w.Running || existing[workloadKey{workloadType: w.WorkloadType, id: w.Id}] {
+			continue
+		}
+		log := log.WithValues("workloadType", w.WorkloadType, "id", w.Id)
+		if found, err := r.hasUnlabeledResource(ctx, w); err != nil {
+			log.Error(err, "Failed to get the k8s resource of the workload")
+			continue
+		} else if found {
+			continue
+		}
+		corrected, err := r.correctMissingWorkload(ctx, w)
+		if err != nil {
+			log.Error(err, "Failed to correct the workload without its k8s resources")
+			continue
+		}
+		if !corrected {
+			continue
+		}
+		switch w.WorkloadType {
+		case v1.DispatchAction_JOB:
+			report.recreatedJobs = append(report.recreatedJobs, w.Id)
+		case v1.DispatchAction_BATCH_JOB:
+			report.failedBatchJobs = append(report.failedBatchJobs, w.Id)
+		case v1.DispatchAction_NOTEBOOK:
+			report.requeuedNotebooks = append(report.requeuedNotebooks, w.Id)
+		}
+	}
+	return report, nil
+}
+
+// listPages lists the objects with the workload labels page by page and calls fn for each page. A new list
+// is created for each page so that the objects of the previous pages are not overwritten.
+func (r *WorkloadReconciler) listPages(ctx context.Context, newList func() client.ObjectList, fn func(client.ObjectList)) error {
+	var cont string
+	for {
+		list := newList()
+		if err := r.reader.List(ctx, list,
+			client.HasLabels{workload.KindLabelKey, workload.IDLabelKey},
+			client.Limit(reconciliationPageSize),
+			client.Continue(cont),
+		); err != nil {
+			return err
+		}
+		fn(list)
+		if cont = list.GetContinue(); cont == "" {
+			return nil
+		}
+	}
+}
+
+// hasUnlabeledResource returns true if the k8s resource of the workload exists without the workload labels.
+// The name of the resource is the ID of the workload.
+func (r *WorkloadReconciler) hasUnlabeledResource(ctx context.Context, w *v1.ListActiveWorkloadsResponse_Workload) (bool, error) {
+	if w.KubernetesNamespace == "" {
+		// The server does not report the namespace. Assume that the resource exists as it cannot be looked up.
+		return true, nil
+	}
+	var obj client.Object
+	switch w.WorkloadType {
+	case v1.DispatchAction_JOB, v1.DispatchAction_BATCH_JOB:
+		obj = &batchv1.Job{}
+	case v1.DispatchAction_NOTEBOOK:
+		obj = &appsv1.Deployment{}
+	default:
+		return false, fmt.Errorf("unknown workload type: %s", w.WorkloadType)
+	}
+	if err := r.reader.Get(ctx, client.ObjectKey{Namespace: w.KubernetesNamespace, Name: w.Id}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// correctMissingWorkload corrects a running workload whose k8s resources do not exist. It returns false if
+// the workload is no longer running (e.g., it has been canceled since it was listed).
+func (r *WorkloadReconciler) correctMissingWorkload(ctx context.Context, w *v1.ListActiveWorkloadsResponse_Workload) (bool, error) {
+	var err error
+	switch w.WorkloadType {
+	case v1.DispatchAction_JOB:
+		_, err = r.ftClient.UpdateJobPhase(ctx, &v1.UpdateJobPhaseRequest{
+			Id:    w.Id,
+			Phase: v1.UpdateJobPhaseRequest_RECREATE,
+		})
+	case v1.DispatchAction_BATCH_JOB:
+		// Check the state again as the server does not reject failing a batch job in another state.
+		ibjob, err := r.bwClient.GetInternalBatchJob(ctx, &v1.GetInternalBatchJobRequest{Id: w.Id})
+		if err != nil {
+			return false, err
+		}
+		if ibjob.State != v1.InternalBatchJob_RUNNING {
+			return false, nil
+		}
+		_, err = r.bwClient.UpdateBatchJobState(ctx, &v1.UpdateBatchJobStateRequest{
+			Id:      w.Id,
+			State:   v1.InternalBatchJob_FAILED,
+			Message: "The k8s job of the batch job has been deleted.",
+		})
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	case v1.DispatchAction_NOTEBOOK:
+		_, err = r.wsClient.UpdateNotebookState(ctx, &v1.UpdateNotebookStateRequest{
+			Id:    w.Id,
+			State: v1.NotebookState_REQUEUED,
+		})
+	default:
+		return false, fmt.Errorf("unknown workload type: %s", w.WorkloadType)
+	}
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isDeletable returns true if the object is old enough to be deleted and is not being deleted.
+func (r *WorkloadReconciler) isDeletable(obj client.Object) bool {
+	return obj.GetDeletionTimestamp().IsZero() &&
+		r.now().Sub(obj.GetCreationTimestamp().Time) >= r.config.GracePeriod
+}
+
+// deleteObject deletes the object unless it has been updated since it was listed. It returns false if
+// the object was not deleted.
+func (r *WorkloadReconciler) deleteObject(ctx context.Context, obj client.Object) (bool, error) {
+	if err := r.k8sClient.Delete(ctx, obj,
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+		client.Preconditions{
+			UID:             ptr.To(obj.GetUID()),
+			ResourceVersion: ptr.To(obj.GetResourceVersion()),
+		},
+	); err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,230 @@
+package dispatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/dispatcher/internal/config"
+	"github.com/llmariner/job-manager/pkg/workload"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWorkloadReconciler(t *testing.T) {
+	now := time.Now()
+	old := metav1.NewTime(now.Add(-time.Hour))
+	ftJob := func(id string, created metav1.Time) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              id,
+				Namespace:         "default",
+				CreationTimestamp: created,
+				Labels:            workload.Labels(workload.KindFineTuningJob, id),
+				Annotations: map[string]string{
+					managedJobAnnotationKey: "true",
+					jobIDAnnotationKey:      id,
+				},
+			},
+		}
+	}
+	batchJob := func(id string) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              id,
+				Namespace:         "default",
+				CreationTimestamp: old,
+				Labels:            workload.Labels(workload.KindBatchJob, id),
+				Annotations: map[string]string{
+					batchJobManagedAnnotationKey: "true",
+					batchJobIDAnnotationKey:      id,
+				},
+			},
+		}
+	}
+	deploy := func(id string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              id,
+				Namespace:         "default",
+				CreationTimestamp: old,
+				Labels:            workload.Labels(workload.KindNotebook, id),
+				Annotations: map[string]string{
+					notebookManagedAnnotationKey: "true",
+					notebookIDAnnotationKey:      id,
+				},
+			},
+		}
+	}
+
+	finished := batchJob("bjob-finished")
+	finished.Status.Conditions = []batchv1.JobCondition{
+		{
+			Type:   batchv1.JobComplete,
+			Status: corev1.ConditionTrue,
+		},
+	}
+	suspended := batchJob("bjob-canceled")
+	suspended.Spec.Suspend = ptr.To(true)
+	// Resources created before the workload labels were added.
+	legacyJob := ftJob("job-legacy", old)
+	legacyJob.Labels = nil
+	legacyOrphan := deploy("nb-legacy-orphan")
+	legacyOrphan.Labels = nil
+
+	objs := []client.Object{
+		// Active workloads.
+		ftJob("job-running", old),
+		batchJob("bjob-running"),
+		deploy("nb-running"),
+		// A job of a queued workload.
+		ftJob("job-queued", old),
+		// Orphans.
+		ftJob("job-orphan", old),
+		batchJob("bjob-orphan"),
+		deploy("nb-orphan"),
+		// Orphans that are kept.
+		ftJob("job-new", metav1.NewTime(now)),
+		finished,
+		suspended,
+		legacyJob,
+		legacyOrphan,
+		// A job not managed by the dispatcher.
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "unmanaged",
+				Namespace:         "default",
+				CreationTimestamp: old,
+			},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithObjects(objs...).Build()
+
+	lister := &fakeActiveWorkloadLister{
+		resp: &v1.ListActiveWorkloadsResponse{
+			Workloads: []*v1.ListActiveWorkloadsResponse_Workload{
+				{WorkloadType: v1.DispatchAction_JOB, Id: "job-running", Running: true, KubernetesNamespace: "default"},
+				{WorkloadType: v1.DispatchAction_JOB, Id: "job-queued"},
+				{WorkloadType: v1.DispatchAction_BATCH_JOB, Id: "bjob-running", Running: true, KubernetesNamespace: "default"},
+				{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "nb-running", Running: true, KubernetesNamespace: "default"},
+				{WorkloadType: v1.DispatchAction_JOB, Id: "job-legacy", Running: true, KubernetesNamespace: "default"},
+				// Workloads without their k8s resources.
+				{WorkloadType: v1.DispatchAction_JOB, Id: "job-lost", Running: true, KubernetesNamespace: "default"},
+				{WorkloadType: v1.DispatchAction_BATCH_JOB, Id: "bjob-lost", Running: true, KubernetesNamespace: "default"},
+				{WorkloadType: v1.DispatchAction_BATCH_JOB, Id: "bjob-canceling", Running: true, KubernetesNamespace: "default"},
+				{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "nb-lost", Running: true, KubernetesNamespace: "default"},
+				{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "nb-deleting", Running: true, KubernetesNamespace: "default"},
+				{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "nb-queued"},
+			},
+		},
+	}
+	ftClient := &fakeJobPhaseUpdater{}
+	bwClient := &fakeBatchJobStateUpdater{
+		states: map[string]v1.InternalBatchJob_State{
+			"bjob-lost":      v1.InternalBatchJob_RUNNING,
+			"bjob-canceling": v1.InternalBatchJob_QUEUED,
+		},
+	}
+	wsClient := &fakeNotebookStateUpdater{
+		rejected: map[string]bool{"nb-deleting": true},
+	}
+	r := NewWorkloadReconciler(lister, ftClient, bwClient, wsClient, config.ReconciliationConfig{
+		Enable:      true,
+		Interval:    time.Minute,
+		GracePeriod: 10 * time.Minute,
+	})
+	r.k8sClient = k8sClient
+	r.reader = k8sClient
+	r.now = func() time.Time { return now }
+
+	ctx := ctrl.LoggerInto(context.Background(), testr.New(t))
+	report, err := r.reconcile(ctx)
+	assert.NoError(t, err)
+
+	want := &reconciliationReport{
+		recreatedJobs:      []string{"job-lost"},
+		failedBatchJobs:    []string{"bjob-lost"},
+		requeuedNotebooks:  []string{"nb-lost"},
+		deletedJobs:        []string{"default/bjob-orphan", "default/job-orphan"},
+		deletedDeployments: []string{"default/nb-orphan"},
+	}
+	assert.Equal(t, want, report)
+
+	assert.Equal(t, []*v1.UpdateJobPhaseRequest{
+		{Id: "job-lost", Phase: v1.UpdateJobPhaseRequest_RECREATE},
+	}, ftClient.reqs)
+	assert.Len(t, bwClient.reqs, 1)
+	assert.Equal(t, v1.InternalBatchJob_FAILED, bwClient.reqs[0].State)
+	assert.Equal(t, "The k8s job of the batch job has been deleted.", bwClient.reqs[0].Message)
+
+	var jobs batchv1.JobList
+	assert.NoError(t, k8sClient.List(ctx, &jobs))
+	var names []string
+	for _, job := range jobs.Items {
+		names = append(names, job.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"job-running",
+		"bjob-running",
+		"job-queued",
+		"job-new",
+		"bjob-finished",
+		"bjob-canceled",
+		"job-legacy",
+		"unmanaged",
+	}, names)
+	var deploys appsv1.DeploymentList
+	assert.NoError(t, k8sClient.List(ctx, &deploys))
+	names = nil
+	for _, deploy := range deploys.Items {
+		names = append(names, deploy.Name)
+	}
+	assert.ElementsMatch(t, []string{"nb-running", "nb-legacy-orphan"}, names)
+}
+
+type fakeJobPhaseUpdater struct {
+	reqs []*v1.UpdateJobPhaseRequest
+}
+
+func (u *fakeJobPhaseUpdater) UpdateJobPhase(ctx context.Context, in *v1.UpdateJobPhaseRequest, opts ...grpc.CallOption) (*v1.UpdateJobPhaseResponse, error) {
+	u.reqs = append(u.reqs, in)
+	return &v1.UpdateJobPhaseResponse{}, nil
+}
+
+type fakeBatchJobStateUpdater struct {
+	states map[string]v1.InternalBatchJob_State
+	reqs   []*v1.UpdateBatchJobStateRequest
+}
+
+func (u *fakeBatchJobStateUpdater) GetInternalBatchJob(ctx context.Context, in *v1.GetInternalBatchJobRequest, opts ...grpc.CallOption) (*v1.InternalBatchJob, error) {
+	return &v1.InternalBatchJob{State: u.states[in.Id]}, nil
+}
+
+func (u *fakeBatchJobStateUpdater) UpdateBatchJobState(ctx context.Context, in *v1.UpdateBatchJobStateRequest, opts ...grpc.CallOption) (*v1.UpdateBatchJobStateResponse, error) {
+	u.reqs = append(u.reqs, in)
+	return &v1.UpdateBatchJobStateResponse{}, nil
+}
+
+type fakeNotebookStateUpdater struct {
+	// rejected is the IDs of the notebooks whose state cannot be updated.
+	rejected map[string]bool
+}
+
+func (u *fakeNotebookStateUpdater) UpdateNotebookState(ctx context.Context, in *v1.UpdateNotebookStateRequest, opts ...grpc.CallOption) (*v1.UpdateNotebookStateResponse, error) {
+	if u.rejected[in.Id] {
+		return nil, status.Errorf(codes.FailedPrecondition, "notebook is not queued")
+	}
+	return &v1.UpdateNotebookStateResponse{}, nil
+}
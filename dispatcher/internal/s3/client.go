@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"strings"
@@ -153,6 +154,18 @@ func reconstructPresignURL(origURL, bucket string) (string, error) {
 	return u.String(), nil
 }
 
+// Upload uploads the data to the given bucket and key.
+func (c *Client) Upload(ctx context.Context, r io.Reader, bucket, key string) error {
+	if _, err := c.svc.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   r,
+	}); err != nil {
+		return fmt.Errorf("upload: %s", err)
+	}
+	return nil
+}
+
 // ListObjectsPages returns S3 objects with pagination.
 func (c *Client) ListObjectsPages(
 	ctx context.Context,
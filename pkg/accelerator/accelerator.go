@@ -0,0 +1,90 @@
+// Package accelerator defines the Kubernetes extended resource names of the supported accelerators.
+package accelerator
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// NvidiaGPU is the resource name of NVIDIA GPUs.
+	NvidiaGPU = "nvidia.com/gpu"
+	// AMDGPU is the resource name of AMD GPUs.
+	AMDGPU = "amd.com/gpu"
+	// IntelGaudi is the resource name of Intel Gaudi accelerators.
+	IntelGaudi = "habana.ai/gaudi"
+	// AWSNeuron is the resource name of AWS Neuron devices (Inferentia and Trainium).
+	AWSNeuron = "aws.amazon.com/neuron"
+
+	// NvidiaGPUShared is the resource name of time-sliced NVIDIA GPUs. The NVIDIA device plugin exposes
+	// time-sliced GPUs with this name when "renameByDefault" is enabled.
+	NvidiaGPUShared = "nvidia.com/gpu.shared"
+	// NvidiaMIGPrefix is the prefix of the resource names of NVIDIA MIG devices (e.g., "nvidia.com/mig-1g.10gb").
+	NvidiaMIGPrefix = "nvidia.com/mig-"
+
+	// SharedGPUProfile is the GPU profile of time-sliced NVIDIA GPUs.
+	SharedGPUProfile = "shared"
+
+	// Default is the accelerator used when a workload does not specify its accelerator type.
+	Default = NvidiaGPU
+
+	// NvidiaGPUProductLabelKey is the node label set by NVIDIA GPU Feature Discovery for the GPU product name.
+	NvidiaGPUProductLabelKey = "nvidia.com/gpu.product"
+	// NvidiaGPUMemoryLabelKey is the node label set by NVIDIA GPU Feature Discovery for the GPU memory size in MiB.
+	NvidiaGPUMemoryLabelKey = "nvidia.com/gpu.memory"
+)
+
+// ResourceNames returns the resource names of the supported accelerators. Fractional NVIDIA GPUs are not
+// included as the resource names of MIG devices depend on their profiles.
+func ResourceNames() []string {
+	return []string{NvidiaGPU, AMDGPU, IntelGaudi, AWSNeuron}
+}
+
+// migProfileRE matches MIG profiles such as "1g.10gb", "1g.10gb+me" and "1c.2g.20gb".
+var migProfileRE = regexp.MustCompile(`^([0-9]+c\.)?[0-9]+g\.[0-9]+gb(\+me)?$`)
+
+// IsSupported returns true if the given resource name is one of the supported accelerators.
+func IsSupported(name string) bool {
+	for _, n := range ResourceNames() {
+		if n == name {
+			return true
+		}
+	}
+	return name == NvidiaGPUShared || IsNvidiaMIG(name)
+}
+
+// IsNvidiaMIG returns true if the given resource name is a NVIDIA MIG device.
+func IsNvidiaMIG(name string) bool {
+	return strings.HasPrefix(name, NvidiaMIGPrefix) && migProfileRE.MatchString(strings.TrimPrefix(name, NvidiaMIGPrefix))
+}
+
+// IsNvidia returns true if the given resource name is a NVIDIA GPU, including fractional ones.
+func IsNvidia(name string) bool {
+	return name == NvidiaGPU || name == NvidiaGPUShared || IsNvidiaMIG(name)
+}
+
+// IsValidGPUProfile returns true if the given GPU profile is either a MIG profile or the shared GPU profile.
+func IsValidGPUProfile(profile string) bool {
+	return profile == SharedGPUProfile || migProfileRE.MatchString(profile)
+}
+
+// ResourceName returns the resource name of the accelerators requested with the given accelerator type
+// and fractional GPU profile. The GPU profile takes precedence over the accelerator type.
+func ResourceName(acceleratorType, gpuProfile string) string {
+	switch gpuProfile {
+	case "":
+		return ResourceNameOrDefault(acceleratorType)
+	case SharedGPUProfile:
+		return NvidiaGPUShared
+	default:
+		return NvidiaMIGPrefix + gpuProfile
+	}
+}
+
+// ResourceNameOrDefault returns the given resource name, or the default one if it is empty.
+func ResourceNameOrDefault(name string) string {
+	if name == "" {
+		return Default
+	}
+	return name
+}
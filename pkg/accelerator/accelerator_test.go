@@ -0,0 +1,46 @@
+package accelerator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSupported(t *testing.T) {
+	for _, n := range ResourceNames() {
+		assert.True(t, IsSupported(n), n)
+	}
+	assert.False(t, IsSupported(""))
+	assert.False(t, IsSupported("example.com/tpu"))
+}
+
+func TestResourceNameOrDefault(t *testing.T) {
+	assert.Equal(t, NvidiaGPU, ResourceNameOrDefault(""))
+	assert.Equal(t, AMDGPU, ResourceNameOrDefault(AMDGPU))
+}
+
+func TestIsSupported_Fractional(t *testing.T) {
+	tcs := []struct {
+		name string
+		want bool
+	}{
+		{name: "nvidia.com/mig-1g.10gb", want: true},
+		{name: "nvidia.com/mig-1g.10gb+me", want: true},
+		{name: "nvidia.com/mig-1c.2g.20gb", want: true},
+		{name: "nvidia.com/gpu.shared", want: true},
+		{name: "nvidia.com/mig-invalid", want: false},
+		{name: "nvidia.com/gpu.product", want: false},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsSupported(tc.name))
+		})
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	assert.Equal(t, NvidiaGPU, ResourceName("", ""))
+	assert.Equal(t, AMDGPU, ResourceName(AMDGPU, ""))
+	assert.Equal(t, "nvidia.com/mig-1g.10gb", ResourceName("", "1g.10gb"))
+	assert.Equal(t, NvidiaGPUShared, ResourceName(NvidiaGPU, SharedGPUProfile))
+}
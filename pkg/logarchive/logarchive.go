@@ -0,0 +1,64 @@
+// Package logarchive defines the layout of the workload logs archived in object storage.
+//
+// Dispatchers upload the logs of the containers of finished workloads as gzip-compressed chunks,
+// and the server lists the chunks to serve them to users. The key of a chunk is
+// "<path prefix>/<workload kind>/<workload ID>/<pod name>/<container name>/<chunk index>.log.gz".
+package logarchive
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	// KindFineTuningJob is the workload kind of fine-tuning jobs.
+	KindFineTuningJob = "fine-tuning-jobs"
+	// KindBatchJob is the workload kind of batch jobs.
+	KindBatchJob = "batch-jobs"
+)
+
+const chunkSuffix = ".log.gz"
+
+// Prefix returns the key prefix of the archived logs of a workload. The prefix ends with a slash.
+func Prefix(pathPrefix, kind, id string) string {
+	return path.Join(pathPrefix, kind, id) + "/"
+}
+
+// ChunkKey returns the key of a chunk of the archived log of a container.
+func ChunkKey(pathPrefix, kind, id, podName, containerName string, index int) string {
+	return fmt.Sprintf("%s%s/%s/%05d%s", Prefix(pathPrefix, kind, id), podName, containerName, index, chunkSuffix)
+}
+
+// Chunk is a chunk of the archived log of a container.
+type Chunk struct {
+	PodName       string
+	ContainerName string
+	Index         int
+}
+
+// ParseChunkKey parses the key of a chunk under the given prefix.
+func ParseChunkKey(prefix, key string) (Chunk, error) {
+	rel, ok := strings.CutPrefix(key, prefix)
+	if !ok {
+		return Chunk{}, fmt.Errorf("key %q does not have prefix %q", key, prefix)
+	}
+	l := strings.Split(rel, "/")
+	if len(l) != 3 || l[0] == "" || l[1] == "" {
+		return Chunk{}, fmt.Errorf("invalid key %q", key)
+	}
+	s, ok := strings.CutSuffix(l[2], chunkSuffix)
+	if !ok {
+		return Chunk{}, fmt.Errorf("invalid chunk name %q", l[2])
+	}
+	index, err := strconv.Atoi(s)
+	if err != nil {
+		return Chunk{}, fmt.Errorf("invalid chunk index %q: %s", s, err)
+	}
+	return Chunk{
+		PodName:       l[0],
+		ContainerName: l[1],
+		Index:         index,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package logarchive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkKey(t *testing.T) {
+	key := ChunkKey("logs", KindBatchJob, "job0", "job0-0-abcde", "main", 12)
+	assert.Equal(t, "logs/batch-jobs/job0/job0-0-abcde/main/00012.log.gz", key)
+
+	got, err := ParseChunkKey(Prefix("logs", KindBatchJob, "job0"), key)
+	assert.NoError(t, err)
+	assert.Equal(t, Chunk{PodName: "job0-0-abcde", ContainerName: "main", Index: 12}, got)
+}
+
+func TestParseChunkKey_Invalid(t *testing.T) {
+	prefix := Prefix("logs", KindFineTuningJob, "job0")
+	tcs := []string{
+		"logs/fine-tuning-jobs/job1/pod/main/00000.log.gz",
+		"logs/fine-tuning-jobs/job0/pod/00000.log.gz",
+		"logs/fine-tuning-jobs/job0/pod/main/00000.log",
+		"logs/fine-tuning-jobs/job0/pod/main/abc.log.gz",
+	}
+	for _, key := range tcs {
+		_, err := ParseChunkKey(prefix, key)
+		assert.Error(t, err, key)
+	}
+}
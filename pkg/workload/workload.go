@@ -0,0 +1,25 @@
+// Package workload defines the labels that the server and the dispatcher use to identify the Kubernetes resources
+// of workloads.
+package workload
+
+const (
+	// KindLabelKey is the label of the kind of the workload that a Kubernetes resource belongs to.
+	KindLabelKey = "llmariner/workload-kind"
+	// IDLabelKey is the label of the ID of the workload that a Kubernetes resource belongs to.
+	IDLabelKey = "llmariner/workload-id"
+
+	// KindFineTuningJob is the kind of fine-tuning jobs.
+	KindFineTuningJob = "fine-tuning-job"
+	// KindBatchJob is the kind of batch jobs.
+	KindBatchJob = "batch-job"
+	// KindNotebook is the kind of notebooks.
+	KindNotebook = "notebook"
+)
+
+// Labels returns the labels of the Kubernetes resources of a workload.
+func Labels(kind, id string) map[string]string {
+	return map[string]string{
+		KindLabelKey: kind,
+		IDLabelKey:   id,
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/llmariner/job-manager/server/internal/cache"
 	"github.com/llmariner/job-manager/server/internal/config"
 	"github.com/llmariner/job-manager/server/internal/k8s"
+	"github.com/llmariner/job-manager/server/internal/s3"
 	"github.com/llmariner/job-manager/server/internal/scheduler"
 	"github.com/llmariner/job-manager/server/internal/server"
 	"github.com/llmariner/job-manager/server/internal/store"
@@ -169,9 +170,19 @@ func run(ctx context.Context, c *config.Config) error {
 		usageSetter = sender.NoopUsageSetter{}
 	}
 
-	// TODO(aya): stop the lazy loading and populate cache data from DB at the startup time.
 	cache := cache.NewStore(st, logger.WithName("cache"))
-	sched := scheduler.New(cache, logger.WithName("scheduler"))
+	if err := cache.Load(); err != nil {
+		return err
+	}
+	sched := scheduler.New(cache, c.ClusterHealthConfig.UnreachableThreshold, logger.WithName("scheduler"))
+
+	var logArchiveClient *s3.Client
+	if c.LogArchiveConfig.Enable {
+		logArchiveClient, err = s3.NewClient(ctx, c.LogArchiveConfig.S3)
+		if err != nil {
+			return err
+		}
+	}
 
 	srv := server.New(
 		st,
@@ -182,6 +193,9 @@ func run(ctx context.Context, c *config.Config) error {
 		cache,
 		c.NotebookConfig.ImageTypes,
 		c.BatchJobConfig.Images,
+		c.PreemptionConfig,
+		logArchiveClient,
+		c.LogArchiveConfig,
 		logger,
 		dataKey,
 	)
@@ -199,7 +213,11 @@ func run(ctx context.Context, c *config.Config) error {
 	}()
 
 	go func() {
-		s := server.NewWorkerServiceServer(st, cache, logger)
+		errCh <- srv.RunClusterHealthChecker(ctx, c.ClusterHealthConfig)
+	}()
+
+	go func() {
+		s := server.NewWorkerServiceServer(st, cache, c.KueueConfig, logger)
 		errCh <- s.Run(ctx, c.WorkerServiceGRPCPort, c.AuthConfig)
 	}()
 
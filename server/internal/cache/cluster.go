@@ -8,11 +8,15 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
 	"github.com/llmariner/job-manager/server/internal/store"
 	"google.golang.org/protobuf/proto"
 )
 
 // Store is a cache store.
+//
+// Assumed pods are persisted in the store so that multiple server replicas can share them. The cached clusters of
+// a tenant are reloaded from the store whenever a replica makes a scheduling decision with Reserve.
 type Store struct {
 	store *store.S
 
@@ -45,20 +49,45 @@ type Cluster struct {
 	ClusterName string
 	UpdatedAt   time.Time
 
+	// Cordoned is true if the cluster is excluded from scheduling.
+	Cordoned bool
+	// MaintenanceWindows are the periods when the cluster is excluded from scheduling.
+	MaintenanceWindows []store.MaintenanceWindow
+	// Labels are the labels of the cluster used by placement constraints.
+	Labels map[string]string
+
 	GPUNodes               []*v1.GpuNode
 	ProvisionableResources []*v1.ProvisionableResource
 
 	GPUPods []*v1.GpuPod
+	// Nodes are the CPU and memory capacity of the schedulable nodes.
+	Nodes []*v1.Node
+	// NamespaceQuotas are the ResourceQuotas of the namespaces in the cluster.
+	NamespaceQuotas []*v1.NamespaceQuota
 	// AssumedGPUPodsByKey is a map from key to the assumed GPU pods on the node.
 	// This pod is bound to the cluster by scheduler, but not yet created.
 	// The key is the prefix of the pod.
 	AssumedGPUPodsByKey map[string]*AssumedGPUPod
 }
 
-// AssumedGPUPod represents an assumed GPU pod.
+// AssumedGPUPod represents an assumed pod. It reserves CPU and memory as well as accelerators.
 type AssumedGPUPod struct {
+	// ResourceName is the resource name of the accelerator allocated to the pod (e.g., "nvidia.com/gpu").
+	ResourceName   string
 	AllocatedCount int32
-	AddedAt        time.Time
+	// CPUMilicore and MemoryMegabytes are the CPU and memory reserved for the pod.
+	CPUMilicore     int32
+	MemoryMegabytes int32
+	AddedAt         time.Time
+}
+
+// PodResources are the resources requested by a pod.
+type PodResources struct {
+	// ResourceName is the resource name of the requested accelerators. NVIDIA GPUs are assumed if empty.
+	ResourceName    string
+	GPUCount        int
+	CPUMilicore     int
+	MemoryMegabytes int
 }
 
 // Clone returns a deep copy of the cluster.
@@ -70,22 +99,49 @@ func (c *Cluster) Clone() *Cluster {
 		ClusterID:   c.ClusterID,
 		ClusterName: c.ClusterName,
 		UpdatedAt:   c.UpdatedAt,
+		Cordoned:    c.Cordoned,
 		// Make a copy of the slices and maps, but the elements are not deeply copied.
 		// This is fine because we don't modify the elements.
 		GPUNodes:               make([]*v1.GpuNode, len(c.GPUNodes)),
 		ProvisionableResources: make([]*v1.ProvisionableResource, len(c.ProvisionableResources)),
 		GPUPods:                make([]*v1.GpuPod, len(c.GPUPods)),
+		Nodes:                  make([]*v1.Node, len(c.Nodes)),
 		AssumedGPUPodsByKey:    make(map[string]*AssumedGPUPod, len(c.AssumedGPUPodsByKey)),
 	}
+	if c.MaintenanceWindows != nil {
+		cls.MaintenanceWindows = make([]store.MaintenanceWindow, len(c.MaintenanceWindows))
+		copy(cls.MaintenanceWindows, c.MaintenanceWindows)
+	}
+	if c.NamespaceQuotas != nil {
+		cls.NamespaceQuotas = make([]*v1.NamespaceQuota, len(c.NamespaceQuotas))
+		copy(cls.NamespaceQuotas, c.NamespaceQuotas)
+	}
+	if c.Labels != nil {
+		cls.Labels = make(map[string]string, len(c.Labels))
+		for k, v := range c.Labels {
+			cls.Labels[k] = v
+		}
+	}
 	copy(cls.GPUNodes, c.GPUNodes)
 	copy(cls.ProvisionableResources, c.ProvisionableResources)
 	copy(cls.GPUPods, c.GPUPods)
+	copy(cls.Nodes, c.Nodes)
 	for k, v := range c.AssumedGPUPodsByKey {
 		cls.AssumedGPUPodsByKey[k] = v
 	}
 	return cls
 }
 
+// InMaintenance returns true if the cluster is in one of its maintenance windows at the given time.
+func (c *Cluster) InMaintenance(t time.Time) bool {
+	for _, w := range c.MaintenanceWindows {
+		if w.IsActive(t) {
+			return true
+		}
+	}
+	return false
+}
+
 // NewStore creates a new cache store.
 func NewStore(store *store.S, log logr.Logger) *Store {
 	return &Store{
@@ -95,6 +151,42 @@ func NewStore(store *store.S, log logr.Logger) *Store {
 	}
 }
 
+// Load loads the clusters and the assumed pods of all tenants from the store. It is called at startup so that
+// the first scheduling decisions do not have to wait for the store.
+func (c *Store) Load() error {
+	scls, err := c.store.ListClusters()
+	if err != nil {
+		return fmt.Errorf("failed to list clusters: %s", err)
+	}
+	pods, err := c.store.ListAssumedPods(time.Now().Add(-assumedPodExpiration))
+	if err != nil {
+		return fmt.Errorf("failed to list assumed pods: %s", err)
+	}
+
+	sclsByTenant := map[string][]*store.Cluster{}
+	for _, scl := range scls {
+		sclsByTenant[scl.TenantID] = append(sclsByTenant[scl.TenantID], scl)
+	}
+	podsByTenant := map[string][]*store.AssumedPod{}
+	for _, p := range pods {
+		podsByTenant[p.TenantID] = append(podsByTenant[p.TenantID], p)
+	}
+	clusters := make(map[string]Clusters, len(sclsByTenant))
+	for tenantID, scls := range sclsByTenant {
+		cls, err := newClusters(scls, podsByTenant[tenantID])
+		if err != nil {
+			return err
+		}
+		clusters[tenantID] = cls
+	}
+
+	c.mu.Lock()
+	c.clusters = clusters
+	c.mu.Unlock()
+	c.logger.Info("Loaded clusters", "tenants", len(clusters), "clusters", len(scls), "assumedPods", len(pods))
+	return nil
+}
+
 // ListClustersByTenantID lists clusters by tenant ID.
 // If the tenant is not found in the cache, it fetches them from the store.
 func (c *Store) ListClustersByTenantID(tenantID string) (map[string]*Cluster, error) {
@@ -110,7 +202,23 @@ func (c *Store) ListClustersByTenantID(tenantID string) (map[string]*Cluster, er
 	if err != nil {
 		return nil, fmt.Errorf("failed to list clusters by tenant ID: %s", err)
 	}
-	cls = make(map[string]*Cluster, len(scls))
+	pods, err := c.store.ListAssumedPodsByTenantID(tenantID, time.Now().Add(-assumedPodExpiration))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assumed pods by tenant ID: %s", err)
+	}
+	cls, err = newClusters(scls, pods)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.clusters[tenantID] = cls
+	c.mu.Unlock()
+	return cls.Clone(), nil
+}
+
+// newClusters converts clusters and their assumed pods in the store to the cache clusters.
+func newClusters(scls []*store.Cluster, pods []*store.AssumedPod) (Clusters, error) {
+	cls := make(Clusters, len(scls))
 	for _, scl := range scls {
 		cl, err := convertToCacheCluster(scl)
 		if err != nil {
@@ -118,9 +226,11 @@ func (c *Store) ListClustersByTenantID(tenantID string) (map[string]*Cluster, er
 		}
 		cls[scl.ClusterID] = cl
 	}
-	c.mu.Lock()
-	c.clusters[tenantID] = cls
-	c.mu.Unlock()
+	for _, p := range pods {
+		if cl, ok := cls[p.ClusterID]; ok {
+			cl.AssumedGPUPodsByKey[p.PodKey] = toAssumedGPUPod(p)
+		}
+	}
 	return cls, nil
 }
 
@@ -131,28 +241,38 @@ const assumedPodExpiration = time.Minute
 
 // AddOrUpdateCluster adds or updates a cluster.
 // If the tenant is not found in the cache, it fetches them from the store.
+//
+// Assumed pods of the cluster that have been reported by the dispatcher or have expired are deleted from the store.
 func (c *Store) AddOrUpdateCluster(cluster *store.Cluster) error {
 	cl, err := convertToCacheCluster(cluster)
 	if err != nil {
 		return fmt.Errorf("failed to convert to cache cluster: %s", err)
 	}
 
-	oldCl, ok, err := c.getCluster(cluster.TenantID, cluster.ClusterID)
+	// Make sure that the clusters of the tenant are loaded.
+	if _, err := c.ListClustersByTenantID(cluster.TenantID); err != nil {
+		return fmt.Errorf("failed to list clusters by tenant ID: %s", err)
+	}
+
+	// Read the assumed pods from the store as they might have been added by other server replicas.
+	pods, err := c.store.ListAssumedPodsByClusterID(cluster.ClusterID)
 	if err != nil {
-		return fmt.Errorf("failed to get cluster: %s", err)
+		return fmt.Errorf("failed to list assumed pods: %s", err)
 	}
-	if ok {
-		// TODO(aya): rethink the better expiration logic.
+	var deleted []uint
+	for _, p := range pods {
 		// NOTE: If the pod is quickly completed, it would not be recorded in GpuPods,
 		// it remains in the assumed pod map until the expiration time.
-		for key, pod := range oldCl.AssumedGPUPodsByKey {
-			if time.Since(pod.AddedAt) < assumedPodExpiration &&
-				!nnHasPrefix(cl.GPUPods, key) {
-				cl.AssumedGPUPodsByKey[key] = pod
-			}
+		if time.Since(p.CreatedAt) >= assumedPodExpiration || nnHasPrefix(cl.GPUPods, p.PodKey) {
+			deleted = append(deleted, p.ID)
+			continue
 		}
-		c.logger.V(3).Info("updated cluster", "ID", cl.ClusterID, "gpuPods", cl.GPUPods, "assumedPods", cl.AssumedGPUPodsByKey)
+		cl.AssumedGPUPodsByKey[p.PodKey] = toAssumedGPUPod(p)
 	}
+	if err := c.store.DeleteAssumedPods(deleted); err != nil {
+		return fmt.Errorf("failed to delete assumed pods: %s", err)
+	}
+	c.logger.V(3).Info("updated cluster", "ID", cl.ClusterID, "gpuPods", cl.GPUPods, "assumedPods", cl.AssumedGPUPodsByKey)
 
 	c.mu.Lock()
 	c.clusters[cluster.TenantID][cl.ClusterID] = cl
@@ -169,48 +289,104 @@ func nnHasPrefix(pods []*v1.GpuPod, key string) bool {
 	return false
 }
 
-// AddAssumedPod adds an assumed pod to the cache.
-// If the tenant is not found in the cache, it fetches them from the store.
-func (c *Store) AddAssumedPod(tenantID, clusterID, key string, gpuCount int) error {
-	if gpuCount == 0 {
-		// ignore non GPU pods.
+// Reservation is the resources reserved for the pods of a workload scheduled to a cluster.
+type Reservation struct {
+	ClusterID string
+	// Keys identify the pods of the workload. Each key is the namespaced name of a pod or its prefix.
+	Keys []string
+	// Resources are the resources reserved for each pod.
+	Resources PodResources
+}
+
+// Reserve makes a scheduling decision with the given function and reserves the resources of the returned
+// reservation atomically across server replicas.
+//
+// The clusters of the tenant are locked in the store while the function is called. The cached clusters of the tenant
+// are reloaded from the store beforehand so that the function sees the latest cluster status and the assumed pods
+// added by other server replicas. The function is called with a store that runs queries in the transaction of
+// the lock so that its reads and writes are committed or rolled back with the reservation. The assumed pods of
+// the reservation are stored before the lock is released. Either all of the pods are added or none of them is.
+// The pods are added to the cache only after the transaction is committed.
+func (c *Store) Reserve(tenantID string, fn func(tx *store.S) (Reservation, error)) error {
+	var (
+		reserved Reservation
+		assumed  []*store.AssumedPod
+	)
+	if err := c.store.LockClustersByTenantID(tenantID, func(tx *store.S, scls []*store.Cluster) error {
+		pods, err := tx.ListAssumedPodsByTenantID(tenantID, time.Now().Add(-assumedPodExpiration))
+		if err != nil {
+			return fmt.Errorf("failed to list assumed pods by tenant ID: %s", err)
+		}
+		cls, err := newClusters(scls, pods)
+		if err != nil {
+			return err
+		}
+		c.mu.Lock()
+		c.clusters[tenantID] = cls
+		c.mu.Unlock()
+
+		r, err := fn(tx)
+		if err != nil {
+			return err
+		}
+		if res := r.Resources; res.GPUCount == 0 && res.CPUMilicore == 0 && res.MemoryMegabytes == 0 {
+			// ignore pods that do not request any resources.
+			return nil
+		}
+		if _, ok := cls[r.ClusterID]; !ok {
+			return fmt.Errorf("cluster not found: %s", r.ClusterID)
+		}
+
+		var spods []*store.AssumedPod
+		for _, key := range r.Keys {
+			spods = append(spods, &store.AssumedPod{
+				TenantID:        tenantID,
+				ClusterID:       r.ClusterID,
+				PodKey:          key,
+				ResourceName:    accelerator.ResourceNameOrDefault(r.Resources.ResourceName),
+				AllocatedCount:  int32(r.Resources.GPUCount),
+				CPUMilicore:     int32(r.Resources.CPUMilicore),
+				MemoryMegabytes: int32(r.Resources.MemoryMegabytes),
+			})
+		}
+		if err := tx.CreateAssumedPods(spods); err != nil {
+			return fmt.Errorf("failed to create assumed pods: %s", err)
+		}
+		reserved = r
+		assumed = spods
 		return nil
+	}); err != nil {
+		return err
 	}
-
-	cls, ok, err := c.getCluster(tenantID, clusterID)
-	if err != nil {
-		return fmt.Errorf("failed to get cluster: %s", err)
+	if len(assumed) == 0 {
+		return nil
 	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cl, ok := c.clusters[tenantID][reserved.ClusterID]
 	if !ok {
-		return fmt.Errorf("cluster not found: %s", clusterID)
+		// The cluster has been removed by a concurrent update. The assumed pods are loaded at the next reload.
+		return nil
 	}
-	cls.AssumedGPUPodsByKey[key] = &AssumedGPUPod{
-		AllocatedCount: int32(gpuCount),
-		AddedAt:        time.Now(),
+	// Replace the cluster with a copy so that the clones returned to callers are not modified.
+	cl = cl.Clone()
+	for _, p := range assumed {
+		cl.AssumedGPUPodsByKey[p.PodKey] = toAssumedGPUPod(p)
 	}
-	c.logger.V(3).Info("added assumed pod", "key", key, "assumedPods", cls.AssumedGPUPodsByKey)
-
-	c.mu.Lock()
-	c.clusters[tenantID][clusterID] = cls
-	c.mu.Unlock()
+	c.clusters[tenantID][reserved.ClusterID] = cl
+	c.logger.V(3).Info("added assumed pods", "keys", reserved.Keys, "assumedPods", cl.AssumedGPUPodsByKey)
 	return nil
 }
 
-func (c *Store) getCluster(tenantID, clusterID string) (*Cluster, bool, error) {
-	c.mu.RLock()
-	cls, ok := c.clusters[tenantID]
-	if ok {
-		defer c.mu.RUnlock()
-		cl, ok := cls[clusterID]
-		return cl.Clone(), ok, nil
-	}
-	c.mu.RUnlock()
-	cls, err := c.ListClustersByTenantID(tenantID)
-	if err != nil {
-		return nil, false, fmt.Errorf("failed to list clusters by tenant ID: %s", err)
+func toAssumedGPUPod(p *store.AssumedPod) *AssumedGPUPod {
+	return &AssumedGPUPod{
+		ResourceName:    p.ResourceName,
+		AllocatedCount:  p.AllocatedCount,
+		CPUMilicore:     p.CPUMilicore,
+		MemoryMegabytes: p.MemoryMegabytes,
+		AddedAt:         p.CreatedAt,
 	}
-	cl, ok := cls[clusterID]
-	return cl, ok, nil
 }
 
 func convertToCacheCluster(c *store.Cluster) (*Cluster, error) {
@@ -222,9 +398,14 @@ func convertToCacheCluster(c *store.Cluster) (*Cluster, error) {
 		ClusterID:              c.ClusterID,
 		ClusterName:            c.Name,
 		UpdatedAt:              c.UpdatedAt,
+		Cordoned:               c.Cordoned,
+		MaintenanceWindows:     c.MaintenanceWindows,
+		Labels:                 c.EffectiveLabels(status.Labels),
 		GPUNodes:               status.GpuNodes,
 		ProvisionableResources: status.ProvisionableResources,
 		GPUPods:                status.GpuPods,
+		Nodes:                  status.Nodes,
+		NamespaceQuotas:        status.NamespaceQuotas,
 		AssumedGPUPodsByKey:    make(map[string]*AssumedGPUPod),
 	}, nil
 }
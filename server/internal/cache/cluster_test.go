@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -15,6 +16,11 @@ func TestCluster_Clone(t *testing.T) {
 	cls := &Cluster{
 		ClusterID: "test-cluster",
 		UpdatedAt: time.Now(),
+		Cordoned:  true,
+		MaintenanceWindows: []store.MaintenanceWindow{
+			{ID: "w0", StartTime: time.Now(), EndTime: time.Now().Add(time.Hour)},
+		},
+		Labels: map[string]string{"region": "us-west-2"},
 		GPUNodes: []*v1.GpuNode{
 			{ResourceName: "r0", AllocatableCount: 2},
 			{ResourceName: "r2", AllocatableCount: 1},
@@ -29,9 +35,16 @@ func TestCluster_Clone(t *testing.T) {
 				NamespacedName: "ns-1/pod-1",
 			},
 		},
+		Nodes: []*v1.Node{
+			{Name: "n0", AllocatableCpuMilicore: 8000},
+		},
+		NamespaceQuotas: []*v1.NamespaceQuota{
+			{Namespace: "ns-1", Hard: map[string]int64{"cpu": 4000}},
+		},
 		AssumedGPUPodsByKey: map[string]*AssumedGPUPod{
 			"pod-2": {
 				AllocatedCount: 1,
+				CPUMilicore:    500,
 				AddedAt:        time.Now(),
 			},
 		},
@@ -41,6 +54,11 @@ func TestCluster_Clone(t *testing.T) {
 	assert.Same(t, cls.GPUNodes[0], gotCls.GPUNodes[0])
 	assert.NotSame(t, &cls.GPUNodes, &gotCls.GPUNodes)
 	assert.NotSame(t, &cls.GPUPods, &gotCls.GPUPods)
+	assert.NotSame(t, &cls.Nodes, &gotCls.Nodes)
+	assert.NotSame(t, &cls.NamespaceQuotas, &gotCls.NamespaceQuotas)
+	assert.NotSame(t, &cls.MaintenanceWindows[0], &gotCls.MaintenanceWindows[0])
+	gotCls.Labels["region"] = "us-east-1"
+	assert.Equal(t, "us-west-2", cls.Labels["region"])
 	assert.NotSame(t, &cls.AssumedGPUPodsByKey, &gotCls.AssumedGPUPodsByKey)
 }
 
@@ -77,21 +95,74 @@ func TestCache(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, gotEmpty, 0)
 
+	reserve := func(c *Store, tenantID, clusterID string, keys []string, r PodResources) error {
+		return c.Reserve(tenantID, func(tx *store.S) (Reservation, error) {
+			return Reservation{ClusterID: clusterID, Keys: keys, Resources: r}, nil
+		})
+	}
+
 	// add assumed pod to cache
-	err = c.AddAssumedPod("t0", "c0", "ns-1/pod-4", 1)
+	err = reserve(c, "t0", "c0", []string{"ns-1/pod-4"}, PodResources{GPUCount: 1})
 	assert.NoError(t, err)
-	err = c.AddAssumedPod("t0", "c0", "ns-1/pod-5", 1)
+	err = reserve(c, "t0", "c0", []string{"ns-1/pod-5"}, PodResources{GPUCount: 1})
 	assert.NoError(t, err)
 	gotT0Cls3, err := c.ListClustersByTenantID("t0")
 	assert.NoError(t, err)
 	assert.Len(t, gotT0Cls3["c0"].GPUPods, 1)
 	assert.Len(t, gotT0Cls3["c0"].AssumedGPUPodsByKey, 2)
 
-	err = c.AddAssumedPod("t0", "unknown", "ns-1/pod-6", 1)
+	err = reserve(c, "t0", "unknown", []string{"ns-1/pod-6"}, PodResources{GPUCount: 1})
 	assert.ErrorContains(t, err, "cluster not found: unknown")
-	err = c.AddAssumedPod("unknown", "unknown", "ns-1/pod-6", 1)
+	err = reserve(c, "unknown", "unknown", []string{"ns-1/pod-6"}, PodResources{GPUCount: 1})
 	assert.ErrorContains(t, err, "cluster not found: unknown")
 
+	// add assumed pods of a gang to cache
+	err = reserve(c, "t0", "c1", []string{"ns-1/job-0-", "ns-1/job-1-"}, PodResources{ResourceName: "amd.com/gpu", GPUCount: 2})
+	assert.NoError(t, err)
+	err = reserve(c, "t0", "unknown", []string{"ns-1/job-2-", "ns-1/job-3-"}, PodResources{ResourceName: "amd.com/gpu", GPUCount: 2})
+	assert.ErrorContains(t, err, "cluster not found: unknown")
+	gotT0Cls5, err := c.ListClustersByTenantID("t0")
+	assert.NoError(t, err)
+	assert.Len(t, gotT0Cls5["c1"].AssumedGPUPodsByKey, 2)
+	assert.Equal(t, int32(2), gotT0Cls5["c1"].AssumedGPUPodsByKey["ns-1/job-0-"].AllocatedCount)
+	assert.Equal(t, "amd.com/gpu", gotT0Cls5["c1"].AssumedGPUPodsByKey["ns-1/job-0-"].ResourceName)
+	assert.Equal(t, "nvidia.com/gpu", gotT0Cls3["c0"].AssumedGPUPodsByKey["ns-1/pod-4"].ResourceName)
+
+	// a failed scheduling decision does not reserve anything
+	err = c.Reserve("t0", func(tx *store.S) (Reservation, error) {
+		return Reservation{}, errors.New("not schedulable")
+	})
+	assert.ErrorContains(t, err, "not schedulable")
+
+	// add an assumed pod that requests only CPU and memory
+	err = reserve(c, "t0", "c1", []string{"ns-1/pod-7"}, PodResources{CPUMilicore: 500, MemoryMegabytes: 1000})
+	assert.NoError(t, err)
+	err = reserve(c, "t0", "c1", []string{"ns-1/pod-8"}, PodResources{})
+	assert.NoError(t, err)
+	gotT0Cls6, err := c.ListClustersByTenantID("t0")
+	assert.NoError(t, err)
+	assert.Len(t, gotT0Cls6["c1"].AssumedGPUPodsByKey, 3)
+	assert.Equal(t, int32(500), gotT0Cls6["c1"].AssumedGPUPodsByKey["ns-1/pod-7"].CPUMilicore)
+	assert.Len(t, gotT0Cls6["c1"].Nodes, 1)
+
+	// another server replica sees the assumed pods
+	c2 := NewStore(st, testr.New(t))
+	err = c2.Load()
+	assert.NoError(t, err)
+	gotT0Cls7, err := c2.ListClustersByTenantID("t0")
+	assert.NoError(t, err)
+	assert.Len(t, gotT0Cls7["c0"].AssumedGPUPodsByKey, 2)
+	assert.Len(t, gotT0Cls7["c1"].AssumedGPUPodsByKey, 3)
+	err = reserve(c2, "t0", "c0", []string{"ns-1/pod-9"}, PodResources{GPUCount: 1})
+	assert.NoError(t, err)
+	err = c.Reserve("t0", func(tx *store.S) (Reservation, error) {
+		cls, err := c.ListClustersByTenantID("t0")
+		assert.NoError(t, err)
+		assert.Len(t, cls["c0"].AssumedGPUPodsByKey, 3)
+		return Reservation{}, nil
+	})
+	assert.NoError(t, err)
+
 	// update cluster c0
 	newT0Cl0 := stCluster(t, "t0", "c0", "ns-1/pod-1", "ns-1/pod-4")
 	err = c.AddOrUpdateCluster(newT0Cl0)
@@ -99,7 +170,10 @@ func TestCache(t *testing.T) {
 	gotT0Cls4, err := c.ListClustersByTenantID("t0")
 	assert.NoError(t, err)
 	assert.Len(t, gotT0Cls4["c0"].GPUPods, 2)
-	assert.Len(t, gotT0Cls4["c0"].AssumedGPUPodsByKey, 1)
+	assert.Len(t, gotT0Cls4["c0"].AssumedGPUPodsByKey, 2)
+	pods, err := st.ListAssumedPodsByClusterID("c0")
+	assert.NoError(t, err)
+	assert.Len(t, pods, 2)
 	// add cluster c3
 	err = c.AddOrUpdateCluster(stCluster(t, "t0", "c3"))
 	assert.NoError(t, err)
@@ -117,6 +191,9 @@ func stCluster(t *testing.T, tid, cid string, pods ...string) *store.Cluster {
 			{InstanceFamily: "f1", InstanceType: "t1"},
 		},
 		GpuPods: []*v1.GpuPod{},
+		Nodes: []*v1.Node{
+			{Name: "n0", AllocatableCpuMilicore: 8000, AllocatableMemoryMegabytes: 32000},
+		},
 	}
 	for _, p := range pods {
 		status.GpuPods = append(status.GpuPods,
@@ -3,10 +3,12 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/llmariner/api-usage/pkg/sender"
 	"github.com/llmariner/common/pkg/db"
 	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Config is the configuration.
@@ -27,7 +29,15 @@ type Config struct {
 	NotebookConfig NotebookConfig `yaml:"notebook"`
 	BatchJobConfig BatchJobConfig `yaml:"batchJob"`
 
+	PreemptionConfig PreemptionConfig `yaml:"preemption"`
+
+	KueueConfig KueueConfig `yaml:"kueue"`
+
+	ClusterHealthConfig ClusterHealthConfig `yaml:"clusterHealth"`
+
 	KMSConfig KMSConfig `yaml:"kms"`
+
+	LogArchiveConfig LogArchiveConfig `yaml:"logArchive"`
 }
 
 // NotebookConfig is the notebook configuration.
@@ -56,6 +66,90 @@ func (c *BatchJobConfig) Validate() error {
 	return nil
 }
 
+// PreemptionConfig is the preemption configuration.
+type PreemptionConfig struct {
+	// Enable enables preemption of lower-priority workloads when a workload cannot be scheduled.
+	Enable bool `yaml:"enable"`
+	// NonPreemptibleProjectIDs is a list of the projects that are allowed to create non-preemptible workloads.
+	NonPreemptibleProjectIDs []string `yaml:"nonPreemptibleProjectIds"`
+	// PreemptNotebooks is the flag to preempt lower-priority notebooks in addition to batch jobs.
+	// Preempted notebooks are stopped and lose unsaved work as the activities of notebooks are not tracked.
+	PreemptNotebooks bool `yaml:"preemptNotebooks"`
+}
+
+// KueueConfig is the configuration of the Kueue queues of projects.
+type KueueConfig struct {
+	// Projects are the Kueue queues of the projects keyed by their IDs. The workloads of a project are submitted
+	// to the queue of the project. The dispatcher uses the queue configured for the namespace if a project is not listed.
+	Projects map[string]KueueProjectConfig `yaml:"projects"`
+}
+
+// KueueProjectConfig is the Kueue queue of a project.
+type KueueProjectConfig struct {
+	// QueueName is the name of the LocalQueue that the workloads of the project are submitted to.
+	QueueName string `yaml:"queueName"`
+	// ResourceQuotas are the nominal quotas of the ClusterQueue that the dispatcher provisions for the namespace
+	// of the project (e.g., "nvidia.com/gpu": "8").
+	ResourceQuotas map[string]string `yaml:"resourceQuotas"`
+}
+
+// Validate validates the configuration.
+func (c *KueueConfig) Validate() error {
+	for id, p := range c.Projects {
+		for name, q := range p.ResourceQuotas {
+			if _, err := resource.ParseQuantity(q); err != nil {
+				return fmt.Errorf("project %q: invalid quota of %q: %s", id, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ClusterHealthConfig is the configuration of the cluster health checks.
+type ClusterHealthConfig struct {
+	// CheckInterval is the interval to check the health of clusters.
+	CheckInterval time.Duration `yaml:"checkInterval"`
+	// DegradedThreshold is the duration after which a cluster that has not updated its status is considered degraded.
+	DegradedThreshold time.Duration `yaml:"degradedThreshold"`
+	// UnreachableThreshold is the duration after which a cluster that has not updated its status is considered
+	// unreachable. Unreachable clusters are excluded from scheduling.
+	UnreachableThreshold time.Duration `yaml:"unreachableThreshold"`
+	// RequeueNotebooks is the flag to requeue notebooks in unreachable clusters to other clusters.
+	// Batch jobs and fine-tuning jobs are not requeued.
+	RequeueNotebooks bool `yaml:"requeueNotebooks"`
+}
+
+const (
+	defaultClusterHealthCheckInterval        = time.Minute
+	defaultClusterHealthDegradedThreshold    = 5 * time.Minute
+	defaultClusterHealthUnreachableThreshold = 30 * time.Minute
+)
+
+// Validate validates the configuration. The durations that are not set default to 1m, 5m, and 30m so that
+// the configuration without the clusterHealth section keeps working.
+func (c *ClusterHealthConfig) Validate() error {
+	if c.CheckInterval == 0 {
+		c.CheckInterval = defaultClusterHealthCheckInterval
+	}
+	if c.DegradedThreshold == 0 {
+		c.DegradedThreshold = defaultClusterHealthDegradedThreshold
+	}
+	if c.UnreachableThreshold == 0 {
+		c.UnreachableThreshold = defaultClusterHealthUnreachableThreshold
+	}
+
+	if c.CheckInterval <= 0 {
+		return fmt.Errorf("checkInterval must be greater than 0")
+	}
+	if c.DegradedThreshold <= 0 {
+		return fmt.Errorf("degradedThreshold must be greater than 0")
+	}
+	if c.UnreachableThreshold <= c.DegradedThreshold {
+		return fmt.Errorf("unreachableThreshold must be greater than degradedThreshold")
+	}
+	return nil
+}
+
 // AuthConfig is the authentication configuration.
 type AuthConfig struct {
 	Enable                 bool   `yaml:"enable"`
@@ -113,6 +207,58 @@ func (c *KMSConfig) validate() error {
 	return nil
 }
 
+// S3Config is the S3 configuration.
+type S3Config struct {
+	EndpointURL        string `yaml:"endpointUrl"`
+	Region             string `yaml:"region"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify"`
+	Bucket             string `yaml:"bucket"`
+
+	AssumeRole *AssumeRoleConfig `yaml:"assumeRole"`
+}
+
+func (c *S3Config) validate() error {
+	if c.Region == "" {
+		return fmt.Errorf("region must be set")
+	}
+	if c.Bucket == "" {
+		return fmt.Errorf("bucket must be set")
+	}
+	if ar := c.AssumeRole; ar != nil {
+		if err := ar.validate(); err != nil {
+			return fmt.Errorf("assumeRole: %s", err)
+		}
+	}
+	return nil
+}
+
+// LogArchiveConfig is the configuration of the workload logs that dispatchers archive in object storage.
+type LogArchiveConfig struct {
+	Enable bool `yaml:"enable"`
+	// S3 is the object store where the logs are archived.
+	S3 S3Config `yaml:"s3"`
+	// PathPrefix is the key prefix of the archived logs. It must match the path prefix configured in dispatchers.
+	PathPrefix string `yaml:"pathPrefix"`
+	// URLExpiration is the expiration of the presigned URLs of the archived logs.
+	URLExpiration time.Duration `yaml:"urlExpiration"`
+}
+
+func (c *LogArchiveConfig) validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if err := c.S3.validate(); err != nil {
+		return fmt.Errorf("s3: %s", err)
+	}
+	if c.PathPrefix == "" {
+		return fmt.Errorf("pathPrefix must be set")
+	}
+	if c.URLExpiration <= 0 {
+		return fmt.Errorf("urlExpiration must be greater than 0")
+	}
+	return nil
+}
+
 // Validate validates the configuration.
 func (c *Config) Validate() error {
 	if c.GRPCPort <= 0 {
@@ -148,12 +294,21 @@ func (c *Config) Validate() error {
 	if err := c.BatchJobConfig.Validate(); err != nil {
 		return fmt.Errorf("batch job: %s", err)
 	}
+	if err := c.KueueConfig.Validate(); err != nil {
+		return fmt.Errorf("kueue: %s", err)
+	}
+	if err := c.ClusterHealthConfig.Validate(); err != nil {
+		return fmt.Errorf("cluster health: %s", err)
+	}
 	if err := c.UsageSender.Validate(); err != nil {
 		return err
 	}
 	if err := c.KMSConfig.validate(); err != nil {
 		return fmt.Errorf("kms: %s", err)
 	}
+	if err := c.LogArchiveConfig.validate(); err != nil {
+		return fmt.Errorf("log archive: %s", err)
+	}
 	return nil
 }
 
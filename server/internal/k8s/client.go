@@ -3,7 +3,9 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"io"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -59,8 +61,10 @@ func (f *defaultClientFactory) getRestConfig(clusterID, token string) *rest.Conf
 
 // Client is a client to mange worker Kubernetes resources.
 type Client interface {
-	CreateSecret(ctx context.Context, name, namespace string, data map[string][]byte) error
-	CreateConfigMap(ctx context.Context, name, namespace string, data map[string][]byte) error
+	CreateSecret(ctx context.Context, name, namespace string, labels map[string]string, data map[string][]byte) error
+	CreateConfigMap(ctx context.Context, name, namespace string, labels map[string]string, data map[string][]byte) error
+	ListPods(ctx context.Context, namespace, labelSelector string) ([]corev1.Pod, error)
+	StreamPodLogs(ctx context.Context, name, namespace string, opts *corev1.PodLogOptions) (io.ReadCloser, error)
 }
 
 type defaultClient struct {
@@ -68,21 +72,35 @@ type defaultClient struct {
 }
 
 // CreateSecret creates a secret.
-func (c *defaultClient) CreateSecret(ctx context.Context, name, namespace string, data map[string][]byte) error {
+func (c *defaultClient) CreateSecret(ctx context.Context, name, namespace string, labels map[string]string, data map[string][]byte) error {
 	opts := metav1.ApplyOptions{FieldManager: fieldManager, Force: true}
-	conf := corev1apply.Secret(name, namespace).WithData(data)
+	conf := corev1apply.Secret(name, namespace).WithLabels(labels).WithData(data)
 	_, err := c.client.CoreV1().Secrets(namespace).Apply(ctx, conf, opts)
 	return err
 }
 
 // CreateConfigMap creates a configmap.
-func (c *defaultClient) CreateConfigMap(ctx context.Context, name, namespace string, data map[string][]byte) error {
+func (c *defaultClient) CreateConfigMap(ctx context.Context, name, namespace string, labels map[string]string, data map[string][]byte) error {
 	opts := metav1.ApplyOptions{FieldManager: fieldManager, Force: true}
-	conf := corev1apply.ConfigMap(name, namespace).WithBinaryData(data)
+	conf := corev1apply.ConfigMap(name, namespace).WithLabels(labels).WithBinaryData(data)
 	_, err := c.client.CoreV1().ConfigMaps(namespace).Apply(ctx, conf, opts)
 	return err
 }
 
+// ListPods lists the pods that match the label selector.
+func (c *defaultClient) ListPods(ctx context.Context, namespace, labelSelector string) ([]corev1.Pod, error) {
+	pods, err := c.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// StreamPodLogs streams the logs of a container of a pod.
+func (c *defaultClient) StreamPodLogs(ctx context.Context, name, namespace string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return c.client.CoreV1().Pods(namespace).GetLogs(name, opts).Stream(ctx)
+}
+
 // DynamicClient is a dynamic client to mange worker Kubernetes resources.
 type DynamicClient interface {
 	PatchResource(ctx context.Context, name, namespace string, gvr schema.GroupVersionResource, data []byte) (*unstructured.Unstructured, error)
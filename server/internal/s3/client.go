@@ -0,0 +1,74 @@
+package s3
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	laws "github.com/llmariner/common/pkg/aws"
+	"github.com/llmariner/job-manager/server/internal/config"
+)
+
+// NewClient returns a new S3 client.
+func NewClient(ctx context.Context, c config.S3Config) (*Client, error) {
+	opts := laws.NewS3ClientOptions{
+		EndpointURL:        c.EndpointURL,
+		Region:             c.Region,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if ar := c.AssumeRole; ar != nil {
+		opts.AssumeRole = &laws.AssumeRole{
+			RoleARN:    ar.RoleARN,
+			ExternalID: ar.ExternalID,
+		}
+	}
+	svc, err := laws.NewS3Client(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		svc:    svc,
+		bucket: c.Bucket,
+	}, nil
+}
+
+// Client is a client for S3.
+type Client struct {
+	svc    *s3.Client
+	bucket string
+}
+
+// ListObjectKeys returns the keys of all objects with the given prefix.
+func (c *Client) ListObjectKeys(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	p := s3.NewListObjectsV2Paginator(c.svc, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for p.HasMorePages() {
+		resp, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range resp.Contents {
+			keys = append(keys, aws.ToString(obj.Key))
+		}
+	}
+	return keys, nil
+}
+
+// GeneratePresignedURL generates a pre-signed URL for getting an object.
+func (c *Client) GeneratePresignedURL(ctx context.Context, key string, expire time.Duration) (string, error) {
+	presigner := s3.NewPresignClient(c.svc)
+	req, err := presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = expire
+	})
+	if err != nil {
+		return "", err
+	}
+	return req.URL, nil
+}
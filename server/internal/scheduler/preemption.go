@@ -0,0 +1,263 @@
+package scheduler
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+)
+
+// PreemptionCandidate is a running workload that can be preempted to make room for a higher-priority workload.
+type PreemptionCandidate struct {
+	// ID is the ID of the workload (e.g., batch job ID, notebook ID).
+	ID        string
+	ClusterID string
+	Namespace string
+	Priority  int32
+	// PodKeys identify the pods of the workload. Each key is the namespaced name of a pod or its prefix.
+	PodKeys []string
+	// GPUCount, CPUMilicore and MemoryMegabytes are the total resources allocated to the workload.
+	GPUCount        int
+	CPUMilicore     int
+	MemoryMegabytes int
+	// AcceleratorType is the resource name of the accelerators allocated to the workload.
+	AcceleratorType string
+}
+
+// PreemptionResult is the result of finding preemption victims.
+type PreemptionResult struct {
+	SchedulingResult
+
+	// Victims are the workloads that need to be preempted before the workload can run.
+	Victims []PreemptionCandidate
+}
+
+// FindPreemptionVictims finds a cluster where the workload can be scheduled if lower-priority candidates
+// running on the cluster are preempted. All victims are picked from a single cluster.
+//
+// The workload is checked against the cluster without the pods of the victims in the same way as Schedule
+// (e.g., the GPU model, the placement of the pods on distinct nodes, and the namespace quota). CPU and memory
+// of the nodes are not checked as the nodes where the victims run are not known.
+//
+// Among feasible clusters, the one where the highest victim priority is the lowest is picked. Ties are broken
+// by the number of victims and then by the number of preempted GPUs.
+func (s *S) FindPreemptionVictims(
+	userInfo *auth.UserInfo,
+	prevScheduledClusterID string,
+	w Workload,
+	priority int32,
+	candidates []PreemptionCandidate,
+) (PreemptionResult, error) {
+	gpuCount := w.PodCount * w.GPUCountPerPod
+	if gpuCount == 0 {
+		return PreemptionResult{}, fmt.Errorf("preemption is not needed for a workload without GPUs")
+	}
+
+	clusters, err := s.cache.ListClustersByTenantID(userInfo.TenantID)
+	if err != nil {
+		return PreemptionResult{}, err
+	}
+
+	namespacesByCluster := assignedNamespacesByCluster(userInfo)
+
+	candidatesByCluster := map[string][]PreemptionCandidate{}
+	for _, c := range candidates {
+		if c.Priority >= priority || c.GPUCount == 0 {
+			continue
+		}
+		if !w.matchesResourceName(c.AcceleratorType) {
+			// Preempting a workload that uses different accelerators does not free up any of the requested ones.
+			continue
+		}
+		candidatesByCluster[c.ClusterID] = append(candidatesByCluster[c.ClusterID], c)
+	}
+
+	var (
+		best     *PreemptionResult
+		bestCost preemptionCost
+	)
+	for _, c := range clusters {
+		if time.Since(c.UpdatedAt) > s.unreachableThreshold {
+			continue
+		}
+		if c.ClusterID == prevScheduledClusterID {
+			continue
+		}
+		ns, ok := w.namespace(namespacesByCluster[c.ClusterID])
+		if !ok {
+			continue
+		}
+		if !w.isRequired(c) {
+			continue
+		}
+		if unavailableReason(c, time.Now()) != "" {
+			continue
+		}
+		if len(c.GPUNodes) == 0 {
+			// Preemption does not help a cluster that does not report any GPU node.
+			continue
+		}
+
+		victims, ok := selectVictims(c, ns, w, candidatesByCluster[c.ClusterID])
+		if !ok {
+			s.logger.V(1).Info("Preemption cannot make room in the cluster", "clusterID", c.ClusterID)
+			continue
+		}
+		cost := newPreemptionCost(victims)
+		if best == nil || cost.less(bestCost) {
+			best = &PreemptionResult{
+				SchedulingResult: SchedulingResult{
+					ClusterID:   c.ClusterID,
+					ClusterName: c.ClusterName,
+					Namespace:   ns,
+				},
+				Victims: victims,
+			}
+			bestCost = cost
+		}
+	}
+
+	if best == nil {
+		return PreemptionResult{}, fmt.Errorf("no preemption victims found")
+	}
+	s.logger.V(1).Info("Found preemption victims", "clusterID", best.ClusterID, "victims", best.Victims)
+	return *best, nil
+}
+
+// selectVictims picks candidates in the ascending order of their priorities until the workload fits in the cluster.
+// Victims that turn out to be unnecessary (e.g., ones running on nodes with a different GPU model) are then
+// released in the descending order of their priorities.
+func selectVictims(c *cache.Cluster, namespace string, w Workload, candidates []PreemptionCandidate) ([]PreemptionCandidate, bool) {
+	cs := make([]PreemptionCandidate, len(candidates))
+	copy(cs, candidates)
+	sort.SliceStable(cs, func(i, j int) bool {
+		if cs[i].Priority != cs[j].Priority {
+			return cs[i].Priority < cs[j].Priority
+		}
+		// Prefer a workload with more GPUs to minimize the number of victims.
+		return cs[i].GPUCount > cs[j].GPUCount
+	})
+
+	var victims []PreemptionCandidate
+	fits := false
+	for _, cand := range cs {
+		victims = append(victims, cand)
+		if fitsAfterPreemption(c, namespace, w, victims) {
+			fits = true
+			break
+		}
+	}
+	if !fits {
+		return nil, false
+	}
+
+	for i := len(victims) - 1; i >= 0; i-- {
+		rest := append(victims[:i:i], victims[i+1:]...)
+		if fitsAfterPreemption(c, namespace, w, rest) {
+			victims = rest
+		}
+	}
+	return victims, true
+}
+
+// fitsAfterPreemption returns true if the GPUs requested by the workload can be allocated in the cluster and
+// the namespace quota has enough headroom once the victims are preempted.
+func fitsAfterPreemption(c *cache.Cluster, namespace string, w Workload, victims []PreemptionCandidate) bool {
+	c = withoutVictims(c, victims)
+	if quotaExceededReason(c, namespace, w) != "" {
+		return false
+	}
+	if w.PodCount*w.GPUCountPerPod > availableGPUs(c, w) {
+		return false
+	}
+	return w.PodCount <= 1 || fitsOnDistinctNodes(c, w)
+}
+
+// withoutVictims returns a copy of the cluster where the pods of the victims are removed and the resources
+// allocated to the victims are released from the namespace quotas.
+func withoutVictims(c *cache.Cluster, victims []PreemptionCandidate) *cache.Cluster {
+	var keys []string
+	for _, v := range victims {
+		keys = append(keys, v.PodKeys...)
+	}
+	isVictimPod := func(name string) bool {
+		for _, k := range keys {
+			if strings.HasPrefix(name, k) {
+				return true
+			}
+		}
+		return false
+	}
+
+	cl := c.Clone()
+	pods := cl.GPUPods[:0]
+	for _, p := range cl.GPUPods {
+		if !isVictimPod(p.NamespacedName) {
+			pods = append(pods, p)
+		}
+	}
+	cl.GPUPods = pods
+	for key := range cl.AssumedGPUPodsByKey {
+		if isVictimPod(key) {
+			delete(cl.AssumedGPUPodsByKey, key)
+		}
+	}
+
+	for i, q := range cl.NamespaceQuotas {
+		used := maps.Clone(q.Used)
+		for _, v := range victims {
+			if v.Namespace != q.Namespace {
+				continue
+			}
+			for key, n := range map[string]int{
+				accelerator.ResourceNameOrDefault(v.AcceleratorType): v.GPUCount,
+				quotaKeyCPU:    v.CPUMilicore,
+				quotaKeyMemory: v.MemoryMegabytes,
+			} {
+				if _, ok := used[key]; ok {
+					used[key] -= int64(n)
+				}
+			}
+		}
+		cl.NamespaceQuotas[i] = &v1.NamespaceQuota{
+			Namespace: q.Namespace,
+			Hard:      q.Hard,
+			Used:      used,
+		}
+	}
+	return cl
+}
+
+type preemptionCost struct {
+	maxPriority int32
+	numVictims  int
+	numGPUs     int
+}
+
+func newPreemptionCost(victims []PreemptionCandidate) preemptionCost {
+	var c preemptionCost
+	for i, v := range victims {
+		if i == 0 || v.Priority > c.maxPriority {
+			c.maxPriority = v.Priority
+		}
+		c.numGPUs += v.GPUCount
+	}
+	c.numVictims = len(victims)
+	return c
+}
+
+func (c preemptionCost) less(o preemptionCost) bool {
+	if c.maxPriority != o.maxPriority {
+		return c.maxPriority < o.maxPriority
+	}
+	if c.numVictims != o.numVictims {
+		return c.numVictims < o.numVictims
+	}
+	return c.numGPUs < o.numGPUs
+}
@@ -0,0 +1,358 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindPreemptionVictims(t *testing.T) {
+	const (
+		tenantID = "tenant0"
+	)
+
+	// pod returns a pod that runs a workload with the given ID in the namespace.
+	pod := func(namespace, id, nodeName string, gpus int32) *v1.GpuPod {
+		return &v1.GpuPod{
+			ResourceName:   "nvidia.com/gpu",
+			AllocatedCount: gpus,
+			NamespacedName: namespace + "/" + id,
+			NodeName:       nodeName,
+		}
+	}
+	// fullCluster returns a cluster whose GPUs are all allocated to the given pods.
+	fullCluster := func(id string, pods ...*v1.GpuPod) *store.Cluster {
+		var gpus int32
+		for _, p := range pods {
+			gpus += p.AllocatedCount
+		}
+		return &store.Cluster{
+			ClusterID: id,
+			TenantID:  tenantID,
+			Status: marshalStatus(t, &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: gpus,
+					},
+				},
+				GpuPods: pods,
+			}),
+		}
+	}
+	candidate := func(id, clusterID, namespace string, priority int32, gpus int) PreemptionCandidate {
+		return PreemptionCandidate{
+			ID:        id,
+			ClusterID: clusterID,
+			Namespace: namespace,
+			Priority:  priority,
+			PodKeys:   []string{namespace + "/" + id},
+			GPUCount:  gpus,
+		}
+	}
+
+	userInfo := &auth.UserInfo{
+		TenantID: tenantID,
+		AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+			{
+				ClusterID: "cluster0",
+				Namespace: "namespace0",
+			},
+			{
+				ClusterID: "cluster1",
+				Namespace: "namespace1",
+			},
+		},
+	}
+
+	tcs := []struct {
+		name            string
+		clusters        []*store.Cluster
+		podCount        int
+		gpuCount        int
+		acceleratorType string
+		gpuType         string
+		priority        int32
+		candidates      []PreemptionCandidate
+		want            PreemptionResult
+		wantErr         bool
+	}{
+		{
+			name:     "preempt a lower-priority workload",
+			clusters: []*store.Cluster{fullCluster("cluster0", pod("namespace0", "bj0", "", 2))},
+			gpuCount: 2,
+			priority: 10,
+			candidates: []PreemptionCandidate{
+				candidate("bj0", "cluster0", "namespace0", 0, 2),
+			},
+			want: PreemptionResult{
+				SchedulingResult: SchedulingResult{
+					ClusterID: "cluster0",
+					Namespace: "namespace0",
+				},
+				Victims: []PreemptionCandidate{
+					candidate("bj0", "cluster0", "namespace0", 0, 2),
+				},
+			},
+		},
+		{
+			name:     "no candidate with a lower priority",
+			clusters: []*store.Cluster{fullCluster("cluster0", pod("namespace0", "bj0", "", 2))},
+			gpuCount: 2,
+			priority: 10,
+			candidates: []PreemptionCandidate{
+				candidate("bj0", "cluster0", "namespace0", 10, 2),
+			},
+			wantErr: true,
+		},
+		{
+			name: "not enough GPUs freed",
+			clusters: []*store.Cluster{fullCluster("cluster0",
+				pod("namespace0", "bj0", "", 2),
+				pod("namespace0", "other", "", 2),
+			)},
+			gpuCount: 4,
+			priority: 10,
+			candidates: []PreemptionCandidate{
+				candidate("bj0", "cluster0", "namespace0", 0, 2),
+			},
+			wantErr: true,
+		},
+		{
+			name: "victims do not span clusters",
+			clusters: []*store.Cluster{
+				fullCluster("cluster0", pod("namespace0", "bj0", "", 1)),
+				fullCluster("cluster1", pod("namespace1", "bj1", "", 1)),
+			},
+			gpuCount: 2,
+			priority: 10,
+			candidates: []PreemptionCandidate{
+				candidate("bj0", "cluster0", "namespace0", 0, 1),
+				candidate("bj1", "cluster1", "namespace1", 0, 1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "prefer lowest-priority victims",
+			clusters: []*store.Cluster{fullCluster("cluster0",
+				pod("namespace0", "nb0", "", 1),
+				pod("namespace0", "nb1", "", 1),
+			)},
+			gpuCount: 1,
+			priority: 10,
+			candidates: []PreemptionCandidate{
+				candidate("nb0", "cluster0", "namespace0", 5, 1),
+				candidate("nb1", "cluster0", "namespace0", 1, 1),
+			},
+			want: PreemptionResult{
+				SchedulingResult: SchedulingResult{
+					ClusterID: "cluster0",
+					Namespace: "namespace0",
+				},
+				Victims: []PreemptionCandidate{
+					candidate("nb1", "cluster0", "namespace0", 1, 1),
+				},
+			},
+		},
+		{
+			name: "prefer the cluster with lower-priority victims",
+			clusters: []*store.Cluster{
+				fullCluster("cluster0", pod("namespace0", "bj0", "", 2)),
+				fullCluster("cluster1",
+					pod("namespace1", "bj1", "", 1),
+					pod("namespace1", "bj2", "", 1),
+				),
+			},
+			gpuCount: 2,
+			priority: 10,
+			candidates: []PreemptionCandidate{
+				candidate("bj0", "cluster0", "namespace0", 5, 2),
+				candidate("bj1", "cluster1", "namespace1", 1, 1),
+				candidate("bj2", "cluster1", "namespace1", 1, 1),
+			},
+			want: PreemptionResult{
+				SchedulingResult: SchedulingResult{
+					ClusterID: "cluster1",
+					Namespace: "namespace1",
+				},
+				Victims: []PreemptionCandidate{
+					candidate("bj1", "cluster1", "namespace1", 1, 1),
+					candidate("bj2", "cluster1", "namespace1", 1, 1),
+				},
+			},
+		},
+		{
+			name: "victims on nodes with a different GPU model are not preempted",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								NodeName:         "node0",
+								Product:          "NVIDIA-A100",
+								AllocatableCount: 1,
+							},
+							{
+								ResourceName:     "nvidia.com/gpu",
+								NodeName:         "node1",
+								Product:          "NVIDIA-H100",
+								AllocatableCount: 1,
+							},
+						},
+						GpuPods: []*v1.GpuPod{
+							pod("namespace0", "bj0", "node0", 1),
+							pod("namespace0", "bj1", "node1", 1),
+						},
+					}),
+				},
+			},
+			gpuCount: 1,
+			gpuType:  "NVIDIA-H100",
+			priority: 10,
+			candidates: []PreemptionCandidate{
+				candidate("bj0", "cluster0", "namespace0", 0, 1),
+				candidate("bj1", "cluster0", "namespace0", 1, 1),
+			},
+			want: PreemptionResult{
+				SchedulingResult: SchedulingResult{
+					ClusterID: "cluster0",
+					Namespace: "namespace0",
+				},
+				Victims: []PreemptionCandidate{
+					candidate("bj1", "cluster0", "namespace0", 1, 1),
+				},
+			},
+		},
+		{
+			name: "pods of the gang do not fit on distinct nodes",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								NodeName:         "node0",
+								AllocatableCount: 2,
+							},
+							{
+								ResourceName:     "nvidia.com/gpu",
+								NodeName:         "node1",
+								AllocatableCount: 1,
+							},
+						},
+						GpuPods: []*v1.GpuPod{
+							pod("namespace0", "bj0", "node0", 2),
+							pod("namespace0", "other", "node1", 1),
+						},
+					}),
+				},
+			},
+			podCount: 2,
+			gpuCount: 1,
+			priority: 10,
+			candidates: []PreemptionCandidate{
+				candidate("bj0", "cluster0", "namespace0", 0, 2),
+			},
+			wantErr: true,
+		},
+		{
+			name: "preempt a workload in the namespace whose quota is exceeded",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 3,
+							},
+						},
+						GpuPods: []*v1.GpuPod{
+							pod("namespace0", "bj0", "", 1),
+							pod("other", "bj1", "", 1),
+						},
+						NamespaceQuotas: []*v1.NamespaceQuota{
+							{
+								Namespace: "namespace0",
+								Hard:      map[string]int64{"nvidia.com/gpu": 1},
+								Used:      map[string]int64{"nvidia.com/gpu": 1},
+							},
+						},
+					}),
+				},
+			},
+			gpuCount: 1,
+			priority: 10,
+			candidates: []PreemptionCandidate{
+				candidate("bj0", "cluster0", "namespace0", 1, 1),
+				candidate("bj1", "cluster0", "other", 0, 1),
+			},
+			want: PreemptionResult{
+				SchedulingResult: SchedulingResult{
+					ClusterID: "cluster0",
+					Namespace: "namespace0",
+				},
+				Victims: []PreemptionCandidate{
+					candidate("bj0", "cluster0", "namespace0", 1, 1),
+				},
+			},
+		},
+		{
+			name:            "candidates with different accelerators",
+			clusters:        []*store.Cluster{fullCluster("cluster0", pod("namespace0", "bj0", "", 2))},
+			gpuCount:        2,
+			acceleratorType: "amd.com/gpu",
+			priority:        10,
+			candidates: []PreemptionCandidate{
+				candidate("bj0", "cluster0", "namespace0", 0, 2),
+			},
+			wantErr: true,
+		},
+		{
+			name:     "no GPUs",
+			clusters: []*store.Cluster{fullCluster("cluster0", pod("namespace0", "bj0", "", 2))},
+			gpuCount: 0,
+			priority: 10,
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			for _, c := range tc.clusters {
+				_, err := st.CreateOrUpdateCluster(c)
+				assert.NoError(t, err)
+			}
+
+			sched := New(cache.NewStore(st, testr.New(t)), 30*time.Minute, testr.New(t))
+			w := Workload{
+				PodCount:        max(tc.podCount, 1),
+				GPUCountPerPod:  tc.gpuCount,
+				AcceleratorType: tc.acceleratorType,
+				GPUType:         tc.gpuType,
+			}
+			got, err := sched.FindPreemptionVictims(userInfo, "", w, tc.priority, tc.candidates)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
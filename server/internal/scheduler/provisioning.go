@@ -0,0 +1,241 @@
+package scheduler
+
+import (
+	"strings"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"github.com/llmariner/job-manager/server/internal/cache"
+)
+
+// instanceType is an AWS instance type that has accelerators.
+type instanceType struct {
+	name string
+	// gpuName is the value of the "karpenter.k8s.aws/instance-gpu-name" label.
+	gpuName string
+	// gpuProduct is the GPU product name reported by NVIDIA GPU Feature Discovery.
+	gpuProduct   string
+	resourceName string
+	gpuCount     int
+	// gpuMemoryMegabytes is the memory size of each GPU in MiB.
+	gpuMemoryMegabytes int
+}
+
+// family returns the instance family (e.g., "g5" for "g5.12xlarge").
+func (t *instanceType) family() string {
+	f, _, _ := strings.Cut(t.name, ".")
+	return f
+}
+
+// category returns the instance category (e.g., "g" for "g5.12xlarge").
+func (t *instanceType) category() string {
+	f := t.family()
+	i := strings.IndexFunc(f, func(r rune) bool { return r < 'a' || r > 'z' })
+	if i < 0 {
+		return f
+	}
+	return f[:i]
+}
+
+func nvidiaInstanceTypes(family, gpuName, gpuProduct string, gpuMemoryMegabytes int, gpuCounts map[string]int) []instanceType {
+	var ts []instanceType
+	for size, count := range gpuCounts {
+		ts = append(ts, instanceType{
+			name:               family + "." + size,
+			gpuName:            gpuName,
+			gpuProduct:         gpuProduct,
+			resourceName:       accelerator.NvidiaGPU,
+			gpuCount:           count,
+			gpuMemoryMegabytes: gpuMemoryMegabytes,
+		})
+	}
+	return ts
+}
+
+// awsInstanceTypes is the catalog of AWS instance types that have accelerators.
+var awsInstanceTypes = func() map[string]instanceType {
+	var ts []instanceType
+	ts = append(ts, nvidiaInstanceTypes("g4dn", "t4", "Tesla-T4", 15360, map[string]int{
+		"xlarge": 1, "2xlarge": 1, "4xlarge": 1, "8xlarge": 1, "16xlarge": 1, "12xlarge": 4, "metal": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("g5", "a10g", "NVIDIA-A10G", 23028, map[string]int{
+		"xlarge": 1, "2xlarge": 1, "4xlarge": 1, "8xlarge": 1, "16xlarge": 1, "12xlarge": 4, "24xlarge": 4, "48xlarge": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("g6", "l4", "NVIDIA-L4", 23034, map[string]int{
+		"xlarge": 1, "2xlarge": 1, "4xlarge": 1, "8xlarge": 1, "16xlarge": 1, "12xlarge": 4, "24xlarge": 4, "48xlarge": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("g6e", "l40s", "NVIDIA-L40S", 46068, map[string]int{
+		"xlarge": 1, "2xlarge": 1, "4xlarge": 1, "8xlarge": 1, "16xlarge": 1, "12xlarge": 4, "24xlarge": 4, "48xlarge": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("p3", "v100", "Tesla-V100-SXM2-16GB", 16384, map[string]int{
+		"2xlarge": 1, "8xlarge": 4, "16xlarge": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("p3dn", "v100", "Tesla-V100-SXM2-32GB", 32768, map[string]int{
+		"24xlarge": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("p4d", "a100", "NVIDIA-A100-SXM4-40GB", 40960, map[string]int{
+		"24xlarge": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("p4de", "a100", "NVIDIA-A100-SXM4-80GB", 81920, map[string]int{
+		"24xlarge": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("p5", "h100", "NVIDIA-H100-80GB-HBM3", 81559, map[string]int{
+		"48xlarge": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("p5e", "h200", "NVIDIA-H200", 143771, map[string]int{
+		"48xlarge": 8,
+	})...)
+	ts = append(ts, nvidiaInstanceTypes("p5en", "h200", "NVIDIA-H200", 143771, map[string]int{
+		"48xlarge": 8,
+	})...)
+
+	for size, count := range map[string]int{"xlarge": 1, "8xlarge": 1, "24xlarge": 6, "48xlarge": 12} {
+		ts = append(ts, instanceType{
+			name:         "inf2." + size,
+			gpuName:      "inferentia2",
+			resourceName: accelerator.AWSNeuron,
+			gpuCount:     count,
+		})
+	}
+	for _, family := range []string{"trn1", "trn1n"} {
+		for size, count := range map[string]int{"2xlarge": 1, "32xlarge": 16} {
+			if family == "trn1n" && size == "2xlarge" {
+				continue
+			}
+			ts = append(ts, instanceType{
+				name:         family + "." + size,
+				gpuName:      "trainium",
+				resourceName: accelerator.AWSNeuron,
+				gpuCount:     count,
+			})
+		}
+	}
+	ts = append(ts, instanceType{
+		name:         "dl1.24xlarge",
+		gpuName:      "gaudi-hl-205",
+		resourceName: accelerator.IntelGaudi,
+		gpuCount:     8,
+	})
+
+	m := map[string]instanceType{}
+	for _, t := range ts {
+		m[t.name] = t
+	}
+	return m
+}()
+
+// sourceClusterAutoscaler is the source of the provisionable resources that represent node groups of
+// Cluster Autoscaler.
+const sourceClusterAutoscaler = "cluster-autoscaler"
+
+// canScaleUp returns true if one of the provisionable resources of the cluster can create nodes that run
+// all pods of the workload without exceeding its limits.
+func canScaleUp(w Workload, c *cache.Cluster) bool {
+	for _, pr := range c.ProvisionableResources {
+		if pr.Source == sourceClusterAutoscaler || len(pr.NodeGpus) > 0 {
+			// The shape of the nodes is known in advance. Node groups whose shape is unknown are ignored.
+			for _, t := range nodeTemplateInstanceTypes(pr) {
+				if canProvisionWorkload(pr, &t, w) {
+					return true
+				}
+			}
+			continue
+		}
+
+		for _, t := range awsInstanceTypes {
+			if canProvisionWorkload(pr, &t, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nodeTemplateInstanceTypes returns the instance types of the nodes that the provisionable resource creates.
+func nodeTemplateInstanceTypes(pr *v1.ProvisionableResource) []instanceType {
+	var ts []instanceType
+	for name, count := range pr.NodeGpus {
+		ts = append(ts, instanceType{
+			name:               pr.Name,
+			gpuProduct:         pr.GpuProduct,
+			resourceName:       name,
+			gpuCount:           int(count),
+			gpuMemoryMegabytes: int(pr.GpuMemoryMegabytes),
+		})
+	}
+	return ts
+}
+
+// canProvisionWorkload returns true if the provisionable resource can create nodes of the given instance type
+// to run all pods of the workload.
+func canProvisionWorkload(pr *v1.ProvisionableResource, t *instanceType, w Workload) bool {
+	if len(pr.NodeGpus) == 0 && !matchesProvisionableResource(pr, t) {
+		return false
+	}
+
+	// Fractional GPUs are not considered for instance types in the catalog as the device plugin configuration
+	// of new nodes is unknown.
+	if w.resourceName() != t.resourceName {
+		return false
+	}
+	if w.GPUCountPerPod > t.gpuCount {
+		return false
+	}
+	if w.GPUType != "" && w.GPUType != t.gpuProduct {
+		return false
+	}
+	if w.MinGPUMemoryMegabytes > 0 && t.gpuMemoryMegabytes < w.MinGPUMemoryMegabytes {
+		return false
+	}
+
+	limit, ok := pr.GpuLimits[t.resourceName]
+	if !ok || w.GPUCountPerPod == 0 {
+		return true
+	}
+	podsPerNode := t.gpuCount / w.GPUCountPerPod
+	nodes := (w.PodCount + podsPerNode - 1) / podsPerNode
+	return int(pr.ProvisionedGpus[t.resourceName])+nodes*t.gpuCount <= int(limit)
+}
+
+// matchesProvisionableResource returns true if the instance type satisfies the requirements of
+// the provisionable resource.
+func matchesProvisionableResource(pr *v1.ProvisionableResource, t *instanceType) bool {
+	types := pr.InstanceTypes
+	if len(types) == 0 && pr.InstanceType != "" {
+		// Sent by an old version of the dispatcher.
+		types = []string{pr.InstanceType}
+	}
+	families := pr.InstanceFamilies
+	if len(families) == 0 && pr.InstanceFamily != "" {
+		families = []string{pr.InstanceFamily}
+	}
+
+	if len(types) > 0 && !contains(types, t.name) {
+		return false
+	}
+	if len(families) > 0 && !contains(families, t.family()) {
+		return false
+	}
+	if len(pr.InstanceCategories) > 0 && !contains(pr.InstanceCategories, t.category()) {
+		return false
+	}
+	if len(pr.GpuNames) > 0 && !contains(pr.GpuNames, t.gpuName) {
+		return false
+	}
+	if t.gpuCount < int(pr.MinGpuCount) {
+		return false
+	}
+	if pr.MaxGpuCount != nil && t.gpuCount > int(*pr.MaxGpuCount) {
+		return false
+	}
+	return true
+}
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAWSInstanceTypes(t *testing.T) {
+	tcs := []struct {
+		name             string
+		instType         string
+		wantFound        bool
+		wantResourceName string
+		wantGPUCount     int
+	}{
+		{
+			name:             "gpu instance type",
+			instType:         "g5.12xlarge",
+			wantFound:        true,
+			wantResourceName: accelerator.NvidiaGPU,
+			wantGPUCount:     4,
+		},
+		{
+			name:             "neuron instance type",
+			instType:         "trn1.32xlarge",
+			wantFound:        true,
+			wantResourceName: accelerator.AWSNeuron,
+			wantGPUCount:     16,
+		},
+		{
+			name:      "non-gpu instance type",
+			instType:  "m5.12xlarge",
+			wantFound: false,
+		},
+		{
+			name:      "invalid instance type",
+			instType:  "invalid",
+			wantFound: false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, found := awsInstanceTypes[tc.instType]
+			assert.Equal(t, tc.wantFound, found)
+			if !found {
+				return
+			}
+			assert.Equal(t, tc.wantResourceName, got.resourceName)
+			assert.Equal(t, tc.wantGPUCount, got.gpuCount)
+		})
+	}
+}
+
+func TestInstanceTypeFamilyAndCategory(t *testing.T) {
+	it := instanceType{name: "p4de.24xlarge"}
+	assert.Equal(t, "p4de", it.family())
+	assert.Equal(t, "p", it.category())
+}
@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/cache"
+)
+
+// Keys of the CPU and memory in namespace quotas. Other keys are the resource names of accelerators.
+const (
+	quotaKeyCPU    = "cpu"
+	quotaKeyMemory = "memory"
+)
+
+// quotaExceededReason returns the reason why the workload exceeds the ResourceQuota of the namespace.
+// It returns an empty string if the namespace has enough headroom or does not have any quota.
+//
+// Assumed pods in the namespace are counted as used since they are not reflected in the reported usage yet.
+func quotaExceededReason(c *cache.Cluster, namespace string, w Workload) string {
+	q := findNamespaceQuota(c, namespace)
+	if q == nil {
+		return ""
+	}
+
+	requested := map[string]int64{
+		quotaKeyCPU:    int64(w.PodCount * w.CPUMilicorePerPod),
+		quotaKeyMemory: int64(w.PodCount * w.MemoryMegabytesPerPod),
+	}
+	if w.GPUCountPerPod > 0 {
+		requested[w.resourceName()] = int64(w.PodCount * w.GPUCountPerPod)
+	}
+
+	assumed := map[string]int64{}
+	for key, p := range c.AssumedGPUPodsByKey {
+		// The key of an assumed pod is prefixed with its namespace.
+		if !strings.HasPrefix(key, namespace+"/") {
+			continue
+		}
+		assumed[quotaKeyCPU] += int64(p.CPUMilicore)
+		assumed[quotaKeyMemory] += int64(p.MemoryMegabytes)
+		if w.matchesResourceName(p.ResourceName) {
+			assumed[w.resourceName()] += int64(p.AllocatedCount)
+		}
+	}
+
+	for _, key := range []string{quotaKeyCPU, quotaKeyMemory, w.resourceName()} {
+		r := requested[key]
+		if r == 0 {
+			continue
+		}
+		hard, ok := q.Hard[key]
+		if !ok {
+			continue
+		}
+		if headroom := hard - q.Used[key] - assumed[key]; r > headroom {
+			return fmt.Sprintf("insufficient %s quota in namespace %q (requested: %d, available: %d)", key, namespace, r, max(headroom, 0))
+		}
+	}
+	return ""
+}
+
+func findNamespaceQuota(c *cache.Cluster, namespace string) *v1.NamespaceQuota {
+	for _, q := range c.NamespaceQuotas {
+		if q.Namespace == namespace {
+			return q
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"testing"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaExceededReason(t *testing.T) {
+	c := &cache.Cluster{
+		NamespaceQuotas: []*v1.NamespaceQuota{
+			{
+				Namespace: "ns0",
+				Hard: map[string]int64{
+					"cpu":            8000,
+					"memory":         16000,
+					"nvidia.com/gpu": 4,
+				},
+				Used: map[string]int64{
+					"cpu":            2000,
+					"memory":         4000,
+					"nvidia.com/gpu": 1,
+				},
+			},
+		},
+		AssumedGPUPodsByKey: map[string]*cache.AssumedGPUPod{
+			"ns0/job-0-":  {ResourceName: "nvidia.com/gpu", AllocatedCount: 1, CPUMilicore: 1000},
+			"ns1/job-1-":  {ResourceName: "nvidia.com/gpu", AllocatedCount: 4, CPUMilicore: 8000},
+			"ns0/job-2-":  {ResourceName: "amd.com/gpu", AllocatedCount: 2},
+			"ns00/job-3-": {ResourceName: "nvidia.com/gpu", AllocatedCount: 4},
+		},
+	}
+
+	tcs := []struct {
+		name      string
+		namespace string
+		w         Workload
+		wantOK    bool
+	}{
+		{
+			name:      "fits",
+			namespace: "ns0",
+			w:         Workload{PodCount: 2, GPUCountPerPod: 1, CPUMilicorePerPod: 2500, MemoryMegabytesPerPod: 6000},
+			wantOK:    true,
+		},
+		{
+			name:      "insufficient gpu quota",
+			namespace: "ns0",
+			w:         Workload{PodCount: 1, GPUCountPerPod: 3},
+		},
+		{
+			name:      "insufficient cpu quota",
+			namespace: "ns0",
+			w:         Workload{PodCount: 2, CPUMilicorePerPod: 3000},
+		},
+		{
+			name:      "quota of other accelerators",
+			namespace: "ns0",
+			w:         Workload{PodCount: 1, GPUCountPerPod: 4, AcceleratorType: "amd.com/gpu"},
+			wantOK:    true,
+		},
+		{
+			name:      "no quota",
+			namespace: "ns1",
+			w:         Workload{PodCount: 1, GPUCountPerPod: 8},
+			wantOK:    true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got := quotaExceededReason(c, tc.namespace, tc.w)
+			if tc.wantOK {
+				assert.Empty(t, got)
+			} else {
+				assert.NotEmpty(t, got)
+			}
+		})
+	}
+}
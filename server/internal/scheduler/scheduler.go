@@ -2,35 +2,37 @@ package scheduler
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
 	"github.com/llmariner/job-manager/server/internal/cache"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 )
 
-const (
-	// staleThreshold is the threshold for stale clusters. Clusters that have not been updated for longer than
-	// this threshold are considered are excluded from scheduling.
-	staleThreshold = 30 * time.Minute
-)
-
 // New creates a new scheduler.
 func New(
 	cache *cache.Store,
+	unreachableThreshold time.Duration,
 	logger logr.Logger,
 ) *S {
 	return &S{
-		cache:  cache,
-		logger: logger,
+		cache:                cache,
+		unreachableThreshold: unreachableThreshold,
+		logger:               logger,
 	}
 }
 
 // S is a scheduler.
 type S struct {
-	cache  *cache.Store
-	logger logr.Logger
+	cache *cache.Store
+	// unreachableThreshold is the threshold for unreachable clusters. Clusters that have not been updated for
+	// longer than this threshold are excluded from scheduling.
+	unreachableThreshold time.Duration
+	logger               logr.Logger
 }
 
 // SchedulingResult is the result of scheduling a workload.
@@ -40,13 +42,105 @@ type SchedulingResult struct {
 	Namespace   string
 }
 
+// Workload describes the resources requested by a workload.
+type Workload struct {
+	// PodCount is the number of pods of the workload. Pods of a multi-pod workload (e.g., workers of
+	// a multi-worker PyTorch job) are scheduled together as a gang, each on a distinct node.
+	PodCount int
+	// GPUCountPerPod is the number of accelerators requested by each pod.
+	GPUCountPerPod int
+	// AcceleratorType is the resource name of the requested accelerators (e.g., "nvidia.com/gpu").
+	// NVIDIA GPUs are assumed if empty.
+	AcceleratorType string
+	// GPUType is the GPU product name reported by NVIDIA GPU Feature Discovery (e.g., "NVIDIA-H100-80GB-HBM3").
+	// Any GPU product is accepted if empty.
+	GPUType string
+	// MinGPUMemoryMegabytes is the minimum memory size of each GPU in MiB.
+	MinGPUMemoryMegabytes int
+	// CPUMilicorePerPod and MemoryMegabytesPerPod are the CPU and memory requested by each pod.
+	CPUMilicorePerPod     int
+	MemoryMegabytesPerPod int
+	// RequiredClusters are the IDs or names of the clusters where the workload must run.
+	// Any cluster is accepted if empty.
+	RequiredClusters []string
+	// ClusterSelector is the labels that the cluster must have.
+	ClusterSelector map[string]string
+	// PreferredClusters are the IDs or names of the clusters where the workload preferably runs.
+	// A feasible preferred cluster is always picked up over other clusters.
+	PreferredClusters []string
+	// PreferredClusterSelector is the labels of the clusters where the workload preferably runs.
+	// Clusters that have the labels are preferred in the same way as PreferredClusters.
+	PreferredClusterSelector map[string]string
+	// Namespace is the namespace where the workload runs. It must be one of the namespaces assigned to the project.
+	// The first namespace assigned to the project in each cluster is used if empty.
+	Namespace string
+}
+
+// namespace returns the namespace where the workload runs among the namespaces assigned in a cluster.
+// It returns false if none of the namespaces can be used.
+func (w Workload) namespace(assigned []string) (string, bool) {
+	if len(assigned) == 0 {
+		return "", false
+	}
+	if w.Namespace == "" {
+		return assigned[0], true
+	}
+	for _, ns := range assigned {
+		if ns == w.Namespace {
+			return ns, true
+		}
+	}
+	return "", false
+}
+
+// assignedNamespacesByCluster returns a map from cluster IDs to the namespaces assigned to the user
+// in the order of the assignments.
+func assignedNamespacesByCluster(userInfo *auth.UserInfo) map[string][]string {
+	namespacesByCluster := map[string][]string{}
+	for _, env := range userInfo.AssignedKubernetesEnvs {
+		namespacesByCluster[env.ClusterID] = append(namespacesByCluster[env.ClusterID], env.Namespace)
+	}
+	return namespacesByCluster
+}
+
+// isRequired returns true if the cluster satisfies the required placement constraints of the workload.
+func (w Workload) isRequired(c *cache.Cluster) bool {
+	if len(w.RequiredClusters) > 0 && !matchesCluster(c, w.RequiredClusters) {
+		return false
+	}
+	return matchesLabels(c.Labels, w.ClusterSelector)
+}
+
+// isPreferred returns true if the cluster is one of the preferred clusters of the workload.
+func (w Workload) isPreferred(c *cache.Cluster) bool {
+	if matchesCluster(c, w.PreferredClusters) {
+		return true
+	}
+	return len(w.PreferredClusterSelector) > 0 && matchesLabels(c.Labels, w.PreferredClusterSelector)
+}
+
+// PodResources returns the resources requested by each pod of the workload.
+func (w Workload) PodResources() cache.PodResources {
+	return cache.PodResources{
+		ResourceName:    w.AcceleratorType,
+		GPUCount:        w.GPUCountPerPod,
+		CPUMilicore:     w.CPUMilicorePerPod,
+		MemoryMegabytes: w.MemoryMegabytesPerPod,
+	}
+}
+
 // Schedule returns a Kubernetes cluster and a namespace where a workload can be scheduled.
-// Currently it simply picks up one of the clusters that can provision GPU resources.
-// The function returns an error if a workload is not schedulable.
+// Currently it simply picks up one of the clusters that can provision the requested accelerators.
+// All pods of the workload are scheduled to the same cluster, and each of them is placed on a distinct node.
+// The function returns an error if any of the pods is not schedulable.
 // PrevScheduledClusterID is the cluster where the workload was previously scheduled. Schedule
 // will not reschedule the workload to the same cluster.
 // TODO(kenji): Improve the algorithm.
-func (s *S) Schedule(userInfo *auth.UserInfo, prevScheduledClusterID string, gpuCount int) (SchedulingResult, error) {
+func (s *S) Schedule(userInfo *auth.UserInfo, prevScheduledClusterID string, w Workload) (SchedulingResult, error) {
+	if w.PodCount <= 0 {
+		return SchedulingResult{}, fmt.Errorf("pod count must be positive")
+	}
+
 	clusters, err := s.cache.ListClustersByTenantID(userInfo.TenantID)
 	if err != nil {
 		return SchedulingResult{}, err
@@ -59,20 +153,19 @@ func (s *S) Schedule(userInfo *auth.UserInfo, prevScheduledClusterID string, gpu
 		return SchedulingResult{}, fmt.Errorf("no assigned Kubernetes environments")
 	}
 
-	namespacesByCluster := map[string]string{}
-	for _, env := range userInfo.AssignedKubernetesEnvs {
-		namespacesByCluster[env.ClusterID] = env.Namespace
-	}
-	s.logger.V(1).Info("Scheduling a workload", "gpuCount", gpuCount, "assignedClustersEnvs", userInfo.AssignedKubernetesEnvs)
+	namespacesByCluster := assignedNamespacesByCluster(userInfo)
+	s.logger.V(1).Info("Scheduling a workload", "workload", w, "assignedClustersEnvs", userInfo.AssignedKubernetesEnvs)
 
 	var (
-		bestResult *SchedulingResult
-		bestScore  float64
+		bestResult    *SchedulingResult
+		bestScore     float64
+		bestPreferred bool
 	)
 	var infeasibleReasons []string
+	now := time.Now()
 	for _, c := range clusters {
-		if time.Since(c.UpdatedAt) > staleThreshold {
-			s.logger.V(1).Info("Ignoring a stale cluster", "clusterID", c.ClusterID)
+		if time.Since(c.UpdatedAt) > s.unreachableThreshold {
+			s.logger.V(1).Info("Ignoring an unreachable cluster", "clusterID", c.ClusterID)
 			continue
 		}
 		if c.ClusterID == prevScheduledClusterID {
@@ -80,13 +173,32 @@ func (s *S) Schedule(userInfo *auth.UserInfo, prevScheduledClusterID string, gpu
 			continue
 		}
 
-		ns, ok := namespacesByCluster[c.ClusterID]
+		assigned, ok := namespacesByCluster[c.ClusterID]
 		if !ok {
 			s.logger.V(1).Info("Ignoring a cluster that is not assigned to the user", "clusterID", c.ClusterID)
 			continue
 		}
+		ns, ok := w.namespace(assigned)
+		if !ok {
+			s.logger.V(1).Info("Ignoring a cluster where the requested namespace is not assigned", "clusterID", c.ClusterID, "namespace", w.Namespace)
+			continue
+		}
+		if !w.isRequired(c) {
+			s.logger.V(1).Info("Ignoring a cluster that does not satisfy the placement constraints", "clusterID", c.ClusterID)
+			continue
+		}
+		if reason := unavailableReason(c, now); reason != "" {
+			s.logger.V(1).Info("Ignoring an unavailable cluster", "clusterID", c.ClusterID, "reason", reason)
+			infeasibleReasons = append(infeasibleReasons, fmt.Sprintf("{cluster: %q, reason: %q}", c.ClusterName, reason))
+			continue
+		}
+		if reason := quotaExceededReason(c, ns, w); reason != "" {
+			s.logger.V(1).Info("Ignoring a cluster where the namespace quota is exceeded", "clusterID", c.ClusterID, "namespace", ns, "reason", reason)
+			infeasibleReasons = append(infeasibleReasons, fmt.Sprintf("{cluster: %q, reason: %q}", c.ClusterName, reason))
+			continue
+		}
 
-		score, err := s.scoreCluster(c, gpuCount)
+		score, err := s.scoreCluster(c, w)
 		if err != nil {
 			return SchedulingResult{}, err
 		}
@@ -98,13 +210,17 @@ func (s *S) Schedule(userInfo *auth.UserInfo, prevScheduledClusterID string, gpu
 			infeasibleReasons = append(infeasibleReasons, fmt.Sprintf("{cluster: %q, reason: %q}", c.ClusterName, score.infeasibleReason))
 			continue
 		}
-		if bestResult == nil || score.score > bestScore {
+		preferred := w.isPreferred(c)
+		if bestResult == nil ||
+			(preferred && !bestPreferred) ||
+			(preferred == bestPreferred && score.score > bestScore) {
 			bestResult = &SchedulingResult{
 				ClusterID:   c.ClusterID,
 				ClusterName: c.ClusterName,
 				Namespace:   ns,
 			}
 			bestScore = score.score
+			bestPreferred = preferred
 		}
 	}
 
@@ -120,90 +236,322 @@ func (s *S) Schedule(userInfo *auth.UserInfo, prevScheduledClusterID string, gpu
 	return *bestResult, nil
 }
 
+// unavailableReason returns the reason why the cluster is excluded from scheduling by administrators.
+// It returns an empty string if the cluster is available.
+func unavailableReason(c *cache.Cluster, now time.Time) string {
+	if c.Cordoned {
+		return "cluster is cordoned"
+	}
+	if c.InMaintenance(now) {
+		return "cluster is under maintenance"
+	}
+	return ""
+}
+
+// matchesCluster returns true if one of the given IDs or names refers to the cluster.
+func matchesCluster(c *cache.Cluster, idsOrNames []string) bool {
+	for _, v := range idsOrNames {
+		if v == c.ClusterID || v == c.ClusterName {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesLabels returns true if the labels have all the key-value pairs of the selector.
+func matchesLabels(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if lv, ok := labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+	return true
+}
+
 type schedulingScore struct {
 	isFeasible       bool
 	score            float64
 	infeasibleReason string
 }
 
-func (s *S) scoreCluster(c *cache.Cluster, requestedGPUs int) (schedulingScore, error) {
+func (s *S) scoreCluster(c *cache.Cluster, w Workload) (schedulingScore, error) {
+	requestedGPUs := w.PodCount * w.GPUCountPerPod
 	if requestedGPUs == 0 {
-		// Simply assume that the workload can run there.
+		if !fitsCPUAndMemory(c, w) && len(c.ProvisionableResources) == 0 {
+			return schedulingScore{
+				isFeasible:       false,
+				infeasibleReason: "insufficient CPU or memory resources",
+			}, nil
+		}
+		// Prefer a cluster that has the largest amount of free CPU. The score is zero
+		// if the cluster does not report its nodes.
 		return schedulingScore{
 			isFeasible: true,
-			score:      0,
+			score:      float64(freeCPUMilicore(c)),
 		}, nil
 	}
 
-	ok, err := s.canProvisionGPUs(requestedGPUs, c)
+	ok, err := s.canProvisionGPUs(w, c)
 	if err != nil {
 		return schedulingScore{}, err
 	}
 	if !ok {
+		reason := fmt.Sprintf("insufficient %s resources", w.describeGPU())
+		if w.PodCount > 1 && len(c.GPUNodes) > 0 && requestedGPUs <= availableGPUs(c, w) {
+			reason = "insufficient GPU nodes to place all pods of the gang"
+		}
+		return schedulingScore{
+			isFeasible:       false,
+			infeasibleReason: reason,
+		}, nil
+	}
+	// New nodes are assumed to have enough CPU and memory as the capacity of instance types
+	// is modeled only by their accelerators.
+	if !fitsCPUAndMemory(c, w) && !canScaleUp(w, c) {
 		return schedulingScore{
 			isFeasible:       false,
-			infeasibleReason: "insufficient GPU resources",
-		}, err
+			infeasibleReason: "insufficient CPU or memory resources",
+		}, nil
 	}
 
 	return schedulingScore{
 		isFeasible: true,
 		// TODO(kenji): Improve the scoring algorithm. Currently it is a simple best-fit where a capacility with the largest
 		// number of available GPUs is selected.
-		score: float64(availableGPUs(c)),
+		score: float64(availableGPUs(c, w)),
 	}, nil
 }
 
-// canProvisionGPUs returns true if the cluster can provision GPUs.
+// canProvisionGPUs returns true if the cluster can provision the accelerators requested by the workload,
+// either with its existing nodes or by scaling up.
 //
-// TODO(kenji): Support other cloud providers and non-Nvidia GPUs.
-func (s *S) canProvisionGPUs(requestedGPUs int, c *cache.Cluster) (bool, error) {
+// TODO(kenji): Support other cloud providers.
+func (s *S) canProvisionGPUs(w Workload, c *cache.Cluster) (bool, error) {
+	requestedGPUs := w.PodCount * w.GPUCountPerPod
 	if len(c.GPUNodes) > 0 {
 		// TODO(kenji): Take into resource fragmentation.
-		avail := availableGPUs(c)
-		s.logger.V(3).Info("Checking GPU resources", "requestedGPUs", requestedGPUs, "availableGPUs", avail)
-		return requestedGPUs <= avail, nil
+		avail := availableGPUs(c, w)
+		s.logger.V(3).Info("Checking GPU resources", "gpu", w.describeGPU(), "requestedGPUs", requestedGPUs, "availableGPUs", avail)
+		if requestedGPUs <= avail && (w.PodCount <= 1 || fitsOnDistinctNodes(c, w)) {
+			return true, nil
+		}
 	}
 
-	// TODO(guangrui): Consider to check if the instance type is GPU instance type.
-	if len(c.ProvisionableResources) > 0 {
-		return true, nil
-	}
-	return false, nil
+	return canScaleUp(w, c), nil
 }
 
-func availableGPUs(c *cache.Cluster) int {
+// availableGPUs returns the number of unallocated healthy accelerators that match the request of the workload.
+func availableGPUs(c *cache.Cluster, w Workload) int {
 	var allocatable int
+	matched := map[string]bool{}
+	unhealthy := unhealthyNodeNames(c)
 	for _, n := range c.GPUNodes {
-		allocatable += int(n.AllocatableCount)
+		if w.matchesNode(n) {
+			allocatable += int(n.AllocatableCount)
+			matched[n.NodeName] = true
+		}
 	}
 	var allocated int
 	for _, p := range c.GPUPods {
+		if !w.matchesResourceName(p.ResourceName) {
+			continue
+		}
+		if unhealthy[p.NodeName] {
+			// The accelerators of the node are not counted as allocatable.
+			continue
+		}
+		if w.requestsGPUModel() && !matched[p.NodeName] {
+			// The pod runs on a node with a different GPU model.
+			continue
+		}
 		allocated += int(p.AllocatedCount)
 	}
+	// Assumed pods are not bound to nodes yet. Conservatively assume that they run on the matching nodes.
 	for _, p := range c.AssumedGPUPodsByKey {
-		allocated += int(p.AllocatedCount)
+		if w.matchesResourceName(p.ResourceName) {
+			allocated += int(p.AllocatedCount)
+		}
 	}
 
 	return allocatable - allocated
 }
 
-func isAWSInstanceTypeForNvidiaGPU(instType string) (bool, error) {
-	// Get the family from the instance type.
-	l := strings.Split(instType, ".")
-	if len(l) != 2 {
-		return false, fmt.Errorf("invalid instance type: %s", instType)
+// resourceName returns the resource name of the accelerators requested by the workload.
+func (w Workload) resourceName() string {
+	return accelerator.ResourceNameOrDefault(w.AcceleratorType)
+}
+
+// requestsGPUModel returns true if the workload requests a specific GPU model.
+func (w Workload) requestsGPUModel() bool {
+	return w.GPUType != "" || w.MinGPUMemoryMegabytes > 0
+}
+
+// matchesResourceName returns true if the reported resource name matches the one requested by the workload.
+// An empty resource name is reported by an old version of the dispatcher that only supports NVIDIA GPUs.
+func (w Workload) matchesResourceName(reported string) bool {
+	return accelerator.ResourceNameOrDefault(reported) == w.resourceName()
+}
+
+// unhealthyNodeNames returns the names of the GPU nodes that cannot run workloads.
+func unhealthyNodeNames(c *cache.Cluster) map[string]bool {
+	names := map[string]bool{}
+	for _, n := range c.GPUNodes {
+		if len(n.UnhealthyReasons) > 0 {
+			names[n.NodeName] = true
+		}
+	}
+	return names
+}
+
+// matchesNode returns true if the node is healthy and has the accelerators requested by the workload.
+func (w Workload) matchesNode(n *v1.GpuNode) bool {
+	if len(n.UnhealthyReasons) > 0 {
+		return false
+	}
+	if !w.matchesResourceName(n.ResourceName) {
+		return false
+	}
+	if w.GPUType != "" && n.Product != w.GPUType {
+		return false
+	}
+	if w.MinGPUMemoryMegabytes > 0 && int(n.MemoryMegabytes) < w.MinGPUMemoryMegabytes {
+		return false
+	}
+	return true
+}
+
+// describeGPU returns a human-readable description of the requested accelerators.
+func (w Workload) describeGPU() string {
+	d := w.resourceName()
+	if w.GPUType != "" {
+		d += fmt.Sprintf(" (%s)", w.GPUType)
+	}
+	if w.MinGPUMemoryMegabytes > 0 {
+		d += fmt.Sprintf(" (>= %d MiB memory)", w.MinGPUMemoryMegabytes)
+	}
+	return d
+}
+
+// fitsOnDistinctNodes returns true if each of the pods of the workload can be placed on a distinct node
+// that has enough free accelerators.
+func fitsOnDistinctNodes(c *cache.Cluster, w Workload) bool {
+	free := map[string]int{}
+	for _, n := range c.GPUNodes {
+		if !w.matchesNode(n) {
+			continue
+		}
+		if n.NodeName == "" {
+			// The node name is not reported by an old version of the dispatcher. Skip the check
+			// as we cannot tell how allocated GPUs are distributed over nodes.
+			return true
+		}
+		free[n.NodeName] += int(n.AllocatableCount)
+	}
+	for _, p := range c.GPUPods {
+		if !w.matchesResourceName(p.ResourceName) {
+			continue
+		}
+		if _, ok := free[p.NodeName]; ok {
+			free[p.NodeName] -= int(p.AllocatedCount)
+		}
+	}
+
+	frees := make([]int, 0, len(free))
+	for _, f := range free {
+		frees = append(frees, f)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(frees)))
+
+	// Assumed pods are not bound to nodes yet. Conservatively assume that each of them
+	// takes GPUs from the node that has the largest number of free GPUs.
+	assumed := make([]int, 0, len(c.AssumedGPUPodsByKey))
+	for _, p := range c.AssumedGPUPodsByKey {
+		if !w.matchesResourceName(p.ResourceName) {
+			continue
+		}
+		assumed = append(assumed, int(p.AllocatedCount))
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(assumed)))
+	for _, a := range assumed {
+		if len(frees) == 0 {
+			break
+		}
+		frees[0] -= a
+		sort.Sort(sort.Reverse(sort.IntSlice(frees)))
+	}
+
+	var n int
+	for _, f := range frees {
+		if f >= w.GPUCountPerPod {
+			n++
+		}
+	}
+	return n >= w.PodCount
+}
+
+// nodeResources are the free CPU and memory of a node.
+type nodeResources struct {
+	cpuMilicore     int
+	memoryMegabytes int
+}
+
+// freeNodeResources returns the free CPU and memory of each node in the cluster.
+func freeNodeResources(c *cache.Cluster) []nodeResources {
+	frees := make([]nodeResources, 0, len(c.Nodes))
+	for _, n := range c.Nodes {
+		frees = append(frees, nodeResources{
+			cpuMilicore:     int(n.AllocatableCpuMilicore - n.RequestedCpuMilicore),
+			memoryMegabytes: int(n.AllocatableMemoryMegabytes - n.RequestedMemoryMegabytes),
+		})
+	}
+	if len(frees) == 0 {
+		return frees
 	}
 
-	return isAWSInstanceFamilyForNvidiaGPU(l[0])
+	// Assumed pods are not bound to nodes yet. Conservatively assume that each of them
+	// takes CPU and memory from the node that has the largest amount of free CPU.
+	assumed := make([]*cache.AssumedGPUPod, 0, len(c.AssumedGPUPodsByKey))
+	for _, p := range c.AssumedGPUPodsByKey {
+		assumed = append(assumed, p)
+	}
+	sort.Slice(assumed, func(i, j int) bool {
+		return assumed[i].CPUMilicore > assumed[j].CPUMilicore
+	})
+	for _, p := range assumed {
+		sort.Slice(frees, func(i, j int) bool {
+			return frees[i].cpuMilicore > frees[j].cpuMilicore
+		})
+		frees[0].cpuMilicore -= int(p.CPUMilicore)
+		frees[0].memoryMegabytes -= int(p.MemoryMegabytes)
+	}
+	return frees
+}
+
+// fitsCPUAndMemory returns true if each of the pods of the workload can be placed on a distinct node
+// that has enough free CPU and memory.
+func fitsCPUAndMemory(c *cache.Cluster, w Workload) bool {
+	if w.CPUMilicorePerPod == 0 && w.MemoryMegabytesPerPod == 0 {
+		return true
+	}
+	if len(c.Nodes) == 0 {
+		// The nodes are not reported by an old version of the dispatcher.
+		return true
+	}
+
+	var n int
+	for _, f := range freeNodeResources(c) {
+		if f.cpuMilicore >= w.CPUMilicorePerPod && f.memoryMegabytes >= w.MemoryMegabytesPerPod {
+			n++
+		}
+	}
+	return n >= max(w.PodCount, 1)
 }
 
-func isAWSInstanceFamilyForNvidiaGPU(instFamily string) (bool, error) {
-	gpuInsts := map[string]bool{
-		"g5":   true,
-		"p4d":  true,
-		"p4de": true,
-		"p5":   true,
+// freeCPUMilicore returns the total amount of free CPU in the cluster.
+func freeCPUMilicore(c *cache.Cluster) int {
+	var total int
+	for _, f := range freeNodeResources(c) {
+		total += max(f.cpuMilicore, 0)
 	}
-	return gpuInsts[instFamily], nil
+	return total
 }
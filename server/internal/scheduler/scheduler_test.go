@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/job-manager/api/v1"
@@ -18,13 +19,19 @@ func TestSchedule(t *testing.T) {
 	)
 
 	tcs := []struct {
-		name          string
-		clusters      []*store.Cluster
-		userInfo      *auth.UserInfo
-		gpuCount      int
-		prevClusterID string
-		want          SchedulingResult
-		wantErr       bool
+		name            string
+		clusters        []*store.Cluster
+		userInfo        *auth.UserInfo
+		gpuCount        int
+		acceleratorType string
+		prevClusterID   string
+		required        []string
+		preferred       []string
+		selector        map[string]string
+		preferredLabels map[string]string
+		namespace       string
+		want            SchedulingResult
+		wantErr         bool
 	}{
 		{
 			name:     "no clusters",
@@ -193,199 +200,1384 @@ func TestSchedule(t *testing.T) {
 				Namespace: "namespace0",
 			},
 		},
-	}
-
-	for _, tc := range tcs {
-		t.Run(tc.name, func(t *testing.T) {
-			st, tearDown := store.NewTest(t)
-			defer tearDown()
-
-			for _, c := range tc.clusters {
-				_, err := st.CreateOrUpdateCluster(c)
-				assert.NoError(t, err)
-			}
-
-			sched := New(cache.NewStore(st, testr.New(t)), testr.New(t))
-			got, err := sched.Schedule(tc.userInfo, tc.prevClusterID, tc.gpuCount)
-			if tc.wantErr {
-				assert.Error(t, err)
-				return
-			}
-			assert.NoError(t, err)
-			assert.Equal(t, tc.want, got)
-		})
-	}
-}
-
-func TestCanProvisionGPUs(t *testing.T) {
-	tcs := []struct {
-		name          string
-		status        *cache.Cluster
-		requestedGPUs int
-		want          bool
-	}{
-		{
-			name:          "no gpu nodes and no provisionable resources",
-			status:        &cache.Cluster{},
-			requestedGPUs: 1,
-			want:          false,
-		},
 		{
-			name: "gpu nodes",
-			status: &cache.Cluster{
-				GPUNodes: []*v1.GpuNode{
+			name: "cluster with the requested accelerator",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 16,
+							},
+						},
+					}),
+				},
+				{
+					ClusterID: "cluster1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "amd.com/gpu",
+								AllocatableCount: 8,
+							},
+						},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
 					{
-						ResourceName:     "nvidia.com/gpu",
-						AllocatableCount: 1,
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
 					},
 				},
-				ProvisionableResources: []*v1.ProvisionableResource{},
 			},
-			requestedGPUs: 1,
-			want:          true,
+			gpuCount:        1,
+			acceleratorType: "amd.com/gpu",
+			want: SchedulingResult{
+				ClusterID: "cluster1",
+				Namespace: "namespace1",
+			},
 		},
 		{
-			name: "gpu nodes with unallocated gpus",
-			status: &cache.Cluster{
-				GPUNodes: []*v1.GpuNode{
-					{
-						ResourceName:     "nvidia.com/gpu",
-						AllocatableCount: 8,
-					},
+			name: "cordoned cluster",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Cordoned:  true,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 2,
+							},
+						},
+					}),
 				},
-				GPUPods: []*v1.GpuPod{
-					{AllocatedCount: 4},
+				{
+					ClusterID: "cluster1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
 				},
-				ProvisionableResources: []*v1.ProvisionableResource{},
 			},
-			requestedGPUs: 2,
-			want:          true,
-		},
-		{
-			name: "gpu nodes with insufficient gpus",
-			status: &cache.Cluster{
-				GPUNodes: []*v1.GpuNode{
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
 					{
-						ResourceName:     "nvidia.com/gpu",
-						AllocatableCount: 8,
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
 					},
 				},
-				GPUPods: []*v1.GpuPod{
-					{AllocatedCount: 7},
-				},
-				ProvisionableResources: []*v1.ProvisionableResource{},
 			},
-			requestedGPUs: 2,
-			want:          false,
+			gpuCount: 1,
+			want: SchedulingResult{
+				ClusterID: "cluster1",
+				Namespace: "namespace1",
+			},
 		},
 		{
-			name: "gpu nodes with insufficient gpus",
-			status: &cache.Cluster{
-				GPUNodes: []*v1.GpuNode{
-					{
-						ResourceName:     "nvidia.com/gpu",
-						AllocatableCount: 8,
+			name: "cluster under maintenance",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					MaintenanceWindows: []store.MaintenanceWindow{
+						{StartTime: time.Now().Add(-time.Hour), EndTime: time.Now().Add(time.Hour)},
 					},
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 2,
+							},
+						},
+					}),
 				},
-				GPUPods: []*v1.GpuPod{
-					{AllocatedCount: 3},
+				{
+					ClusterID: "cluster1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
 				},
-				AssumedGPUPodsByKey: map[string]*cache.AssumedGPUPod{
-					"ns-0/pod-1": {AllocatedCount: 4},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
+					},
 				},
-				ProvisionableResources: []*v1.ProvisionableResource{},
 			},
-			requestedGPUs: 2,
-			want:          false,
+			gpuCount: 1,
+			want: SchedulingResult{
+				ClusterID: "cluster1",
+				Namespace: "namespace1",
+			},
 		},
 		{
-			name: "provisionable resources with gpu instance type",
-			status: &cache.Cluster{
-				GPUNodes: []*v1.GpuNode{},
-				ProvisionableResources: []*v1.ProvisionableResource{
+			name: "future maintenance window",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					MaintenanceWindows: []store.MaintenanceWindow{
+						{StartTime: time.Now().Add(time.Hour), EndTime: time.Now().Add(2 * time.Hour)},
+					},
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 2,
+							},
+						},
+					}),
+				},
+				{
+					ClusterID: "cluster1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
 					{
-						InstanceType: "g5.12xlarge",
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
 					},
 				},
 			},
-			requestedGPUs: 1,
-			want:          true,
-		},
-	}
-
-	for _, tc := range tcs {
-		t.Run(tc.name, func(t *testing.T) {
-			s := S{logger: testr.New(t)}
-			got, err := s.canProvisionGPUs(tc.requestedGPUs, tc.status)
-			assert.NoError(t, err)
-			assert.Equal(t, tc.want, got)
-		})
-	}
-}
-
-func TestIsAWSInstanceTypeForNvidiaGPU(t *testing.T) {
-	tcs := []struct {
-		name     string
-		instType string
-		want     bool
-		wantErr  bool
-	}{
-		{
-			name:     "gpu instance type",
-			instType: "g5.12xlarge",
-			want:     true,
-		},
-		{
-			name:     "non-gpu instance type",
-			instType: "m5.12xlarge",
-			want:     false,
+			gpuCount: 1,
+			want: SchedulingResult{
+				ClusterID: "cluster0",
+				Namespace: "namespace0",
+			},
 		},
 		{
-			name:     "invalid instance type",
-			instType: "invalid",
-			wantErr:  true,
+			name: "required cluster",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 2,
+							},
+						},
+					}),
+				},
+				{
+					ClusterID: "cluster1",
+					Name:      "c1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
+					},
+				},
+			},
+			gpuCount: 1,
+			required: []string{"c1"},
+			want: SchedulingResult{
+				ClusterID:   "cluster1",
+				ClusterName: "c1",
+				Namespace:   "namespace1",
+			},
+		},
+		{
+			name: "preferred cluster",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 2,
+							},
+						},
+					}),
+				},
+				{
+					ClusterID: "cluster1",
+					Name:      "c1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
+					},
+				},
+			},
+			gpuCount:  1,
+			preferred: []string{"cluster1"},
+			want: SchedulingResult{
+				ClusterID:   "cluster1",
+				ClusterName: "c1",
+				Namespace:   "namespace1",
+			},
+		},
+		{
+			name: "infeasible required cluster",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 2,
+							},
+						},
+					}),
+				},
+				{
+					ClusterID: "cluster1",
+					Name:      "c1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
+					},
+				},
+			},
+			gpuCount: 2,
+			required: []string{"cluster1"},
+			wantErr:  true,
+		},
+		{
+			name: "cluster selector",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Labels:    map[string]string{"region": "us-east-1"},
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 2,
+							},
+						},
+					}),
+				},
+				{
+					ClusterID: "cluster1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+						Labels: map[string]string{"region": "us-west-2", "network-fabric": "infiniband"},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
+					},
+				},
+			},
+			gpuCount: 1,
+			selector: map[string]string{"network-fabric": "infiniband"},
+			want: SchedulingResult{
+				ClusterID: "cluster1",
+				Namespace: "namespace1",
+			},
+		},
+		{
+			name: "preferred cluster selector",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Labels:    map[string]string{"region": "us-east-1"},
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 2,
+							},
+						},
+					}),
+				},
+				{
+					ClusterID: "cluster1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+						Labels: map[string]string{"region": "us-west-2", "network-fabric": "infiniband"},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
+					},
+				},
+			},
+			gpuCount:        1,
+			preferredLabels: map[string]string{"region": "us-west-2"},
+			want: SchedulingResult{
+				ClusterID: "cluster1",
+				Namespace: "namespace1",
+			},
+		},
+		{
+			name: "no cluster matching selector",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Labels:    map[string]string{"region": "us-east-1"},
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 2,
+							},
+						},
+					}),
+				},
+				{
+					ClusterID: "cluster1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+						Labels: map[string]string{"region": "us-west-2", "network-fabric": "infiniband"},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
+					},
+				},
+			},
+			gpuCount: 1,
+			selector: map[string]string{"region": "eu-west-1"},
+			wantErr:  true,
+		},
+		{
+			name: "default namespace among multiple namespaces",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "team-a-prod",
+					},
+					{
+						ClusterID: "cluster0",
+						Namespace: "team-a-dev",
+					},
+				},
+			},
+			gpuCount: 1,
+			want: SchedulingResult{
+				ClusterID: "cluster0",
+				Namespace: "team-a-prod",
+			},
+		},
+		{
+			name: "requested namespace",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "team-a-prod",
+					},
+					{
+						ClusterID: "cluster0",
+						Namespace: "team-a-dev",
+					},
+				},
+			},
+			gpuCount:  1,
+			namespace: "team-a-dev",
+			want: SchedulingResult{
+				ClusterID: "cluster0",
+				Namespace: "team-a-dev",
+			},
+		},
+		{
+			name: "unassigned namespace",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "team-a-prod",
+					},
+					{
+						ClusterID: "cluster0",
+						Namespace: "team-a-dev",
+					},
+				},
+			},
+			gpuCount:  1,
+			namespace: "team-b",
+			wantErr:   true,
+		},
+		{
+			name: "namespace quota exceeded",
+			clusters: []*store.Cluster{
+				{
+					ClusterID: "cluster0",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 4,
+							},
+						},
+						NamespaceQuotas: []*v1.NamespaceQuota{
+							{
+								Namespace: "namespace0",
+								Hard:      map[string]int64{"nvidia.com/gpu": 2},
+								Used:      map[string]int64{"nvidia.com/gpu": 2},
+							},
+						},
+					}),
+				},
+				{
+					ClusterID: "cluster1",
+					TenantID:  tenantID,
+					Status: marshalStatus(t, &v1.ClusterStatus{
+						GpuNodes: []*v1.GpuNode{
+							{
+								ResourceName:     "nvidia.com/gpu",
+								AllocatableCount: 1,
+							},
+						},
+					}),
+				},
+			},
+			userInfo: &auth.UserInfo{
+				TenantID: tenantID,
+				AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+					{
+						ClusterID: "cluster0",
+						Namespace: "namespace0",
+					},
+					{
+						ClusterID: "cluster1",
+						Namespace: "namespace1",
+					},
+				},
+			},
+			gpuCount: 1,
+			want: SchedulingResult{
+				ClusterID: "cluster1",
+				Namespace: "namespace1",
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			for _, c := range tc.clusters {
+				_, err := st.CreateOrUpdateCluster(c)
+				assert.NoError(t, err)
+			}
+
+			sched := New(cache.NewStore(st, testr.New(t)), 30*time.Minute, testr.New(t))
+			got, err := sched.Schedule(tc.userInfo, tc.prevClusterID, Workload{
+				PodCount:                 1,
+				GPUCountPerPod:           tc.gpuCount,
+				AcceleratorType:          tc.acceleratorType,
+				RequiredClusters:         tc.required,
+				PreferredClusters:        tc.preferred,
+				ClusterSelector:          tc.selector,
+				PreferredClusterSelector: tc.preferredLabels,
+				Namespace:                tc.namespace,
+			})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestScheduleGang(t *testing.T) {
+	const (
+		tenantID = "tenant0"
+	)
+
+	userInfo := &auth.UserInfo{
+		TenantID: tenantID,
+		AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+			{
+				ClusterID: "cluster0",
+				Namespace: "namespace0",
+			},
+		},
+	}
+
+	tcs := []struct {
+		name           string
+		status         *v1.ClusterStatus
+		podCount       int
+		gpuCountPerPod int
+		wantErr        bool
+	}{
+		{
+			name: "distinct nodes",
+			status: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n0"},
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n1"},
+				},
+			},
+			podCount:       2,
+			gpuCountPerPod: 8,
+		},
+		{
+			name: "insufficient total GPUs",
+			status: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n0"},
+				},
+			},
+			podCount:       4,
+			gpuCountPerPod: 8,
+			wantErr:        true,
+		},
+		{
+			name: "fragmented GPUs",
+			status: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n0"},
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n1"},
+				},
+				GpuPods: []*v1.GpuPod{
+					{ResourceName: "nvidia.com/gpu", AllocatedCount: 4, NamespacedName: "ns/p0", NodeName: "n0"},
+				},
+			},
+			podCount:       2,
+			gpuCountPerPod: 6,
+			wantErr:        true,
+		},
+		{
+			name: "unhealthy node",
+			status: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n0"},
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n1", UnhealthyReasons: []string{"NotReady"}},
+				},
+			},
+			podCount:       2,
+			gpuCountPerPod: 8,
+			wantErr:        true,
+		},
+		{
+			name: "pods on unhealthy node",
+			status: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n0"},
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n1", UnhealthyReasons: []string{"NotReady"}},
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n2"},
+				},
+				GpuPods: []*v1.GpuPod{
+					{ResourceName: "nvidia.com/gpu", AllocatedCount: 8, NamespacedName: "ns/p0", NodeName: "n1"},
+				},
+			},
+			podCount:       2,
+			gpuCountPerPod: 8,
+		},
+		{
+			name: "node names not reported",
+			status: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 16},
+				},
+			},
+			podCount:       2,
+			gpuCountPerPod: 8,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			_, err := st.CreateOrUpdateCluster(&store.Cluster{
+				ClusterID: "cluster0",
+				TenantID:  tenantID,
+				Status:    marshalStatus(t, tc.status),
+			})
+			assert.NoError(t, err)
+
+			sched := New(cache.NewStore(st, testr.New(t)), 30*time.Minute, testr.New(t))
+			got, err := sched.Schedule(userInfo, "", Workload{PodCount: tc.podCount, GPUCountPerPod: tc.gpuCountPerPod})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "cluster0", got.ClusterID)
+		})
+	}
+}
+
+func TestScheduleCPUAndMemory(t *testing.T) {
+	const (
+		tenantID = "tenant0"
+	)
+
+	userInfo := &auth.UserInfo{
+		TenantID: tenantID,
+		AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+			{
+				ClusterID: "cluster0",
+				Namespace: "namespace0",
+			},
+		},
+	}
+
+	nodes := []*v1.Node{
+		{Name: "n0", AllocatableCpuMilicore: 8000, AllocatableMemoryMegabytes: 32000, RequestedCpuMilicore: 6000, RequestedMemoryMegabytes: 8000},
+		{Name: "n1", AllocatableCpuMilicore: 8000, AllocatableMemoryMegabytes: 32000, RequestedCpuMilicore: 2000, RequestedMemoryMegabytes: 24000},
+	}
+
+	tcs := []struct {
+		name     string
+		status   *v1.ClusterStatus
+		assumed  *cache.PodResources
+		workload Workload
+		wantErr  bool
+	}{
+		{
+			name:     "fits",
+			status:   &v1.ClusterStatus{Nodes: nodes},
+			workload: Workload{PodCount: 1, CPUMilicorePerPod: 4000, MemoryMegabytesPerPod: 8000},
+		},
+		{
+			name:     "insufficient cpu",
+			status:   &v1.ClusterStatus{Nodes: nodes},
+			workload: Workload{PodCount: 1, CPUMilicorePerPod: 7000},
+			wantErr:  true,
+		},
+		{
+			name:     "insufficient memory on the node with free cpu",
+			status:   &v1.ClusterStatus{Nodes: nodes},
+			workload: Workload{PodCount: 1, CPUMilicorePerPod: 4000, MemoryMegabytesPerPod: 16000},
+			wantErr:  true,
+		},
+		{
+			name:     "gang on distinct nodes",
+			status:   &v1.ClusterStatus{Nodes: nodes},
+			workload: Workload{PodCount: 2, CPUMilicorePerPod: 2000},
+		},
+		{
+			name:     "insufficient nodes for gang",
+			status:   &v1.ClusterStatus{Nodes: nodes},
+			workload: Workload{PodCount: 2, CPUMilicorePerPod: 4000},
+			wantErr:  true,
+		},
+		{
+			name:     "assumed pod",
+			status:   &v1.ClusterStatus{Nodes: nodes},
+			assumed:  &cache.PodResources{CPUMilicore: 3000},
+			workload: Workload{PodCount: 1, CPUMilicorePerPod: 4000},
+			wantErr:  true,
+		},
+		{
+			name:     "nodes not reported",
+			status:   &v1.ClusterStatus{},
+			workload: Workload{PodCount: 1, CPUMilicorePerPod: 100000},
+		},
+		{
+			name: "scale up",
+			status: &v1.ClusterStatus{
+				Nodes: nodes,
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{InstanceFamily: "g5"},
+				},
+			},
+			workload: Workload{PodCount: 1, CPUMilicorePerPod: 16000},
+		},
+		{
+			name: "gpu workload with insufficient cpu",
+			status: &v1.ClusterStatus{
+				GpuNodes: []*v1.GpuNode{
+					{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n0"},
+				},
+				Nodes: nodes,
+			},
+			workload: Workload{PodCount: 1, GPUCountPerPod: 1, CPUMilicorePerPod: 7000},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			_, err := st.CreateOrUpdateCluster(&store.Cluster{
+				ClusterID: "cluster0",
+				TenantID:  tenantID,
+				Status:    marshalStatus(t, tc.status),
+			})
+			assert.NoError(t, err)
+
+			c := cache.NewStore(st, testr.New(t))
+			if tc.assumed != nil {
+				err := c.Reserve(tenantID, func(tx *store.S) (cache.Reservation, error) {
+					return cache.Reservation{
+						ClusterID: "cluster0",
+						Keys:      []string{"namespace0/pod0"},
+						Resources: *tc.assumed,
+					}, nil
+				})
+				assert.NoError(t, err)
+			}
+
+			sched := New(c, 30*time.Minute, testr.New(t))
+			got, err := sched.Schedule(userInfo, "", tc.workload)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "cluster0", got.ClusterID)
+		})
+	}
+}
+
+func TestCanProvisionGPUs(t *testing.T) {
+	tcs := []struct {
+		name          string
+		status        *cache.Cluster
+		resourceName  string
+		gpuType       string
+		minGPUMemory  int
+		requestedGPUs int
+		want          bool
+	}{
+		{
+			name:          "no gpu nodes and no provisionable resources",
+			status:        &cache.Cluster{},
+			requestedGPUs: 1,
+			want:          false,
+		},
+		{
+			name: "gpu nodes",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 1,
+					},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			requestedGPUs: 1,
+			want:          true,
+		},
+		{
+			name: "gpu nodes with unallocated gpus",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 8,
+					},
+				},
+				GPUPods: []*v1.GpuPod{
+					{AllocatedCount: 4},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			requestedGPUs: 2,
+			want:          true,
+		},
+		{
+			name: "gpu nodes with insufficient gpus",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 8,
+					},
+				},
+				GPUPods: []*v1.GpuPod{
+					{AllocatedCount: 7},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			requestedGPUs: 2,
+			want:          false,
+		},
+		{
+			name: "gpu nodes with insufficient gpus",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 8,
+					},
+				},
+				GPUPods: []*v1.GpuPod{
+					{AllocatedCount: 3},
+				},
+				AssumedGPUPodsByKey: map[string]*cache.AssumedGPUPod{
+					"ns-0/pod-1": {AllocatedCount: 4},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			requestedGPUs: 2,
+			want:          false,
+		},
+		{
+			name: "gpu nodes with other accelerators",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 8,
+					},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			resourceName:  "habana.ai/gaudi",
+			requestedGPUs: 1,
+			want:          false,
+		},
+		{
+			name: "gpu nodes with multiple accelerators",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 8,
+					},
+					{
+						ResourceName:     "amd.com/gpu",
+						AllocatableCount: 4,
+					},
+				},
+				GPUPods: []*v1.GpuPod{
+					{ResourceName: "nvidia.com/gpu", AllocatedCount: 8},
+					{ResourceName: "amd.com/gpu", AllocatedCount: 2},
+				},
+				AssumedGPUPodsByKey: map[string]*cache.AssumedGPUPod{
+					"ns-0/pod-1": {ResourceName: "amd.com/gpu", AllocatedCount: 1},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			resourceName:  "amd.com/gpu",
+			requestedGPUs: 1,
+			want:          true,
+		},
+		{
+			name: "gpu nodes with the requested gpu type",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 8,
+						NodeName:         "n0",
+						Product:          "NVIDIA-A10G",
+						MemoryMegabytes:  23028,
+					},
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 8,
+						NodeName:         "n1",
+						Product:          "NVIDIA-H100-80GB-HBM3",
+						MemoryMegabytes:  81559,
+					},
+				},
+				GPUPods: []*v1.GpuPod{
+					{ResourceName: "nvidia.com/gpu", AllocatedCount: 8, NodeName: "n0"},
+					{ResourceName: "nvidia.com/gpu", AllocatedCount: 4, NodeName: "n1"},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			gpuType:       "NVIDIA-H100-80GB-HBM3",
+			requestedGPUs: 4,
+			want:          true,
+		},
+		{
+			name: "gpu nodes without the requested gpu type",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 8,
+						NodeName:         "n0",
+						Product:          "NVIDIA-A10G",
+						MemoryMegabytes:  23028,
+					},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			gpuType:       "NVIDIA-H100-80GB-HBM3",
+			requestedGPUs: 1,
+			want:          false,
+		},
+		{
+			name: "gpu nodes with insufficient gpu memory",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 8,
+						NodeName:         "n0",
+						Product:          "NVIDIA-A10G",
+						MemoryMegabytes:  23028,
+					},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			minGPUMemory:  40000,
+			requestedGPUs: 1,
+			want:          false,
+		},
+		{
+			name: "mig profile",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 1,
+						NodeName:         "n0",
+					},
+					{
+						ResourceName:     "nvidia.com/mig-1g.10gb",
+						AllocatableCount: 7,
+						NodeName:         "n0",
+					},
+				},
+				GPUPods: []*v1.GpuPod{
+					{ResourceName: "nvidia.com/gpu", AllocatedCount: 1, NodeName: "n0"},
+					{ResourceName: "nvidia.com/mig-1g.10gb", AllocatedCount: 5, NodeName: "n0"},
+				},
+				AssumedGPUPodsByKey: map[string]*cache.AssumedGPUPod{
+					"ns-0/pod-1": {ResourceName: "nvidia.com/mig-1g.10gb", AllocatedCount: 1},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			resourceName:  "nvidia.com/mig-1g.10gb",
+			requestedGPUs: 1,
+			want:          true,
+		},
+		{
+			name: "mig profile with insufficient capacity",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/mig-1g.10gb",
+						AllocatableCount: 7,
+						NodeName:         "n0",
+					},
+				},
+				GPUPods: []*v1.GpuPod{
+					{ResourceName: "nvidia.com/mig-1g.10gb", AllocatedCount: 6, NodeName: "n0"},
+				},
+				AssumedGPUPodsByKey: map[string]*cache.AssumedGPUPod{
+					"ns-0/pod-1": {ResourceName: "nvidia.com/mig-1g.10gb", AllocatedCount: 1},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{},
+			},
+			resourceName:  "nvidia.com/mig-1g.10gb",
+			requestedGPUs: 1,
+			want:          false,
+		},
+		{
+			name: "provisionable resources with gpu instance type",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{},
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceType: "g5.12xlarge",
+					},
+				},
+			},
+			requestedGPUs: 1,
+			want:          true,
+		},
+		{
+			name: "provisionable resources with cpu instance family",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceFamilies: []string{"m5"},
+					},
+				},
+			},
+			requestedGPUs: 1,
+			want:          false,
+		},
+		{
+			name: "provisionable resources with cpu instance category",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceCategories: []string{"c", "m", "r"},
+					},
+				},
+			},
+			requestedGPUs: 1,
+			want:          false,
+		},
+		{
+			name: "provisionable resources with too small instance type",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceTypes: []string{"g5.4xlarge"},
+					},
+				},
+			},
+			requestedGPUs: 4,
+			want:          false,
+		},
+		{
+			name: "provisionable resources with gpu count requirement",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceCategories: []string{"g"},
+						GpuNames:           []string{"a10g"},
+						MinGpuCount:        4,
+					},
+				},
+			},
+			requestedGPUs: 8,
+			want:          true,
+		},
+		{
+			name: "provisionable resources within limits",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceTypes:   []string{"g5.12xlarge"},
+						GpuLimits:       map[string]int32{"nvidia.com/gpu": 8},
+						ProvisionedGpus: map[string]int32{"nvidia.com/gpu": 4},
+					},
+				},
+			},
+			requestedGPUs: 2,
+			want:          true,
+		},
+		{
+			name: "provisionable resources exceeding limits",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceTypes:   []string{"g5.12xlarge"},
+						GpuLimits:       map[string]int32{"nvidia.com/gpu": 8},
+						ProvisionedGpus: map[string]int32{"nvidia.com/gpu": 8},
+					},
+				},
+			},
+			requestedGPUs: 2,
+			want:          false,
+		},
+		{
+			name: "provisionable resources with the requested gpu type",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceFamilies: []string{"g5", "p5"},
+					},
+				},
+			},
+			gpuType:       "NVIDIA-H100-80GB-HBM3",
+			requestedGPUs: 8,
+			want:          true,
+		},
+		{
+			name: "provisionable resources without the requested gpu memory",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceFamilies: []string{"g5"},
+					},
+				},
+			},
+			minGPUMemory:  40000,
+			requestedGPUs: 1,
+			want:          false,
+		},
+		{
+			name: "insufficient gpu nodes with provisionable resources",
+			status: &cache.Cluster{
+				GPUNodes: []*v1.GpuNode{
+					{
+						ResourceName:     "nvidia.com/gpu",
+						AllocatableCount: 1,
+					},
+				},
+				GPUPods: []*v1.GpuPod{
+					{AllocatedCount: 1},
+				},
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceFamilies: []string{"g5"},
+					},
+				},
+			},
+			requestedGPUs: 1,
+			want:          true,
+		},
+		{
+			name: "neuron provisionable resources",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						InstanceFamilies: []string{"inf2", "trn1"},
+					},
+				},
+			},
+			resourceName:  "aws.amazon.com/neuron",
+			requestedGPUs: 16,
+			want:          true,
+		},
+		{
+			name: "cluster autoscaler node group",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						Name:            "gke-cluster-gpu-1234-grp",
+						Source:          "cluster-autoscaler",
+						NodeGpus:        map[string]int32{"nvidia.com/gpu": 2},
+						GpuProduct:      "Tesla-T4",
+						GpuLimits:       map[string]int32{"nvidia.com/gpu": 8},
+						ProvisionedGpus: map[string]int32{"nvidia.com/gpu": 4},
+					},
+				},
+			},
+			gpuType:       "Tesla-T4",
+			requestedGPUs: 2,
+			want:          true,
 		},
-	}
-
-	for _, tc := range tcs {
-		t.Run(tc.name, func(t *testing.T) {
-			got, err := isAWSInstanceTypeForNvidiaGPU(tc.instType)
-			if tc.wantErr {
-				assert.Error(t, err)
-				return
-			}
-			assert.NoError(t, err)
-			assert.Equal(t, tc.want, got)
-		})
-	}
-}
-
-func TestIsAWSInstanceFamilyForNvidiaGPU(t *testing.T) {
-	tcs := []struct {
-		name       string
-		instFamily string
-		want       bool
-		wantErr    bool
-	}{
 		{
-			name:       "gpu",
-			instFamily: "g5",
-			want:       true,
+			name: "cluster autoscaler node group at its max size",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						Name:            "gke-cluster-gpu-1234-grp",
+						Source:          "cluster-autoscaler",
+						NodeGpus:        map[string]int32{"nvidia.com/gpu": 2},
+						GpuLimits:       map[string]int32{"nvidia.com/gpu": 8},
+						ProvisionedGpus: map[string]int32{"nvidia.com/gpu": 8},
+					},
+				},
+			},
+			requestedGPUs: 2,
+			want:          false,
 		},
 		{
-			name:       "non-gpu",
-			instFamily: "m5",
-			want:       false,
+			name: "cluster autoscaler node group of unknown shape",
+			status: &cache.Cluster{
+				ProvisionableResources: []*v1.ProvisionableResource{
+					{
+						Name:   "eks-cpu-5678",
+						Source: "cluster-autoscaler",
+					},
+				},
+			},
+			requestedGPUs: 1,
+			want:          false,
 		},
 	}
 
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
-			got, err := isAWSInstanceFamilyForNvidiaGPU(tc.instFamily)
-			if tc.wantErr {
-				assert.Error(t, err)
-				return
-			}
+			s := S{logger: testr.New(t)}
+			got, err := s.canProvisionGPUs(Workload{
+				PodCount:              1,
+				GPUCountPerPod:        tc.requestedGPUs,
+				AcceleratorType:       tc.resourceName,
+				GPUType:               tc.gpuType,
+				MinGPUMemoryMegabytes: tc.minGPUMemory,
+			}, tc.status)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want, got)
 		})
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateAcceleratorType validates the accelerator type of a workload. An empty type is valid and
+// means the default accelerator.
+func validateAcceleratorType(t string) error {
+	if t == "" || accelerator.IsSupported(t) {
+		return nil
+	}
+	return status.Errorf(codes.InvalidArgument, "unsupported accelerator type %q (supported: %s)", t, strings.Join(accelerator.ResourceNames(), ", "))
+}
+
+// validateGPUModel validates the GPU model requested by a workload. GPU models are reported by
+// NVIDIA GPU Feature Discovery, so they can be requested only for NVIDIA GPUs.
+func validateGPUModel(acceleratorType, gpuType string, minGPUMemoryMegabytes int32) error {
+	if minGPUMemoryMegabytes < 0 {
+		return status.Error(codes.InvalidArgument, "min gpu memory must be non-negative")
+	}
+	if gpuType == "" && minGPUMemoryMegabytes == 0 {
+		return nil
+	}
+	if !accelerator.IsNvidia(accelerator.ResourceNameOrDefault(acceleratorType)) {
+		return status.Error(codes.InvalidArgument, "gpu type and min gpu memory can be specified only for NVIDIA GPUs")
+	}
+	return nil
+}
+
+// validateGPUProfile validates the fractional GPU profile requested by a workload. Fractional GPUs are
+// provided only for NVIDIA GPUs.
+func validateGPUProfile(acceleratorType, gpuProfile string) error {
+	if gpuProfile == "" {
+		return nil
+	}
+	if !accelerator.IsValidGPUProfile(gpuProfile) {
+		return status.Errorf(codes.InvalidArgument, "invalid gpu profile %q", gpuProfile)
+	}
+	if acceleratorType != "" && acceleratorType != accelerator.NvidiaGPU {
+		return status.Errorf(codes.InvalidArgument, "gpu profile can be specified only for %s", accelerator.NvidiaGPU)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListActiveWorkloads lists the workloads of the cluster that have not reached a terminal state.
+// Stopped notebooks are included as they can be restarted.
+func (ws *WS) ListActiveWorkloads(ctx context.Context, req *v1.ListActiveWorkloadsRequest) (*v1.ListActiveWorkloadsResponse, error) {
+	clusterInfo, err := ws.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var workloads []*v1.ListActiveWorkloadsResponse_Workload
+	add := func(t v1.DispatchAction_WorkloadType, id, namespace string, running bool) {
+		workloads = append(workloads, &v1.ListActiveWorkloadsResponse_Workload{
+			WorkloadType:        t,
+			Id:                  id,
+			Running:             running,
+			KubernetesNamespace: namespace,
+		})
+	}
+
+	jobs, err := ws.store.ListJobsByTenantIDAndClusterIDAndStates(clusterInfo.TenantID, clusterInfo.ClusterID, []store.JobState{
+		store.JobStateQueued,
+		store.JobStateRunning,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list jobs: %s", err)
+	}
+	for _, job := range jobs {
+		jobProto, err := job.V1Job()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "convert job: %s", err)
+		}
+		add(v1.DispatchAction_JOB, job.JobID, jobProto.KubernetesNamespace, job.State == store.JobStateRunning)
+	}
+
+	nbs, err := ws.store.ListNotebooksByTenantIDAndClusterIDAndStates(clusterInfo.TenantID, clusterInfo.ClusterID, []store.NotebookState{
+		store.NotebookStateQueued,
+		store.NotebookStateInitializing,
+		store.NotebookStateRunning,
+		store.NotebookStateStopped,
+		store.NotebookStateRequeued,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list notebooks: %s", err)
+	}
+	for _, nb := range nbs {
+		nbProto, err := nb.V1Notebook()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "convert notebook: %s", err)
+		}
+		add(v1.DispatchAction_NOTEBOOK, nb.NotebookID, nbProto.KubernetesNamespace, nb.State == store.NotebookStateInitializing || nb.State == store.NotebookStateRunning)
+	}
+
+	bjobs, err := ws.store.ListBatchJobsByTenantIDAndClusterIDAndStates(clusterInfo.TenantID, clusterInfo.ClusterID, []store.BatchJobState{
+		store.BatchJobStateQueued,
+		store.BatchJobStateRunning,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list batch jobs: %s", err)
+	}
+	for _, job := range bjobs {
+		jobProto, err := job.V1BatchJob()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "convert batch job: %s", err)
+		}
+		add(v1.DispatchAction_BATCH_JOB, job.JobID, jobProto.KubernetesNamespace, job.State == store.BatchJobStateRunning)
+	}
+
+	return &v1.ListActiveWorkloadsResponse{Workloads: workloads}, nil
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/job-manager/server/internal/config"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListActiveWorkloads(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	msg, err := proto.Marshal(&v1.Job{KubernetesNamespace: "ns0"})
+	assert.NoError(t, err)
+	jobs := []*store.Job{
+		{JobID: "job0", State: store.JobStateRunning, ClusterID: defaultClusterID, Message: msg},
+		{JobID: "job1", State: store.JobStateSucceeded, ClusterID: defaultClusterID},
+		{JobID: "job2", State: store.JobStateQueued, ClusterID: "other-cluster"},
+	}
+	for _, job := range jobs {
+		job.TenantID = defaultTenantID
+		assert.NoError(t, st.CreateJob(job))
+	}
+	nbs := []*store.Notebook{
+		{NotebookID: "nb0", State: store.NotebookStateStopped, ClusterID: defaultClusterID},
+		{NotebookID: "nb1", State: store.NotebookStateDeleted, ClusterID: defaultClusterID},
+	}
+	for _, nb := range nbs {
+		nb.TenantID = defaultTenantID
+		assert.NoError(t, st.CreateNotebook(nb))
+	}
+	bjobs := []*store.BatchJob{
+		{JobID: "bjob0", State: store.BatchJobStateQueued, ClusterID: defaultClusterID},
+		{JobID: "bjob1", State: store.BatchJobStateFailed, ClusterID: defaultClusterID},
+		{JobID: "bjob2", State: store.BatchJobStateRunning, ClusterID: defaultClusterID, TenantID: "other-tenant"},
+	}
+	for _, job := range bjobs {
+		if job.TenantID == "" {
+			job.TenantID = defaultTenantID
+		}
+		assert.NoError(t, st.CreateBatchJob(job))
+	}
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	resp, err := srv.ListActiveWorkloads(context.Background(), &v1.ListActiveWorkloadsRequest{})
+	assert.NoError(t, err)
+	want := []*v1.ListActiveWorkloadsResponse_Workload{
+		{WorkloadType: v1.DispatchAction_JOB, Id: "job0", Running: true, KubernetesNamespace: "ns0"},
+		{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "nb0"},
+		{WorkloadType: v1.DispatchAction_BATCH_JOB, Id: "bjob0"},
+	}
+	assert.Equal(t, want, resp.Workloads)
+}
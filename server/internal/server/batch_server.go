@@ -9,6 +9,9 @@ import (
 
 	"github.com/llmariner/common/pkg/id"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"github.com/llmariner/job-manager/pkg/workload"
+	"github.com/llmariner/job-manager/server/internal/scheduler"
 	"github.com/llmariner/job-manager/server/internal/store"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/grpc/codes"
@@ -51,6 +54,34 @@ func (s *S) CreateBatchJob(ctx context.Context, req *v1.CreateBatchJobRequest) (
 		}
 	}
 
+	if r := req.Resources; r != nil {
+		if r.CpuMilicore < 0 || r.MemoryMegabytes < 0 {
+			return nil, status.Error(codes.InvalidArgument, "cpu and memory must be non-negative")
+		}
+		if err := validateAcceleratorType(r.AcceleratorType); err != nil {
+			return nil, err
+		}
+		if err := validateGPUModel(r.AcceleratorType, r.GpuType, r.MinGpuMemoryMegabytes); err != nil {
+			return nil, err
+		}
+		if err := validateGPUProfile(r.AcceleratorType, r.GpuProfile); err != nil {
+			return nil, err
+		}
+		if r.GpuProfile != "" && r.GpuCount == 0 {
+			// Request one fractional GPU by default.
+			r.GpuCount = 1
+		}
+	}
+
+	preemptible, err := s.validatePriority(userInfo.ProjectID, req.Priority, req.Preemptible)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validatePlacement(userInfo, req.Placement); err != nil {
+		return nil, err
+	}
+
 	// Pass the Authorization to the context for downstream gRPC calls.
 	ctx = auth.CarryMetadata(ctx)
 	for _, fileID := range req.DataFiles {
@@ -64,18 +95,19 @@ func (s *S) CreateBatchJob(ctx context.Context, req *v1.CreateBatchJobRequest) (
 		return nil, status.Errorf(codes.Internal, "generate batch job id: %s", err)
 	}
 
-	var gpuCount int
-	if r := req.Resources; r != nil {
-		gpuCount = int(r.GpuCount)
-	}
-	sresult, err := s.scheduler.Schedule(userInfo, "", gpuCount)
+	w := batchJobWorkload(req.Resources, req.Kind, req.Placement)
+	var p *preemption
+	sresult, err := scheduleAndReserve(s.cache, userInfo.TenantID, w, func(tx *store.S) (scheduler.SchedulingResult, error) {
+		var sresult scheduler.SchedulingResult
+		var err error
+		sresult, p, err = s.scheduleWithPreemption(tx, userInfo, "", w, req.Priority, jobID)
+		return sresult, err
+	}, func(ns string) []string {
+		return batchJobPodKeys(ns, jobID, w.PodCount)
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "schedule: %s", err)
 	}
-	if err := s.cache.AddAssumedPod(userInfo.TenantID, sresult.ClusterID,
-		fmt.Sprintf("%s/%s", sresult.Namespace, jobID), gpuCount); err != nil {
-		return nil, status.Errorf(codes.Internal, "add assumed pod: %s", err)
-	}
 
 	jobProto := &v1.BatchJob{
 		Id:                  jobID,
@@ -90,6 +122,9 @@ func (s *S) CreateBatchJob(ctx context.Context, req *v1.CreateBatchJobRequest) (
 		KubernetesNamespace: sresult.Namespace,
 		ClusterId:           sresult.ClusterID,
 		Kind:                req.Kind,
+		Priority:            req.Priority,
+		Preemptible:         preemptible,
+		Placement:           req.Placement,
 
 		OrganizationTitle: userInfo.OrganizationTitle,
 		ProjectTitle:      userInfo.ProjectTitle,
@@ -104,34 +139,79 @@ func (s *S) CreateBatchJob(ctx context.Context, req *v1.CreateBatchJobRequest) (
 	if err != nil {
 		return nil, err
 	}
-	kclient, err := s.k8sClientFactory.NewClient(sresult.ClusterID, apikey)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "create k8s client: %s", err)
-	}
-	if err := kclient.CreateSecret(ctx, jobID, sresult.Namespace, map[string][]byte{
-		"OPENAI_API_KEY": []byte(apikey),
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "create secret: %s", err)
-	}
-	if err := kclient.CreateConfigMap(ctx, jobID, sresult.Namespace, req.Scripts); err != nil {
-		return nil, status.Errorf(codes.Internal, "create configmap for scripts: %s", err)
+	if err := s.createBatchJobResources(ctx, jobID, sresult.ClusterID, sresult.Namespace, apikey, req.Scripts); err != nil {
+		return nil, err
 	}
 
+	proj, err := toProjectMessage(userInfo)
+	if err != nil {
+		return nil, err
+	}
 	job := &store.BatchJob{
-		JobID:        jobID,
-		Message:      msg,
-		State:        store.BatchJobStateQueued,
-		QueuedAction: store.BatchJobQueuedActionCreate,
-		TenantID:     userInfo.TenantID,
-		ProjectID:    userInfo.ProjectID,
-		ClusterID:    sresult.ClusterID,
-	}
-	if err := s.store.CreateBatchJob(job); err != nil {
+		JobID:          jobID,
+		Message:        msg,
+		ProjectMessage: proj,
+		State:          store.BatchJobStateQueued,
+		QueuedAction:   store.BatchJobQueuedActionCreate,
+		TenantID:       userInfo.TenantID,
+		ProjectID:      userInfo.ProjectID,
+		ClusterID:      sresult.ClusterID,
+	}
+	if err := job.SetAPIKey(ctx, apikey, s.dataKey); err != nil {
+		return nil, status.Errorf(codes.Internal, "set api key: %s", err)
+	}
+	if err := job.SetScripts(req.Scripts); err != nil {
+		return nil, status.Errorf(codes.Internal, "set scripts: %s", err)
+	}
+	if err := s.persistWithPreemption(p, func(tx *store.S) error {
+		return tx.CreateBatchJob(job)
+	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "create batch job: %s", err)
 	}
 	return jobProto, nil
 }
 
+// batchJobWorkload returns the workload of a batch job that requests the given resources with the placement constraints.
+func batchJobWorkload(r *v1.BatchJob_Resources, kind *v1.BatchJob_Kind, p *v1.BatchJob_Placement) scheduler.Workload {
+	w := scheduler.Workload{
+		// A batch job runs a single pod unless it is a PyTorch job with multiple workers. The pod count
+		// is set to the worker count below so that all the workers are placed in the same cluster.
+		PodCount: 1,
+	}
+	applyPlacement(&w, p)
+	if r != nil {
+		w.GPUCountPerPod = int(r.GpuCount)
+		w.AcceleratorType = accelerator.ResourceName(r.AcceleratorType, r.GpuProfile)
+		w.GPUType = r.GpuType
+		w.MinGPUMemoryMegabytes = int(r.MinGpuMemoryMegabytes)
+		w.CPUMilicorePerPod = int(r.CpuMilicore)
+		w.MemoryMegabytesPerPod = int(r.MemoryMegabytes)
+	}
+	if pt := kind.GetPytorch(); pt != nil {
+		w.PodCount = int(pt.WorkerCount)
+	}
+	return w
+}
+
+// createBatchJobResources creates the secret and the configmap of a batch job in the scheduled cluster.
+func (s *S) createBatchJobResources(ctx context.Context, jobID, clusterID, namespace, apikey string, scripts map[string][]byte) error {
+	kclient, err := s.k8sClientFactory.NewClient(clusterID, apikey)
+	if err != nil {
+		return status.Errorf(codes.Internal, "create k8s client: %s", err)
+	}
+	// The labels let the dispatcher garbage-collect the secret and the configmap if they are orphaned.
+	labels := workload.Labels(workload.KindBatchJob, jobID)
+	if err := kclient.CreateSecret(ctx, jobID, namespace, labels, map[string][]byte{
+		"OPENAI_API_KEY": []byte(apikey),
+	}); err != nil {
+		return status.Errorf(codes.Internal, "create secret: %s", err)
+	}
+	if err := kclient.CreateConfigMap(ctx, jobID, namespace, labels, scripts); err != nil {
+		return status.Errorf(codes.Internal, "create configmap for scripts: %s", err)
+	}
+	return nil
+}
+
 // ListBatchJobs lists batch jobs.
 func (s *S) ListBatchJobs(ctx context.Context, req *v1.ListBatchJobsRequest) (*v1.ListBatchJobsResponse, error) {
 	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
@@ -283,7 +363,8 @@ func (s *S) CancelBatchJob(ctx context.Context, req *v1.CancelBatchJobRequest) (
 		store.BatchJobStateSucceeded,
 		store.BatchJobStateDeleted:
 		return jobProto, nil
-	case store.BatchJobStateRunning:
+	case store.BatchJobStateRunning,
+		store.BatchJobStateRequeued:
 	case store.BatchJobStateQueued:
 		if job.QueuedAction == store.BatchJobQueuedActionCancel {
 			return jobProto, nil
@@ -321,6 +402,7 @@ func (ws *WS) ListQueuedInternalBatchJobs(ctx context.Context, req *v1.ListQueue
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "convert job to proto: %s", err)
 		}
+		ij.KueueQueue = ws.batchJobKueueQueue(j.ProjectID)
 		ijobs = append(ijobs, ij)
 	}
 
@@ -353,9 +435,23 @@ func (ws *WS) GetInternalBatchJob(ctx context.Context, req *v1.GetInternalBatchJ
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "convert job to proto: %s", err)
 	}
+	jobProto.KueueQueue = ws.batchJobKueueQueue(job.ProjectID)
 	return jobProto, nil
 }
 
+// batchJobKueueQueue returns the Kueue queue currently configured for the project. It returns nil if the project
+// has no queue.
+func (ws *WS) batchJobKueueQueue(projectID string) *v1.InternalBatchJob_KueueQueue {
+	q, ok := ws.kueueProjects[projectID]
+	if !ok {
+		return nil
+	}
+	return &v1.InternalBatchJob_KueueQueue{
+		Name:           q.QueueName,
+		ResourceQuotas: q.ResourceQuotas,
+	}
+}
+
 // UpdateBatchJobState updates the state of a batch job.
 func (ws *WS) UpdateBatchJobState(ctx context.Context, req *v1.UpdateBatchJobStateRequest) (*v1.UpdateBatchJobStateResponse, error) {
 	clusterInfo, err := ws.extractClusterInfoFromContext(ctx)
@@ -426,12 +522,17 @@ func (ws *WS) UpdateBatchJobState(ctx context.Context, req *v1.UpdateBatchJobSta
 		}); err != nil {
 			return nil, status.Errorf(codes.Internal, "mutate batch job: %s", err)
 		}
+	case v1.InternalBatchJob_REQUEUED:
+		if job.State != store.BatchJobStateQueued || job.QueuedAction != store.BatchJobQueuedActionRequeue {
+			return nil, status.Errorf(codes.FailedPrecondition, "job state is not requeueing: %s (%s)", job.State, job.QueuedAction)
+		}
 	case v1.InternalBatchJob_FAILED:
 		if err := job.MutateMessage(func(job *v1.BatchJob) {
 			job.FinishedAt = time.Now().UTC().Unix()
 			job.Error = &v1.BatchJob_Error{
-				Code:    req.Reason,
-				Message: req.Message,
+				Code:     req.Reason,
+				Message:  req.Message,
+				ExitCode: req.ExitCode,
 			}
 		}); err != nil {
 			return nil, status.Errorf(codes.Internal, "mutate batch job: %s", err)
@@ -448,6 +549,47 @@ func (ws *WS) UpdateBatchJobState(ctx context.Context, req *v1.UpdateBatchJobSta
 	return &v1.UpdateBatchJobStateResponse{}, nil
 }
 
+// UpdateBatchJobStatusReason updates the reason why a batch job is not making progress.
+func (ws *WS) UpdateBatchJobStatusReason(ctx context.Context, req *v1.UpdateBatchJobStatusReasonRequest) (*v1.UpdateBatchJobStatusReasonResponse, error) {
+	clusterInfo, err := ws.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	job, err := ws.store.GetBatchJobByID(req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "batch job not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "get batch job: %s", err)
+	}
+	if job.TenantID != clusterInfo.TenantID {
+		return nil, status.Error(codes.NotFound, "batch job not found")
+	}
+
+	if err := ws.store.UpdateBatchJobStatusReason(req.Id, req.StatusReason); err != nil {
+		return nil, status.Errorf(codes.Internal, "update batch job status reason: %s", err)
+	}
+	return &v1.UpdateBatchJobStatusReasonResponse{}, nil
+}
+
+// batchJobPodKeys returns the keys of the assumed pods of a batch job. The keys are the prefixes of the pod names.
+func batchJobPodKeys(namespace, jobID string, podCount int) []string {
+	if podCount <= 1 {
+		return []string{fmt.Sprintf("%s/%s", namespace, jobID)}
+	}
+	// Pods of an indexed job are named as "<job name>-<completion index>-<random suffix>".
+	keys := make([]string, podCount)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("%s/%s-%d-", namespace, jobID, i)
+	}
+	return keys
+}
+
 func convertBatchJobState(s v1.InternalBatchJob_State) store.BatchJobState {
 	return store.BatchJobState(strings.ToLower(s.String()))
 }
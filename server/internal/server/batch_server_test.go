@@ -8,8 +8,11 @@ import (
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/job-manager/api/v1"
 	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/job-manager/server/internal/config"
 	"github.com/llmariner/job-manager/server/internal/store"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -55,6 +58,117 @@ func TestCreateBatchJob(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "negative priority",
+			req: &v1.CreateBatchJobRequest{
+				Image:    "t0",
+				Command:  "python train.py",
+				Scripts:  map[string][]byte{"train.py": []byte("dummy-data")},
+				Priority: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-preemptible not allowed",
+			req: &v1.CreateBatchJobRequest{
+				Image:       "t0",
+				Command:     "python train.py",
+				Scripts:     map[string][]byte{"train.py": []byte("dummy-data")},
+				Preemptible: proto.Bool(false),
+			},
+			wantErr: true,
+		},
+		{
+			name: "accelerator type",
+			req: &v1.CreateBatchJobRequest{
+				Image:   "t0",
+				Command: "python train.py",
+				Scripts: map[string][]byte{"train.py": []byte("dummy-data")},
+				Resources: &v1.BatchJob_Resources{
+					GpuCount:        1,
+					AcceleratorType: "amd.com/gpu",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unsupported accelerator type",
+			req: &v1.CreateBatchJobRequest{
+				Image:   "t0",
+				Command: "python train.py",
+				Scripts: map[string][]byte{"train.py": []byte("dummy-data")},
+				Resources: &v1.BatchJob_Resources{
+					GpuCount:        1,
+					AcceleratorType: "example.com/tpu",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gpu type",
+			req: &v1.CreateBatchJobRequest{
+				Image:   "t0",
+				Command: "python train.py",
+				Scripts: map[string][]byte{"train.py": []byte("dummy-data")},
+				Resources: &v1.BatchJob_Resources{
+					GpuCount:              1,
+					GpuType:               "NVIDIA-H100-80GB-HBM3",
+					MinGpuMemoryMegabytes: 81559,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "gpu profile",
+			req: &v1.CreateBatchJobRequest{
+				Image:   "t0",
+				Command: "python train.py",
+				Scripts: map[string][]byte{"train.py": []byte("dummy-data")},
+				Resources: &v1.BatchJob_Resources{
+					GpuProfile: "1g.10gb",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid gpu profile",
+			req: &v1.CreateBatchJobRequest{
+				Image:   "t0",
+				Command: "python train.py",
+				Scripts: map[string][]byte{"train.py": []byte("dummy-data")},
+				Resources: &v1.BatchJob_Resources{
+					GpuProfile: "half",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gpu profile for non-nvidia accelerator",
+			req: &v1.CreateBatchJobRequest{
+				Image:   "t0",
+				Command: "python train.py",
+				Scripts: map[string][]byte{"train.py": []byte("dummy-data")},
+				Resources: &v1.BatchJob_Resources{
+					AcceleratorType: "amd.com/gpu",
+					GpuProfile:      "shared",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gpu type for non-nvidia accelerator",
+			req: &v1.CreateBatchJobRequest{
+				Image:   "t0",
+				Command: "python train.py",
+				Scripts: map[string][]byte{"train.py": []byte("dummy-data")},
+				Resources: &v1.BatchJob_Resources{
+					GpuCount:        1,
+					AcceleratorType: "amd.com/gpu",
+					GpuType:         "NVIDIA-H100-80GB-HBM3",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -62,7 +176,7 @@ func TestCreateBatchJob(t *testing.T) {
 			st, tearDown := store.NewTest(t)
 			defer tearDown()
 
-			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, map[string]string{"t0": "img0"}, testr.New(t), nil)
+			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, map[string]string{"t0": "img0"}, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 			resp, err := srv.CreateBatchJob(fakeAuthInto(context.Background()), tc.req)
 			if tc.wantErr {
 				assert.Error(t, err)
@@ -70,8 +184,13 @@ func TestCreateBatchJob(t *testing.T) {
 			}
 			assert.NoError(t, err)
 
-			_, err = st.GetActiveBatchJobByIDAndProjectID(resp.Id, defaultProjectID)
+			job, err := st.GetActiveBatchJobByIDAndProjectID(resp.Id, defaultProjectID)
+			assert.NoError(t, err)
+			// The scripts are kept to reschedule the job.
+			assert.True(t, job.Reschedulable())
+			scripts, err := job.GetScripts()
 			assert.NoError(t, err)
+			assert.Equal(t, tc.req.Scripts, scripts)
 		})
 	}
 }
@@ -98,7 +217,7 @@ func TestListBatchJobs(t *testing.T) {
 	err := st.SetBatchJobState("nb10", 0, store.BatchJobStateDeleted)
 	assert.NoError(t, err)
 
-	srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+	srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 	ctx := fakeAuthInto(context.Background())
 	resp, err := srv.ListBatchJobs(ctx, &v1.ListBatchJobsRequest{Limit: 5})
 	assert.NoError(t, err)
@@ -143,7 +262,7 @@ func TestGetBatchJob(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+	srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 	resp, err := srv.GetBatchJob(fakeAuthInto(context.Background()), &v1.GetBatchJobRequest{Id: nbID})
 	assert.NoError(t, err)
 	assert.EqualValues(t, store.BatchJobQueuedActionCreate, store.BatchJobState(resp.Status))
@@ -204,7 +323,7 @@ func TestCancelBatchJob(t *testing.T) {
 			})
 			assert.NoError(t, err)
 
-			srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+			srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 			resp, err := srv.CancelBatchJob(fakeAuthInto(context.Background()), &v1.CancelBatchJobRequest{Id: nbID})
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want.Status, resp.Status)
@@ -258,7 +377,7 @@ func TestListQueuedInternalBatchJobs(t *testing.T) {
 		}))
 	}
 
-	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 	req := &v1.ListQueuedInternalBatchJobsRequest{}
 	got, err := srv.ListQueuedInternalBatchJobs(fakeAuthInto(context.Background()), req)
 	assert.NoError(t, err)
@@ -281,7 +400,7 @@ func TestGetInternalBatchJob(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 	req := &v1.GetInternalBatchJobRequest{Id: "job0"}
 	resp, err := srv.GetInternalBatchJob(fakeAuthInto(context.Background()), req)
 	assert.NoError(t, err)
@@ -303,7 +422,7 @@ func TestDeleteBatchJob(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+	srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 	_, err = srv.DeleteBatchJob(fakeAuthInto(context.Background()), &v1.DeleteBatchJobRequest{Id: nbID})
 	assert.NoError(t, err)
 }
@@ -379,6 +498,19 @@ func TestUpdateBatchJobState(t *testing.T) {
 			state:     v1.InternalBatchJob_FAILED,
 			wantState: store.BatchJobStateFailed,
 		},
+		{
+			name:       "set requeued state",
+			prevState:  store.BatchJobStateQueued,
+			prevAction: store.BatchJobQueuedActionRequeue,
+			state:      v1.InternalBatchJob_REQUEUED,
+			wantState:  store.BatchJobStateRequeued,
+		},
+		{
+			name:      "set requeued state, previous state is not requeueing",
+			prevState: store.BatchJobStateRunning,
+			state:     v1.InternalBatchJob_REQUEUED,
+			wantError: true,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -394,7 +526,7 @@ func TestUpdateBatchJobState(t *testing.T) {
 			})
 			assert.NoError(t, err)
 
-			srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+			srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 			_, err = srv.UpdateBatchJobState(fakeAuthInto(context.Background()), &v1.UpdateBatchJobStateRequest{
 				Id:    batchJobID,
 				State: test.state,
@@ -411,3 +543,65 @@ func TestUpdateBatchJobState(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateBatchJobState_FailedWithExitCode(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	const batchJobID = "batchJob0"
+	err := st.CreateBatchJob(&store.BatchJob{
+		JobID:    batchJobID,
+		TenantID: defaultTenantID,
+		State:    store.BatchJobStateRunning,
+	})
+	assert.NoError(t, err)
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	_, err = srv.UpdateBatchJobState(fakeAuthInto(context.Background()), &v1.UpdateBatchJobStateRequest{
+		Id:       batchJobID,
+		State:    v1.InternalBatchJob_FAILED,
+		Reason:   "user_error",
+		Message:  "container main exited with code 2",
+		ExitCode: 2,
+	})
+	assert.NoError(t, err)
+
+	batchJob, err := st.GetBatchJobByID(batchJobID)
+	assert.NoError(t, err)
+	assert.Equal(t, store.BatchJobStateFailed, batchJob.State)
+	jobProto, err := batchJob.V1BatchJob()
+	assert.NoError(t, err)
+	assert.Equal(t, "user_error", jobProto.Error.Code)
+	assert.Equal(t, "container main exited with code 2", jobProto.Error.Message)
+	assert.Equal(t, int32(2), jobProto.Error.ExitCode)
+}
+
+func TestUpdateBatchJobStatusReason(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateBatchJob(&store.BatchJob{
+		JobID:    "batchJob0",
+		TenantID: defaultTenantID,
+		State:    store.BatchJobStateRunning,
+	})
+	assert.NoError(t, err)
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+	_, err = srv.UpdateBatchJobStatusReason(ctx, &v1.UpdateBatchJobStatusReasonRequest{
+		Id:           "batchJob0",
+		StatusReason: "CrashLoopBackOff: back-off restarting failed container",
+	})
+	assert.NoError(t, err)
+	batchJob, err := st.GetBatchJobByID("batchJob0")
+	assert.NoError(t, err)
+	jobProto, err := batchJob.V1BatchJob()
+	assert.NoError(t, err)
+	assert.Equal(t, "CrashLoopBackOff: back-off restarting failed container", jobProto.StatusReason)
+
+	_, err = srv.UpdateBatchJobStatusReason(ctx, &v1.UpdateBatchJobStatusReasonRequest{
+		Id: "unknown",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
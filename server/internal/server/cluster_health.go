@@ -0,0 +1,243 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/config"
+	"github.com/llmariner/job-manager/server/internal/store"
+)
+
+const unreachableClusterReason = "Cluster is unreachable"
+
+// RunClusterHealthChecker periodically updates the health states of clusters based on their last status updates.
+// It also clears the draining flags of the clusters whose workloads have been moved out.
+func (s *S) RunClusterHealthChecker(ctx context.Context, c config.ClusterHealthConfig) error {
+	ticker := time.NewTicker(c.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := s.checkClusterHealth(c, time.Now()); err != nil {
+				s.logger.Error(err, "Failed to check cluster health")
+			}
+		}
+	}
+}
+
+func (s *S) checkClusterHealth(c config.ClusterHealthConfig, now time.Time) error {
+	clusters, err := s.store.ListClusters()
+	if err != nil {
+		return fmt.Errorf("list clusters: %s", err)
+	}
+	for _, cluster := range clusters {
+		if cluster.Draining {
+			if err := s.finishDrain(cluster); err != nil {
+				return fmt.Errorf("finish draining cluster %s: %s", cluster.ClusterID, err)
+			}
+		}
+
+		newState := clusterHealthState(now.Sub(cluster.UpdatedAt), c)
+		if newState == cluster.HealthState {
+			continue
+		}
+		if err := s.updateClusterHealth(c, cluster, newState); err != nil {
+			return fmt.Errorf("update health state of cluster %s: %s", cluster.ClusterID, err)
+		}
+	}
+	return nil
+}
+
+// updateClusterHealth moves the cluster to the new health state and updates its workloads accordingly.
+//
+// The health checker runs in every server replica. The state is updated from the observed state in the same
+// transaction as the workloads so that only one replica handles each transition. The transaction is rolled back
+// on failure so that the transition is retried at the next check.
+func (s *S) updateClusterHealth(c config.ClusterHealthConfig, cluster *store.Cluster, newState store.ClusterHealthState) error {
+	oldState := cluster.HealthState
+	log := s.logger.WithValues("clusterID", cluster.ClusterID, "from", oldState, "to", newState, "lastUpdatedAt", cluster.UpdatedAt)
+	var requeued []string
+	if err := s.store.Transaction(func(tx *store.S) error {
+		if err := tx.UpdateClusterHealthState(cluster.ClusterID, oldState, newState); err != nil {
+			return err
+		}
+		switch {
+		case newState == store.ClusterHealthStateUnreachable:
+			if c.RequeueNotebooks {
+				ids, err := requeueNotebooksFromUnreachableCluster(tx, cluster)
+				if err != nil {
+					return fmt.Errorf("requeue notebooks: %w", err)
+				}
+				requeued = ids
+			}
+			if err := setWorkloadStatusUnknown(tx, cluster, true); err != nil {
+				return fmt.Errorf("mark workloads: %w", err)
+			}
+		case oldState == store.ClusterHealthStateUnreachable:
+			if err := setWorkloadStatusUnknown(tx, cluster, false); err != nil {
+				return fmt.Errorf("unmark workloads: %w", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			// Another replica has handled the transition, or a workload has been updated concurrently.
+			// The latter is retried at the next check.
+			log.V(1).Info("Skipped the health state transition due to a concurrent update")
+			return nil
+		}
+		return err
+	}
+
+	for _, id := range requeued {
+		log.Info("Requeued a notebook from an unreachable cluster", "notebookID", id)
+	}
+	switch {
+	case newState == store.ClusterHealthStateUnreachable:
+		log.Error(nil, "Cluster is unreachable")
+	case oldState == store.ClusterHealthStateUnreachable:
+		log.Info("Cluster is reachable again")
+	case newState == store.ClusterHealthStateDegraded:
+		log.Error(nil, "Cluster is degraded")
+	default:
+		log.Info("Cluster is healthy again")
+	}
+	return nil
+}
+
+func clusterHealthState(sinceLastUpdate time.Duration, c config.ClusterHealthConfig) store.ClusterHealthState {
+	switch {
+	case sinceLastUpdate > c.UnreachableThreshold:
+		return store.ClusterHealthStateUnreachable
+	case sinceLastUpdate > c.DegradedThreshold:
+		return store.ClusterHealthStateDegraded
+	default:
+		return store.ClusterHealthStateHealthy
+	}
+}
+
+// requeueNotebooksFromUnreachableCluster moves notebooks on an unreachable cluster directly to the requeued state
+// so that the rescheduler can schedule them to other clusters. The dispatcher of the unreachable cluster is not
+// involved, so the resources of the notebooks might be left in the cluster. It returns the IDs of the requeued
+// notebooks.
+func requeueNotebooksFromUnreachableCluster(st *store.S, c *store.Cluster) ([]string, error) {
+	nbs, err := st.ListNotebooksByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.NotebookState{
+		store.NotebookStateQueued,
+		store.NotebookStateInitializing,
+		store.NotebookStateRunning,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, nb := range nbs {
+		if nb.State == store.NotebookStateQueued && nb.QueuedAction != store.NotebookQueuedActionStart {
+			// The notebook is already being stopped, deleted, or requeued.
+			continue
+		}
+		nb.State = store.NotebookStateRequeued
+		nb.QueuedAction = ""
+		nb.Reason = unreachableClusterReason
+		if err := nb.MutateMessage(func(p *v1.Notebook) {
+			p.Status = string(store.NotebookStateRequeued)
+		}); err != nil {
+			return nil, err
+		}
+		if err := st.UpdateNotebookForRescheduling(nb); err != nil {
+			return nil, fmt.Errorf("requeue a notebook %s: %w", nb.NotebookID, err)
+		}
+		ids = append(ids, nb.NotebookID)
+	}
+	return ids, nil
+}
+
+// setWorkloadStatusUnknown marks or unmarks the running workloads on the cluster as having an unknown status.
+func setWorkloadStatusUnknown(st *store.S, c *store.Cluster, unknown bool) error {
+	nbs, err := st.ListNotebooksByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.NotebookState{
+		store.NotebookStateInitializing,
+		store.NotebookStateRunning,
+	})
+	if err != nil {
+		return err
+	}
+	for _, nb := range nbs {
+		nbProto, err := nb.V1Notebook()
+		if err != nil {
+			return err
+		}
+		if nbProto.StatusUnknown == unknown {
+			continue
+		}
+		if err := nb.MutateMessage(func(p *v1.Notebook) {
+			p.StatusUnknown = unknown
+		}); err != nil {
+			return err
+		}
+		if err := st.UpdateNotebookMessage(nb.NotebookID, nb.Version, nb.Message); err != nil {
+			return fmt.Errorf("update notebook %s: %w", nb.NotebookID, err)
+		}
+	}
+
+	bjs, err := st.ListBatchJobsByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.BatchJobState{
+		store.BatchJobStateRunning,
+	})
+	if err != nil {
+		return err
+	}
+	for _, job := range bjs {
+		jobProto, err := job.V1BatchJob()
+		if err != nil {
+			return err
+		}
+		if jobProto.StatusUnknown == unknown {
+			continue
+		}
+		if err := job.MutateMessage(func(p *v1.BatchJob) {
+			p.StatusUnknown = unknown
+		}); err != nil {
+			return err
+		}
+		if err := st.UpdateBatchJobMessage(job.JobID, job.Version, job.Message); err != nil {
+			return fmt.Errorf("update batch job %s: %w", job.JobID, err)
+		}
+	}
+
+	jobs, err := st.ListJobsByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.JobState{
+		store.JobStateRunning,
+	})
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		jobProto, err := job.V1Job()
+		if err != nil {
+			return err
+		}
+		if jobProto.StatusUnknown == unknown {
+			continue
+		}
+		if err := job.MutateMessage(func(p *v1.Job) {
+			p.StatusUnknown = unknown
+		}); err != nil {
+			return err
+		}
+		if err := st.UpdateJobMessage(job.JobID, job.Version, job.Message); err != nil {
+			return fmt.Errorf("update job %s: %w", job.JobID, err)
+		}
+	}
+	return nil
+}
+
+func toClusterHealthStateProto(state store.ClusterHealthState) v1.ClusterHealthState {
+	v, ok := v1.ClusterHealthState_value["CLUSTER_HEALTH_STATE_"+strings.ToUpper(string(state))]
+	if !ok {
+		return v1.ClusterHealthState_CLUSTER_HEALTH_STATE_UNSPECIFIED
+	}
+	return v1.ClusterHealthState(v)
+}
@@ -0,0 +1,214 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/job-manager/server/internal/config"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheckClusterHealth(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateOrUpdateCluster(&store.Cluster{
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+	})
+	assert.NoError(t, err)
+	createWorkloads(t, st)
+
+	srv := New(st, nil, nil, nil, nil, cache.NewStore(st, testr.New(t)), nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	ctx := fakeAuthInto(context.Background())
+	c := config.ClusterHealthConfig{
+		CheckInterval:        time.Minute,
+		DegradedThreshold:    5 * time.Minute,
+		UnreachableThreshold: 30 * time.Minute,
+	}
+	now := time.Now()
+
+	tcs := []struct {
+		name        string
+		now         time.Time
+		wantState   v1.ClusterHealthState
+		wantUnknown bool
+	}{
+		{
+			name:      "healthy",
+			now:       now,
+			wantState: v1.ClusterHealthState_CLUSTER_HEALTH_STATE_HEALTHY,
+		},
+		{
+			name:      "degraded",
+			now:       now.Add(10 * time.Minute),
+			wantState: v1.ClusterHealthState_CLUSTER_HEALTH_STATE_DEGRADED,
+		},
+		{
+			name:        "unreachable",
+			now:         now.Add(time.Hour),
+			wantState:   v1.ClusterHealthState_CLUSTER_HEALTH_STATE_UNREACHABLE,
+			wantUnknown: true,
+		},
+		{
+			name:      "recovered",
+			now:       now,
+			wantState: v1.ClusterHealthState_CLUSTER_HEALTH_STATE_HEALTHY,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := srv.checkClusterHealth(c, tc.now)
+			assert.NoError(t, err)
+
+			resp, err := srv.ListClusters(ctx, &v1.ListClustersRequest{})
+			assert.NoError(t, err)
+			assert.Len(t, resp.Clusters, 1)
+			assert.Equal(t, tc.wantState, resp.Clusters[0].HealthState)
+
+			nb, err := st.GetNotebookByID("nb0")
+			assert.NoError(t, err)
+			assert.Equal(t, store.NotebookStateRunning, nb.State)
+			nbProto, err := nb.V1Notebook()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantUnknown, nbProto.StatusUnknown)
+
+			nb, err = st.GetNotebookByID("nb1")
+			assert.NoError(t, err)
+			assert.Equal(t, store.NotebookStateQueued, nb.State)
+
+			bj, err := st.GetBatchJobByID("bj0")
+			assert.NoError(t, err)
+			bjProto, err := bj.V1BatchJob()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantUnknown, bjProto.StatusUnknown)
+
+			job, err := st.GetJobByJobID("job0")
+			assert.NoError(t, err)
+			jobProto, err := job.V1Job()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantUnknown, jobProto.StatusUnknown)
+		})
+	}
+}
+
+func TestCheckClusterHealth_RequeueNotebooks(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateOrUpdateCluster(&store.Cluster{
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+	})
+	assert.NoError(t, err)
+	createWorkloads(t, st)
+
+	srv := New(st, nil, nil, nil, nil, cache.NewStore(st, testr.New(t)), nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	c := config.ClusterHealthConfig{
+		CheckInterval:        time.Minute,
+		DegradedThreshold:    5 * time.Minute,
+		UnreachableThreshold: 30 * time.Minute,
+		RequeueNotebooks:     true,
+	}
+	err = srv.checkClusterHealth(c, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	for _, id := range []string{"nb0", "nb1"} {
+		nb, err := st.GetNotebookByID(id)
+		assert.NoError(t, err)
+		assert.Equal(t, store.NotebookStateRequeued, nb.State, id)
+		assert.Equal(t, unreachableClusterReason, nb.Reason, id)
+	}
+	nb, err := st.GetNotebookByID("nb2")
+	assert.NoError(t, err)
+	assert.Equal(t, store.NotebookStateQueued, nb.State)
+	assert.Equal(t, store.NotebookQueuedActionDelete, nb.QueuedAction)
+
+	job, err := st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.JobStateRunning, job.State)
+	jobProto, err := job.V1Job()
+	assert.NoError(t, err)
+	assert.True(t, jobProto.StatusUnknown)
+}
+
+func TestCheckClusterHealth_ConcurrentTransition(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateOrUpdateCluster(&store.Cluster{
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+	})
+	assert.NoError(t, err)
+	createWorkloads(t, st)
+
+	c := config.ClusterHealthConfig{
+		CheckInterval:        time.Minute,
+		DegradedThreshold:    5 * time.Minute,
+		UnreachableThreshold: 30 * time.Minute,
+		RequeueNotebooks:     true,
+	}
+	// Another replica has observed the cluster before the transition.
+	stale, err := st.GetClusterByID(defaultClusterID)
+	assert.NoError(t, err)
+
+	srv := New(st, nil, nil, nil, nil, cache.NewStore(st, testr.New(t)), nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	err = srv.checkClusterHealth(c, time.Now().Add(10*time.Minute))
+	assert.NoError(t, err)
+
+	// The other replica does not handle the transition from the stale state.
+	other := New(st, nil, nil, nil, nil, cache.NewStore(st, testr.New(t)), nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	err = other.updateClusterHealth(c, stale, store.ClusterHealthStateUnreachable)
+	assert.NoError(t, err)
+
+	got, err := st.GetClusterByID(defaultClusterID)
+	assert.NoError(t, err)
+	assert.Equal(t, store.ClusterHealthStateDegraded, got.HealthState)
+	nb, err := st.GetNotebookByID("nb0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.NotebookStateRunning, nb.State)
+	job, err := st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	jobProto, err := job.V1Job()
+	assert.NoError(t, err)
+	assert.False(t, jobProto.StatusUnknown)
+}
+
+func createWorkloads(t *testing.T, st *store.S) {
+	for _, nb := range []*store.Notebook{
+		{NotebookID: "nb0", State: store.NotebookStateRunning},
+		{NotebookID: "nb1", State: store.NotebookStateQueued, QueuedAction: store.NotebookQueuedActionStart},
+		{NotebookID: "nb2", State: store.NotebookStateQueued, QueuedAction: store.NotebookQueuedActionDelete},
+	} {
+		nb.ClusterID = defaultClusterID
+		nb.TenantID = defaultTenantID
+		err := st.CreateNotebook(nb)
+		assert.NoError(t, err)
+	}
+
+	msg, err := proto.Marshal(&v1.BatchJob{})
+	assert.NoError(t, err)
+	err = st.CreateBatchJob(&store.BatchJob{
+		JobID:     "bj0",
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+		State:     store.BatchJobStateRunning,
+		Message:   msg,
+	})
+	assert.NoError(t, err)
+
+	err = st.CreateJob(&store.Job{
+		JobID:     "job0",
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+		State:     store.JobStateRunning,
+	})
+	assert.NoError(t, err)
+}
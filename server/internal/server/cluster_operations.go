@@ -0,0 +1,500 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/llmariner/common/pkg/id"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// drainedErrorCode is the error code set to batch jobs that have been canceled by draining a cluster.
+const drainedErrorCode = "cluster_drained"
+
+// clusterOperationAccessResource is the resource that authorizes the operations of clusters. The operations affect
+// all the projects that use the clusters, so the scope of the resource (e.g., "api.clusters.operations.write") is
+// expected to be granted only to organization owners, unlike "api.clusters" that project members can access.
+const clusterOperationAccessResource = "api.clusters.operations"
+
+// clusterOperationMethods are the methods that operate clusters. They are authorized with clusterOperationAccessResource.
+var clusterOperationMethods = map[string]bool{
+	"/llmariner.jobs.server.v1.JobService/CordonCluster":           true,
+	"/llmariner.jobs.server.v1.JobService/UncordonCluster":         true,
+	"/llmariner.jobs.server.v1.JobService/DrainCluster":            true,
+	"/llmariner.jobs.server.v1.JobService/CreateMaintenanceWindow": true,
+	"/llmariner.jobs.server.v1.JobService/DeleteMaintenanceWindow": true,
+	"/llmariner.jobs.server.v1.JobService/SetClusterLabels":        true,
+}
+
+// CordonCluster excludes a cluster from scheduling. Workloads running in the cluster are not affected.
+func (s *S) CordonCluster(ctx context.Context, req *v1.CordonClusterRequest) (*v1.Cluster, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	c, err := s.getAccessibleCluster(userInfo, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	c, err = s.updateClusterCordon(c.ClusterID, true, req.Reason, c.Draining)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Cordoned a cluster", "clusterID", c.ClusterID, "reason", req.Reason)
+	return s.toClusterProto(c)
+}
+
+// UncordonCluster makes a cordoned or drained cluster schedulable again.
+func (s *S) UncordonCluster(ctx context.Context, req *v1.UncordonClusterRequest) (*v1.Cluster, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	c, err := s.getAccessibleCluster(userInfo, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	c, err = s.updateClusterCordon(c.ClusterID, false, "", false)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Uncordoned a cluster", "clusterID", c.ClusterID)
+	return s.toClusterProto(c)
+}
+
+// DrainCluster cordons a cluster and moves the workloads out of the cluster. Notebooks are requeued to
+// other clusters. Batch jobs and fine-tuning jobs are handled based on the job policy.
+//
+// DrainCluster can be called multiple times. Workloads that have been created in the cluster after the
+// previous call are drained again.
+func (s *S) DrainCluster(ctx context.Context, req *v1.DrainClusterRequest) (*v1.Cluster, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	switch req.JobPolicy {
+	case v1.JobDrainPolicy_JOB_DRAIN_POLICY_UNSPECIFIED,
+		v1.JobDrainPolicy_JOB_DRAIN_POLICY_WAIT,
+		v1.JobDrainPolicy_JOB_DRAIN_POLICY_CANCEL,
+		v1.JobDrainPolicy_JOB_DRAIN_POLICY_REQUEUE:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown job policy: %s", req.JobPolicy)
+	}
+
+	c, err := s.getAccessibleCluster(userInfo, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	// Cordon the cluster first so that the drained workloads are not scheduled to the cluster again.
+	c, err = s.updateClusterCordon(c.ClusterID, true, req.Reason, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requeueNotebooks(c); err != nil {
+		return nil, status.Errorf(codes.Internal, "requeue notebooks: %s", err)
+	}
+	switch req.JobPolicy {
+	case v1.JobDrainPolicy_JOB_DRAIN_POLICY_CANCEL:
+		if err := s.cancelBatchJobs(c); err != nil {
+			return nil, status.Errorf(codes.Internal, "cancel batch jobs: %s", err)
+		}
+		if err := s.cancelFineTuningJobs(c); err != nil {
+			return nil, status.Errorf(codes.Internal, "cancel fine-tuning jobs: %s", err)
+		}
+	case v1.JobDrainPolicy_JOB_DRAIN_POLICY_REQUEUE:
+		if err := s.requeueBatchJobs(c); err != nil {
+			return nil, status.Errorf(codes.Internal, "requeue batch jobs: %s", err)
+		}
+		if err := s.requeueFineTuningJobs(c); err != nil {
+			return nil, status.Errorf(codes.Internal, "requeue fine-tuning jobs: %s", err)
+		}
+	}
+	s.logger.Info("Draining a cluster", "clusterID", c.ClusterID, "reason", req.Reason, "jobPolicy", req.JobPolicy)
+	return s.toClusterProto(c)
+}
+
+// CreateMaintenanceWindow schedules a maintenance window of a cluster. The cluster is excluded from scheduling
+// during the window. Expired windows are removed.
+func (s *S) CreateMaintenanceWindow(ctx context.Context, req *v1.CreateMaintenanceWindowRequest) (*v1.Cluster, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.ClusterId == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster_id is required")
+	}
+	if req.StartTime >= req.EndTime {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+	now := time.Now()
+	if req.EndTime <= now.Unix() {
+		return nil, status.Error(codes.InvalidArgument, "end_time must be in the future")
+	}
+
+	c, err := s.getAccessibleCluster(userInfo, req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	wid, err := id.GenerateID("mw-", 24)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate id: %s", err)
+	}
+	c, err = s.store.UpdateClusterMaintenanceWindows(c.ClusterID, func(ws []store.MaintenanceWindow) ([]store.MaintenanceWindow, error) {
+		return append(unexpiredMaintenanceWindows(ws, now), store.MaintenanceWindow{
+			ID:        wid,
+			StartTime: time.Unix(req.StartTime, 0).UTC(),
+			EndTime:   time.Unix(req.EndTime, 0).UTC(),
+			Reason:    req.Reason,
+		}), nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update maintenance windows: %s", err)
+	}
+	if err := s.cache.AddOrUpdateCluster(c); err != nil {
+		return nil, status.Errorf(codes.Internal, "set cluster to cache: %s", err)
+	}
+	return s.toClusterProto(c)
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window of a cluster.
+func (s *S) DeleteMaintenanceWindow(ctx context.Context, req *v1.DeleteMaintenanceWindowRequest) (*v1.Cluster, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.ClusterId == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster_id is required")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	c, err := s.getAccessibleCluster(userInfo, req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	errNotFound := errors.New("maintenance window not found")
+	c, err = s.store.UpdateClusterMaintenanceWindows(c.ClusterID, func(ws []store.MaintenanceWindow) ([]store.MaintenanceWindow, error) {
+		var updated []store.MaintenanceWindow
+		for _, w := range ws {
+			if w.ID != req.Id {
+				updated = append(updated, w)
+			}
+		}
+		if len(updated) == len(ws) {
+			return nil, errNotFound
+		}
+		return updated, nil
+	})
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, status.Errorf(codes.NotFound, "maintenance window %q not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "update maintenance windows: %s", err)
+	}
+	if err := s.cache.AddOrUpdateCluster(c); err != nil {
+		return nil, status.Errorf(codes.Internal, "set cluster to cache: %s", err)
+	}
+	return s.toClusterProto(c)
+}
+
+// SetClusterLabels sets the labels of a cluster. The labels override the labels reported by the dispatcher.
+func (s *S) SetClusterLabels(ctx context.Context, req *v1.SetClusterLabelsRequest) (*v1.Cluster, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	for k := range req.Labels {
+		if k == "" {
+			return nil, status.Error(codes.InvalidArgument, "label key must not be empty")
+		}
+	}
+
+	c, err := s.getAccessibleCluster(userInfo, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	c, err = s.store.UpdateClusterLabels(c.ClusterID, req.Labels)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update cluster labels: %s", err)
+	}
+	if err := s.cache.AddOrUpdateCluster(c); err != nil {
+		return nil, status.Errorf(codes.Internal, "set cluster to cache: %s", err)
+	}
+	s.logger.Info("Set cluster labels", "clusterID", c.ClusterID, "labels", req.Labels)
+	return s.toClusterProto(c)
+}
+
+// getAccessibleCluster gets a cluster that is assigned to the user. The permission to operate the cluster is
+// checked by the interceptor with clusterOperationAccessResource.
+func (s *S) getAccessibleCluster(userInfo *auth.UserInfo, clusterID string) (*store.Cluster, error) {
+	var accessible bool
+	for _, env := range userInfo.AssignedKubernetesEnvs {
+		if env.ClusterID == clusterID {
+			accessible = true
+			break
+		}
+	}
+	if !accessible {
+		return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterID)
+	}
+
+	c, err := s.store.GetClusterByID(clusterID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterID)
+		}
+		return nil, status.Errorf(codes.Internal, "get cluster: %s", err)
+	}
+	if c.TenantID != userInfo.TenantID {
+		return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterID)
+	}
+	return c, nil
+}
+
+// updateClusterCordon updates the cordon state of a cluster in the store and the cache.
+func (s *S) updateClusterCordon(clusterID string, cordoned bool, reason string, draining bool) (*store.Cluster, error) {
+	c, err := s.store.UpdateClusterCordon(clusterID, cordoned, reason, draining)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update cluster: %s", err)
+	}
+	if err := s.cache.AddOrUpdateCluster(c); err != nil {
+		return nil, status.Errorf(codes.Internal, "set cluster to cache: %s", err)
+	}
+	return c, nil
+}
+
+// requeueNotebooks requeues the notebooks in the cluster. The rescheduler schedules them to other clusters
+// once the dispatcher deletes them from the cluster.
+func (s *S) requeueNotebooks(c *store.Cluster) error {
+	nbs, err := s.store.ListNotebooksByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.NotebookState{
+		store.NotebookStateQueued,
+		store.NotebookStateInitializing,
+		store.NotebookStateRunning,
+	})
+	if err != nil {
+		return err
+	}
+	for _, nb := range nbs {
+		if nb.State == store.NotebookStateQueued && nb.QueuedAction != store.NotebookQueuedActionStart {
+			// The notebook is already being stopped, deleted, or requeued.
+			continue
+		}
+		nb.State = store.NotebookStateQueued
+		nb.QueuedAction = store.NotebookQueuedActionRequeue
+		if err := s.store.UpdateNotebookForRescheduling(nb); err != nil {
+			return fmt.Errorf("requeue a notebook %s: %s", nb.NotebookID, err)
+		}
+		s.logger.Info("Requeued a notebook for draining", "notebookID", nb.NotebookID, "clusterID", c.ClusterID)
+	}
+	return nil
+}
+
+// cancelBatchJobs cancels the batch jobs in the cluster, and records the cancellation in their errors.
+func (s *S) cancelBatchJobs(c *store.Cluster) error {
+	jobs, err := s.store.ListBatchJobsByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.BatchJobState{
+		store.BatchJobStateQueued,
+		store.BatchJobStateRunning,
+	})
+	if err != nil {
+		return err
+	}
+	for i := range jobs {
+		job := &jobs[i]
+		if job.State == store.BatchJobStateQueued && job.QueuedAction != store.BatchJobQueuedActionCreate {
+			// The job is already being canceled or deleted.
+			continue
+		}
+		if err := job.MutateMessage(func(j *v1.BatchJob) {
+			j.Error = &v1.BatchJob_Error{
+				Code:    drainedErrorCode,
+				Message: fmt.Sprintf("canceled as cluster %s is drained", c.Name),
+			}
+		}); err != nil {
+			return err
+		}
+		if err := s.store.SetBatchJobQueuedActionAndMessage(job.JobID, job.Version, store.BatchJobQueuedActionCancel, job.Message); err != nil {
+			return fmt.Errorf("cancel a batch job %s: %s", job.JobID, err)
+		}
+		s.logger.Info("Canceled a batch job for draining", "jobID", job.JobID, "clusterID", c.ClusterID)
+	}
+	return nil
+}
+
+// cancelFineTuningJobs cancels the fine-tuning jobs in the cluster.
+func (s *S) cancelFineTuningJobs(c *store.Cluster) error {
+	jobs, err := s.store.ListJobsByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.JobState{
+		store.JobStateQueued,
+		store.JobStateRunning,
+	})
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		if job.State == store.JobStateQueued && job.QueuedAction != store.JobQueuedActionCreate {
+			// The job is already being canceled or deleted.
+			continue
+		}
+		if _, err := s.store.UpdateJobState(job.JobID, job.Version, store.JobStateQueued, store.JobQueuedActionCancel); err != nil {
+			return fmt.Errorf("cancel a job %s: %s", job.JobID, err)
+		}
+		s.logger.Info("Canceled a fine-tuning job for draining", "jobID", job.JobID, "clusterID", c.ClusterID)
+	}
+	return nil
+}
+
+// requeueBatchJobs requeues the batch jobs in the cluster. The rescheduler schedules them to other clusters
+// once the dispatcher deletes them from the cluster. Batch jobs that cannot be rescheduled are left running.
+func (s *S) requeueBatchJobs(c *store.Cluster) error {
+	jobs, err := s.store.ListBatchJobsByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.BatchJobState{
+		store.BatchJobStateQueued,
+		store.BatchJobStateRunning,
+	})
+	if err != nil {
+		return err
+	}
+	for i := range jobs {
+		job := &jobs[i]
+		if job.State == store.BatchJobStateQueued && job.QueuedAction != store.BatchJobQueuedActionCreate {
+			// The job is already being canceled, deleted, or requeued.
+			continue
+		}
+		if !job.Reschedulable() {
+			s.logger.Info("Skipped requeueing a batch job that cannot be rescheduled", "jobID", job.JobID, "clusterID", c.ClusterID)
+			continue
+		}
+		if _, err := s.store.SetBatchJobQueuedAction(job.JobID, job.Version, store.BatchJobQueuedActionRequeue); err != nil {
+			return fmt.Errorf("requeue a batch job %s: %s", job.JobID, err)
+		}
+		s.logger.Info("Requeued a batch job for draining", "jobID", job.JobID, "clusterID", c.ClusterID)
+	}
+	return nil
+}
+
+// requeueFineTuningJobs requeues the fine-tuning jobs in the cluster. The rescheduler schedules them to other
+// clusters once the dispatcher deletes them from the cluster. Jobs that cannot be rescheduled are left running.
+func (s *S) requeueFineTuningJobs(c *store.Cluster) error {
+	jobs, err := s.store.ListJobsByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.JobState{
+		store.JobStateQueued,
+		store.JobStateRunning,
+	})
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		if job.State == store.JobStateQueued && job.QueuedAction != store.JobQueuedActionCreate {
+			// The job is already being canceled or requeued.
+			continue
+		}
+		if !job.Reschedulable() {
+			s.logger.Info("Skipped requeueing a fine-tuning job that cannot be rescheduled", "jobID", job.JobID, "clusterID", c.ClusterID)
+			continue
+		}
+		if _, err := s.store.UpdateJobState(job.JobID, job.Version, store.JobStateQueued, store.JobQueuedActionRequeue); err != nil {
+			return fmt.Errorf("requeue a job %s: %s", job.JobID, err)
+		}
+		s.logger.Info("Requeued a fine-tuning job for draining", "jobID", job.JobID, "clusterID", c.ClusterID)
+	}
+	return nil
+}
+
+// finishDrain clears the draining flag of the cluster if no workload remains in the cluster.
+func (s *S) finishDrain(c *store.Cluster) error {
+	ds, err := s.drainStatus(c)
+	if err != nil {
+		return err
+	}
+	if ds.NotebookCount > 0 || ds.BatchJobCount > 0 || ds.FineTuningJobCount > 0 {
+		return nil
+	}
+	if err := s.store.ClearClusterDraining(c.ClusterID); err != nil {
+		return err
+	}
+	s.logger.Info("Drained a cluster", "clusterID", c.ClusterID)
+	return nil
+}
+
+// drainStatus returns the numbers of the workloads that remain in the cluster.
+func (s *S) drainStatus(c *store.Cluster) (*v1.DrainStatus, error) {
+	nbs, err := s.store.ListNotebooksByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.NotebookState{
+		store.NotebookStateQueued,
+		store.NotebookStateInitializing,
+		store.NotebookStateRunning,
+	})
+	if err != nil {
+		return nil, err
+	}
+	bjobs, err := s.store.ListBatchJobsByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.BatchJobState{
+		store.BatchJobStateQueued,
+		store.BatchJobStateRunning,
+	})
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := s.store.ListJobsByTenantIDAndClusterIDAndStates(c.TenantID, c.ClusterID, []store.JobState{
+		store.JobStateQueued,
+		store.JobStateRunning,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DrainStatus{
+		NotebookCount:      int32(len(nbs)),
+		BatchJobCount:      int32(len(bjobs)),
+		FineTuningJobCount: int32(len(jobs)),
+	}, nil
+}
+
+// unexpiredMaintenanceWindows returns the maintenance windows that have not ended.
+func unexpiredMaintenanceWindows(ws []store.MaintenanceWindow, now time.Time) []store.MaintenanceWindow {
+	var active []store.MaintenanceWindow
+	for _, w := range ws {
+		if w.EndTime.After(now) {
+			active = append(active, w)
+		}
+	}
+	return active
+}
+
+func toMaintenanceWindowProtos(ws []store.MaintenanceWindow) []*v1.MaintenanceWindow {
+	var protos []*v1.MaintenanceWindow
+	for _, w := range ws {
+		protos = append(protos, &v1.MaintenanceWindow{
+			Id:        w.ID,
+			StartTime: w.StartTime.Unix(),
+			EndTime:   w.EndTime.Unix(),
+			Reason:    w.Reason,
+		})
+	}
+	return protos
+}
@@ -0,0 +1,365 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/job-manager/server/internal/config"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCordonCluster(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateOrUpdateCluster(&store.Cluster{
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+	})
+	assert.NoError(t, err)
+
+	srv := New(st, nil, nil, nil, nil, cache.NewStore(st, testr.New(t)), nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	ctx := fakeAuthInto(context.Background())
+
+	_, err = srv.CordonCluster(ctx, &v1.CordonClusterRequest{Id: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	c, err := srv.CordonCluster(ctx, &v1.CordonClusterRequest{Id: defaultClusterID, Reason: "upgrade"})
+	assert.NoError(t, err)
+	assert.True(t, c.Cordoned)
+	assert.Equal(t, "upgrade", c.CordonReason)
+	assert.False(t, c.Draining)
+
+	// A status update from the dispatcher does not uncordon the cluster.
+	_, err = st.CreateOrUpdateCluster(&store.Cluster{
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+	})
+	assert.NoError(t, err)
+	resp, err := srv.ListClusters(ctx, &v1.ListClustersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Clusters, 1)
+	assert.True(t, resp.Clusters[0].Cordoned)
+	assert.Equal(t, "upgrade", resp.Clusters[0].CordonReason)
+
+	c, err = srv.UncordonCluster(ctx, &v1.UncordonClusterRequest{Id: defaultClusterID})
+	assert.NoError(t, err)
+	assert.False(t, c.Cordoned)
+	assert.Empty(t, c.CordonReason)
+}
+
+func TestDrainCluster(t *testing.T) {
+	tcs := []struct {
+		name          string
+		policy        v1.JobDrainPolicy
+		wantBatchJobs map[string]store.BatchJobQueuedAction
+		wantJobs      map[string]store.JobQueuedAction
+	}{
+		{
+			name:   "wait",
+			policy: v1.JobDrainPolicy_JOB_DRAIN_POLICY_UNSPECIFIED,
+			wantBatchJobs: map[string]store.BatchJobQueuedAction{
+				"bj0": "",
+				"bj1": store.BatchJobQueuedActionCreate,
+				"bj2": "",
+				"bj3": "",
+			},
+			wantJobs: map[string]store.JobQueuedAction{
+				"job0": "",
+				"job1": "",
+				"job2": store.JobQueuedActionCancel,
+			},
+		},
+		{
+			name:   "cancel",
+			policy: v1.JobDrainPolicy_JOB_DRAIN_POLICY_CANCEL,
+			wantBatchJobs: map[string]store.BatchJobQueuedAction{
+				"bj0": store.BatchJobQueuedActionCancel,
+				"bj1": store.BatchJobQueuedActionCancel,
+				"bj2": "",
+				"bj3": store.BatchJobQueuedActionCancel,
+			},
+			wantJobs: map[string]store.JobQueuedAction{
+				"job0": store.JobQueuedActionCancel,
+				"job1": "",
+				"job2": store.JobQueuedActionCancel,
+			},
+		},
+		{
+			name:   "requeue",
+			policy: v1.JobDrainPolicy_JOB_DRAIN_POLICY_REQUEUE,
+			wantBatchJobs: map[string]store.BatchJobQueuedAction{
+				"bj0": store.BatchJobQueuedActionRequeue,
+				"bj1": store.BatchJobQueuedActionRequeue,
+				"bj2": "",
+				// bj3 cannot be rescheduled as it has no scripts.
+				"bj3": "",
+			},
+			wantJobs: map[string]store.JobQueuedAction{
+				"job0": store.JobQueuedActionRequeue,
+				"job1": "",
+				"job2": store.JobQueuedActionCancel,
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			for _, c := range []*store.Cluster{
+				{ClusterID: defaultClusterID, Name: "c0", TenantID: defaultTenantID},
+				{ClusterID: "other", TenantID: defaultTenantID},
+			} {
+				_, err := st.CreateOrUpdateCluster(c)
+				assert.NoError(t, err)
+			}
+
+			for _, nb := range []*store.Notebook{
+				{NotebookID: "nb0", ClusterID: defaultClusterID, State: store.NotebookStateRunning},
+				{NotebookID: "nb1", ClusterID: defaultClusterID, State: store.NotebookStateQueued, QueuedAction: store.NotebookQueuedActionStart},
+				{NotebookID: "nb2", ClusterID: defaultClusterID, State: store.NotebookStateQueued, QueuedAction: store.NotebookQueuedActionDelete},
+				{NotebookID: "nb3", ClusterID: "other", State: store.NotebookStateRunning},
+			} {
+				nb.TenantID = defaultTenantID
+				err := st.CreateNotebook(nb)
+				assert.NoError(t, err)
+			}
+
+			msg, err := proto.Marshal(&v1.BatchJob{})
+			assert.NoError(t, err)
+			proj, err := toProjectMessage(&auth.UserInfo{ProjectID: defaultProjectID})
+			assert.NoError(t, err)
+			scripts := map[string][]byte{"main.py": []byte("print('hello')")}
+			for _, job := range []*store.BatchJob{
+				{JobID: "bj0", ClusterID: defaultClusterID, State: store.BatchJobStateRunning},
+				{JobID: "bj1", ClusterID: defaultClusterID, State: store.BatchJobStateQueued, QueuedAction: store.BatchJobQueuedActionCreate},
+				{JobID: "bj2", ClusterID: "other", State: store.BatchJobStateRunning},
+				{JobID: "bj3", ClusterID: defaultClusterID, State: store.BatchJobStateRunning},
+			} {
+				job.TenantID = defaultTenantID
+				job.Message = msg
+				if job.JobID != "bj3" {
+					job.ProjectMessage = proj
+					err := job.SetScripts(scripts)
+					assert.NoError(t, err)
+				}
+				err := st.CreateBatchJob(job)
+				assert.NoError(t, err)
+			}
+			for _, job := range []*store.Job{
+				{JobID: "job0", ClusterID: defaultClusterID, State: store.JobStateRunning},
+				{JobID: "job1", ClusterID: defaultClusterID, State: store.JobStateSucceeded},
+				{JobID: "job2", ClusterID: defaultClusterID, State: store.JobStateQueued, QueuedAction: store.JobQueuedActionCancel},
+			} {
+				job.TenantID = defaultTenantID
+				job.ProjectMessage = proj
+				err := st.CreateJob(job)
+				assert.NoError(t, err)
+			}
+
+			srv := New(st, nil, nil, nil, nil, cache.NewStore(st, testr.New(t)), nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+			ctx := fakeAuthInto(context.Background())
+
+			c, err := srv.DrainCluster(ctx, &v1.DrainClusterRequest{
+				Id:        defaultClusterID,
+				JobPolicy: tc.policy,
+			})
+			assert.NoError(t, err)
+			assert.True(t, c.Cordoned)
+			assert.True(t, c.Draining)
+			assert.Equal(t, int32(3), c.DrainStatus.NotebookCount)
+			assert.Equal(t, int32(3), c.DrainStatus.BatchJobCount)
+			assert.Equal(t, int32(2), c.DrainStatus.FineTuningJobCount)
+
+			wantNotebooks := map[string]store.NotebookQueuedAction{
+				"nb0": store.NotebookQueuedActionRequeue,
+				"nb1": store.NotebookQueuedActionRequeue,
+				"nb2": store.NotebookQueuedActionDelete,
+				"nb3": "",
+			}
+			for id, want := range wantNotebooks {
+				nb, err := st.GetNotebookByID(id)
+				assert.NoError(t, err)
+				assert.Equal(t, want, nb.QueuedAction, id)
+			}
+			for id, want := range tc.wantBatchJobs {
+				job, err := st.GetBatchJobByID(id)
+				assert.NoError(t, err)
+				assert.Equal(t, want, job.QueuedAction, id)
+				if want == store.BatchJobQueuedActionCancel {
+					jobProto, err := job.V1BatchJob()
+					assert.NoError(t, err)
+					assert.Equal(t, drainedErrorCode, jobProto.Error.Code)
+				}
+			}
+			for id, want := range tc.wantJobs {
+				job, err := st.GetJobByJobID(id)
+				assert.NoError(t, err)
+				assert.Equal(t, want, job.QueuedAction, id)
+			}
+
+			c, err = srv.UncordonCluster(ctx, &v1.UncordonClusterRequest{Id: defaultClusterID})
+			assert.NoError(t, err)
+			assert.False(t, c.Cordoned)
+			assert.False(t, c.Draining)
+			assert.Nil(t, c.DrainStatus)
+		})
+	}
+}
+
+func TestFinishDrain(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateOrUpdateCluster(&store.Cluster{
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+	})
+	assert.NoError(t, err)
+	c, err := st.UpdateClusterCordon(defaultClusterID, true, "drain", true)
+	assert.NoError(t, err)
+
+	job := &store.BatchJob{
+		JobID:     "bj0",
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+		State:     store.BatchJobStateRunning,
+	}
+	err = st.CreateBatchJob(job)
+	assert.NoError(t, err)
+
+	srv := New(st, nil, nil, nil, nil, cache.NewStore(st, testr.New(t)), nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+
+	// The cluster is still draining as the batch job is running.
+	err = srv.finishDrain(c)
+	assert.NoError(t, err)
+	c, err = st.GetClusterByID(defaultClusterID)
+	assert.NoError(t, err)
+	assert.True(t, c.Draining)
+
+	err = st.SetBatchJobState(job.JobID, job.Version, store.BatchJobStateSucceeded)
+	assert.NoError(t, err)
+	err = srv.finishDrain(c)
+	assert.NoError(t, err)
+	c, err = st.GetClusterByID(defaultClusterID)
+	assert.NoError(t, err)
+	assert.False(t, c.Draining)
+	assert.True(t, c.Cordoned)
+}
+
+func TestAccessResourceForGRPCRequest(t *testing.T) {
+	assert.Equal(t, clusterOperationAccessResource, accessResourceForGRPCRequest("/llmariner.jobs.server.v1.JobService/DrainCluster"))
+	assert.Equal(t, "api.fine_tuning.jobs", accessResourceForGRPCRequest("/llmariner.jobs.server.v1.JobService/ListClusters"))
+	assert.Equal(t, "api.workspaces.notebooks", accessResourceForGRPCRequest("/llmariner.workspace.server.v1.WorkspaceService/ListNotebooks"))
+}
+
+func TestMaintenanceWindows(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateOrUpdateCluster(&store.Cluster{
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+	})
+	assert.NoError(t, err)
+
+	srv := New(st, nil, nil, nil, nil, cache.NewStore(st, testr.New(t)), nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	ctx := fakeAuthInto(context.Background())
+
+	now := time.Now().Unix()
+	_, err = srv.CreateMaintenanceWindow(ctx, &v1.CreateMaintenanceWindowRequest{
+		ClusterId: defaultClusterID,
+		StartTime: now + 3600,
+		EndTime:   now,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.CreateMaintenanceWindow(ctx, &v1.CreateMaintenanceWindowRequest{
+		ClusterId: defaultClusterID,
+		StartTime: now - 7200,
+		EndTime:   now - 3600,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	c, err := srv.CreateMaintenanceWindow(ctx, &v1.CreateMaintenanceWindowRequest{
+		ClusterId: defaultClusterID,
+		StartTime: now + 3600,
+		EndTime:   now + 7200,
+		Reason:    "upgrade",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, c.MaintenanceWindows, 1)
+	w := c.MaintenanceWindows[0]
+	assert.Equal(t, now+3600, w.StartTime)
+	assert.Equal(t, now+7200, w.EndTime)
+	assert.Equal(t, "upgrade", w.Reason)
+
+	_, err = srv.DeleteMaintenanceWindow(ctx, &v1.DeleteMaintenanceWindowRequest{
+		ClusterId: defaultClusterID,
+		Id:        "unknown",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	c, err = srv.DeleteMaintenanceWindow(ctx, &v1.DeleteMaintenanceWindowRequest{
+		ClusterId: defaultClusterID,
+		Id:        w.Id,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, c.MaintenanceWindows)
+}
+
+func TestSetClusterLabels(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	b, err := proto.Marshal(&v1.ClusterStatus{
+		Labels: map[string]string{"region": "us-east-1", "provider": "aws"},
+	})
+	assert.NoError(t, err)
+	_, err = st.CreateOrUpdateCluster(&store.Cluster{
+		ClusterID: defaultClusterID,
+		TenantID:  defaultTenantID,
+		Status:    b,
+	})
+	assert.NoError(t, err)
+
+	srv := New(st, nil, nil, nil, nil, cache.NewStore(st, testr.New(t)), nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	ctx := fakeAuthInto(context.Background())
+
+	_, err = srv.SetClusterLabels(ctx, &v1.SetClusterLabelsRequest{
+		Id:     defaultClusterID,
+		Labels: map[string]string{"": "v"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	c, err := srv.SetClusterLabels(ctx, &v1.SetClusterLabelsRequest{
+		Id:     defaultClusterID,
+		Labels: map[string]string{"region": "us-west-2", "cost-tier": "spot"},
+	})
+	assert.NoError(t, err)
+	want := map[string]string{
+		"region":    "us-west-2",
+		"provider":  "aws",
+		"cost-tier": "spot",
+	}
+	assert.Equal(t, want, c.Labels)
+
+	resp, err := srv.ListClusters(ctx, &v1.ListClustersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Clusters, 1)
+	assert.Equal(t, want, resp.Clusters[0].Labels)
+
+	c, err = srv.SetClusterLabels(ctx, &v1.SetClusterLabelsRequest{Id: defaultClusterID})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"region": "us-east-1", "provider": "aws"}, c.Labels)
+}
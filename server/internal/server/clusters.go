@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"sort"
 
 	v1 "github.com/llmariner/job-manager/api/v1"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
 // ListClusters lists clusters.
@@ -35,30 +37,11 @@ func (s *S) ListClusters(ctx context.Context, req *v1.ListClustersRequest) (*v1.
 			continue
 		}
 
-		var st v1.ClusterStatus
-		if err := proto.Unmarshal(c.Status, &st); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to marshal cluster status: %s", err)
+		cp, err := s.toClusterProto(c)
+		if err != nil {
+			return nil, err
 		}
-		var gpuCapacity int32
-		for _, node := range st.GpuNodes {
-			gpuCapacity += node.AllocatableCount
-		}
-		var gpuAllocated int32
-		for _, pod := range st.GpuPods {
-			gpuAllocated += pod.AllocatedCount
-		}
-
-		cs = append(cs, &v1.Cluster{
-			Id:     c.ClusterID,
-			Name:   c.Name,
-			Status: &st,
-			Summary: &v1.Cluster_Summary{
-				GpuCapacity:  gpuCapacity,
-				GpuAllocated: gpuAllocated,
-				GpuPodCount:  int32(len(st.GpuPods)),
-			},
-			LastUpdatedAt: c.UpdatedAt.UnixNano(),
-		})
+		cs = append(cs, cp)
 	}
 	sort.Slice(cs, func(i, j int) bool {
 		return cs[i].Id < cs[j].Id
@@ -69,6 +52,93 @@ func (s *S) ListClusters(ctx context.Context, req *v1.ListClustersRequest) (*v1.
 	}, nil
 }
 
+// toClusterProto converts a cluster to its proto message.
+func (s *S) toClusterProto(c *store.Cluster) (*v1.Cluster, error) {
+	var st v1.ClusterStatus
+	if err := proto.Unmarshal(c.Status, &st); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal cluster status: %s", err)
+	}
+	var gpuCapacity, unhealthyGPUs int32
+	for _, node := range st.GpuNodes {
+		healthy, unhealthy := gpuHealthCounts(node)
+		gpuCapacity += healthy
+		unhealthyGPUs += unhealthy
+	}
+	var gpuAllocated int32
+	for _, pod := range st.GpuPods {
+		gpuAllocated += pod.AllocatedCount
+	}
+
+	var drainStatus *v1.DrainStatus
+	if c.Draining {
+		var err error
+		drainStatus, err = s.drainStatus(c)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get drain status: %s", err)
+		}
+	}
+
+	return &v1.Cluster{
+		Id:     c.ClusterID,
+		Name:   c.Name,
+		Status: &st,
+		Summary: &v1.Cluster_Summary{
+			GpuCapacity:       gpuCapacity,
+			GpuAllocated:      gpuAllocated,
+			GpuPodCount:       int32(len(st.GpuPods)),
+			UnhealthyGpuCount: unhealthyGPUs,
+		},
+		LastUpdatedAt:      c.UpdatedAt.UnixNano(),
+		UnhealthyNodes:     unhealthyNodes(st.GpuNodes),
+		Cordoned:           c.Cordoned,
+		CordonReason:       c.CordonReason,
+		Draining:           c.Draining,
+		DrainStatus:        drainStatus,
+		MaintenanceWindows: toMaintenanceWindowProtos(c.MaintenanceWindows),
+		HealthState:        toClusterHealthStateProto(c.HealthState),
+		Labels:             c.EffectiveLabels(st.Labels),
+	}, nil
+}
+
+// gpuHealthCounts returns the numbers of the healthy and unhealthy accelerators of the GPU node.
+func gpuHealthCounts(n *v1.GpuNode) (int32, int32) {
+	// The capacity is not reported by an old version of the dispatcher.
+	capacity := max(n.CapacityCount, n.AllocatableCount)
+	if len(n.UnhealthyReasons) > 0 {
+		return 0, capacity
+	}
+	return n.AllocatableCount, capacity - n.AllocatableCount
+}
+
+// unhealthyNodes returns the GPU nodes that have unhealthy accelerators, sorted by name.
+func unhealthyNodes(gpuNodes []*v1.GpuNode) []*v1.Cluster_UnhealthyNode {
+	byName := map[string]*v1.Cluster_UnhealthyNode{}
+	for _, n := range gpuNodes {
+		_, unhealthy := gpuHealthCounts(n)
+		if unhealthy == 0 {
+			continue
+		}
+		un, ok := byName[n.NodeName]
+		if !ok {
+			un = &v1.Cluster_UnhealthyNode{
+				Name:    n.NodeName,
+				Reasons: n.UnhealthyReasons,
+			}
+			byName[n.NodeName] = un
+		}
+		un.UnhealthyGpuCount += unhealthy
+	}
+
+	var nodes []*v1.Cluster_UnhealthyNode
+	for _, n := range byName {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	return nodes
+}
+
 // UpdateClusterStatus updates the cluster status.
 func (ws *WS) UpdateClusterStatus(
 	ctx context.Context,
@@ -89,10 +159,12 @@ func (ws *WS) UpdateClusterStatus(
 	}
 
 	c := &store.Cluster{
-		ClusterID: clusterInfo.ClusterID,
-		Name:      clusterInfo.ClusterName,
-		TenantID:  clusterInfo.TenantID,
-		Status:    b,
+		ClusterID:            clusterInfo.ClusterID,
+		Name:                 clusterInfo.ClusterName,
+		TenantID:             clusterInfo.TenantID,
+		Status:               b,
+		StatusSessionID:      req.SessionId,
+		StatusSequenceNumber: req.SequenceNumber,
 	}
 
 	c, err = ws.store.CreateOrUpdateCluster(c)
@@ -105,3 +177,113 @@ func (ws *WS) UpdateClusterStatus(
 
 	return &v1.UpdateClusterStatusResponse{}, nil
 }
+
+// UpdateClusterStatusDelta updates the cluster status with the changes since the last update.
+func (ws *WS) UpdateClusterStatusDelta(
+	ctx context.Context,
+	req *v1.UpdateClusterStatusDeltaRequest,
+) (*v1.UpdateClusterStatusDeltaResponse, error) {
+	clusterInfo, err := ws.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if req.SequenceNumber <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "sequence_number must be positive")
+	}
+
+	resync := &v1.UpdateClusterStatusDeltaResponse{ResyncRequired: true}
+	c, err := ws.store.GetClusterByID(clusterInfo.ClusterID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return resync, nil
+		}
+		return nil, status.Errorf(codes.Internal, "get cluster: %s", err)
+	}
+	if c.StatusSessionID != req.SessionId || c.StatusSequenceNumber+1 != req.SequenceNumber {
+		ws.logger.Info("Requesting a full resync of the cluster status", "clusterID", c.ClusterID,
+			"sessionID", req.SessionId, "sequenceNumber", req.SequenceNumber, "lastSequenceNumber", c.StatusSequenceNumber)
+		return resync, nil
+	}
+
+	var st v1.ClusterStatus
+	if err := proto.Unmarshal(c.Status, &st); err != nil {
+		return nil, status.Errorf(codes.Internal, "unmarshal cluster status: %s", err)
+	}
+	applyClusterStatusDelta(&st, req)
+	b, err := proto.Marshal(&st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshal proto: %s", err)
+	}
+
+	c, err = ws.store.UpdateClusterStatusDelta(c.ClusterID, req.SessionId, c.StatusSequenceNumber, req.SequenceNumber, b)
+	if err != nil {
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			return resync, nil
+		}
+		return nil, status.Errorf(codes.Internal, "update cluster: %s", err)
+	}
+	if err := ws.cache.AddOrUpdateCluster(c); err != nil {
+		return nil, status.Errorf(codes.Internal, "set cluster to cache: %s", err)
+	}
+
+	return &v1.UpdateClusterStatusDeltaResponse{}, nil
+}
+
+// applyClusterStatusDelta applies the changes of the delta to the cluster status.
+func applyClusterStatusDelta(st *v1.ClusterStatus, req *v1.UpdateClusterStatusDeltaRequest) {
+	nodeNames := map[string]bool{}
+	for _, n := range req.DeletedNodeNames {
+		nodeNames[n] = true
+	}
+	for _, n := range req.UpdatedGpuNodes {
+		nodeNames[n.NodeName] = true
+	}
+	var nodes []*v1.GpuNode
+	for _, n := range st.GpuNodes {
+		if !nodeNames[n.NodeName] {
+			nodes = append(nodes, n)
+		}
+	}
+	st.GpuNodes = append(nodes, req.UpdatedGpuNodes...)
+
+	podNames := map[string]bool{}
+	for _, n := range req.DeletedPodNames {
+		podNames[n] = true
+	}
+	for _, p := range req.UpdatedGpuPods {
+		podNames[p.NamespacedName] = true
+	}
+	var pods []*v1.GpuPod
+	for _, p := range st.GpuPods {
+		if !podNames[p.NamespacedName] {
+			pods = append(pods, p)
+		}
+	}
+	st.GpuPods = append(pods, req.UpdatedGpuPods...)
+
+	resourceNodeNames := map[string]bool{}
+	for _, n := range req.DeletedNodes {
+		resourceNodeNames[n] = true
+	}
+	for _, n := range req.UpdatedNodes {
+		resourceNodeNames[n.Name] = true
+	}
+	var resourceNodes []*v1.Node
+	for _, n := range st.Nodes {
+		if !resourceNodeNames[n.Name] {
+			resourceNodes = append(resourceNodes, n)
+		}
+	}
+	st.Nodes = append(resourceNodes, req.UpdatedNodes...)
+
+	if req.ProvisionableResourcesUpdated {
+		st.ProvisionableResources = req.ProvisionableResources
+	}
+	if req.NamespaceQuotasUpdated {
+		st.NamespaceQuotas = req.NamespaceQuotas
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/job-manager/api/v1"
 	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/job-manager/server/internal/config"
 	"github.com/llmariner/job-manager/server/internal/store"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/proto"
@@ -24,6 +25,19 @@ func TestListClusters(t *testing.T) {
 				ResourceName:     "nvidia.com/gpu",
 				AllocatableCount: 1,
 			},
+			{
+				ResourceName:     "nvidia.com/gpu",
+				AllocatableCount: 8,
+				CapacityCount:    8,
+				NodeName:         "n1",
+				UnhealthyReasons: []string{"NotReady"},
+			},
+			{
+				ResourceName:     "nvidia.com/gpu",
+				AllocatableCount: 6,
+				CapacityCount:    8,
+				NodeName:         "n2",
+			},
 		},
 		GpuPods: []*v1.GpuPod{
 			{
@@ -61,7 +75,7 @@ func TestListClusters(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+	srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 
 	ctx := fakeAuthInto(context.Background())
 	resp, err := srv.ListClusters(ctx, &v1.ListClustersRequest{})
@@ -72,11 +86,21 @@ func TestListClusters(t *testing.T) {
 	assert.True(t, proto.Equal(status, c.Status))
 
 	summary := &v1.Cluster_Summary{
-		GpuCapacity:  1,
-		GpuAllocated: 6,
-		GpuPodCount:  2,
+		GpuCapacity:       7,
+		GpuAllocated:      6,
+		GpuPodCount:       2,
+		UnhealthyGpuCount: 10,
 	}
 	assert.True(t, proto.Equal(summary, c.Summary))
+
+	wantUnhealthyNodes := []*v1.Cluster_UnhealthyNode{
+		{Name: "n1", Reasons: []string{"NotReady"}, UnhealthyGpuCount: 8},
+		{Name: "n2", UnhealthyGpuCount: 2},
+	}
+	assert.Len(t, c.UnhealthyNodes, len(wantUnhealthyNodes))
+	for i, want := range wantUnhealthyNodes {
+		assert.Truef(t, proto.Equal(want, c.UnhealthyNodes[i]), "got %v", c.UnhealthyNodes[i])
+	}
 }
 
 func TestUpdateClusterStatus(t *testing.T) {
@@ -87,7 +111,7 @@ func TestUpdateClusterStatus(t *testing.T) {
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
 
-	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 	req := &v1.UpdateClusterStatusRequest{
 		ClusterStatus: &v1.ClusterStatus{},
 	}
@@ -98,3 +122,90 @@ func TestUpdateClusterStatus(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, defaultClusterID, got.ClusterID)
 }
+
+func TestUpdateClusterStatusDelta(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	delta := &v1.UpdateClusterStatusDeltaRequest{
+		SessionId:      "session0",
+		SequenceNumber: 2,
+		UpdatedGpuNodes: []*v1.GpuNode{
+			{ResourceName: "nvidia.com/gpu", AllocatableCount: 4, NodeName: "n1"},
+			{ResourceName: "nvidia.com/mig-1g.10gb", AllocatableCount: 7, NodeName: "n1"},
+		},
+		DeletedNodeNames: []string{"n0"},
+		UpdatedGpuPods: []*v1.GpuPod{
+			{ResourceName: "nvidia.com/gpu", AllocatedCount: 2, NamespacedName: "ns/p1", NodeName: "n1"},
+		},
+		DeletedPodNames: []string{"ns/p0"},
+		UpdatedNodes: []*v1.Node{
+			{Name: "n1", AllocatableCpuMilicore: 8000, RequestedCpuMilicore: 2000},
+		},
+		DeletedNodes: []string{"n0"},
+		NamespaceQuotas: []*v1.NamespaceQuota{
+			{Namespace: "ns", Hard: map[string]int64{"nvidia.com/gpu": 8}, Used: map[string]int64{"nvidia.com/gpu": 2}},
+		},
+		NamespaceQuotasUpdated: true,
+	}
+
+	// No full status has been sent.
+	resp, err := srv.UpdateClusterStatusDelta(ctx, delta)
+	assert.NoError(t, err)
+	assert.True(t, resp.ResyncRequired)
+
+	_, err = srv.UpdateClusterStatus(ctx, &v1.UpdateClusterStatusRequest{
+		ClusterStatus: &v1.ClusterStatus{
+			GpuNodes: []*v1.GpuNode{
+				{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n0"},
+				{ResourceName: "nvidia.com/gpu", AllocatableCount: 8, NodeName: "n1"},
+			},
+			GpuPods: []*v1.GpuPod{
+				{ResourceName: "nvidia.com/gpu", AllocatedCount: 1, NamespacedName: "ns/p0", NodeName: "n0"},
+			},
+			Nodes: []*v1.Node{
+				{Name: "n0", AllocatableCpuMilicore: 8000, RequestedCpuMilicore: 1000},
+				{Name: "n1", AllocatableCpuMilicore: 8000},
+			},
+			NamespaceQuotas: []*v1.NamespaceQuota{
+				{Namespace: "ns", Hard: map[string]int64{"nvidia.com/gpu": 8}, Used: map[string]int64{"nvidia.com/gpu": 1}},
+			},
+		},
+		SessionId:      "session0",
+		SequenceNumber: 1,
+	})
+	assert.NoError(t, err)
+
+	resp, err = srv.UpdateClusterStatusDelta(ctx, delta)
+	assert.NoError(t, err)
+	assert.False(t, resp.ResyncRequired)
+
+	got, err := st.GetClusterByID(defaultClusterID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), got.StatusSequenceNumber)
+	var gotStatus v1.ClusterStatus
+	err = proto.Unmarshal(got.Status, &gotStatus)
+	assert.NoError(t, err)
+	want := &v1.ClusterStatus{
+		GpuNodes:        delta.UpdatedGpuNodes,
+		GpuPods:         delta.UpdatedGpuPods,
+		Nodes:           delta.UpdatedNodes,
+		NamespaceQuotas: delta.NamespaceQuotas,
+	}
+	assert.True(t, proto.Equal(want, &gotStatus))
+
+	// The same delta is sent again.
+	resp, err = srv.UpdateClusterStatusDelta(ctx, delta)
+	assert.NoError(t, err)
+	assert.True(t, resp.ResyncRequired)
+
+	// A delta of a different session.
+	delta.SessionId = "session1"
+	delta.SequenceNumber = 3
+	resp, err = srv.UpdateClusterStatusDelta(ctx, delta)
+	assert.NoError(t, err)
+	assert.True(t, resp.ResyncRequired)
+}
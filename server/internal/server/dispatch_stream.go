@@ -0,0 +1,363 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// defaultDispatchPollingInterval is the interval to poll the queued actions of the clusters whose dispatchers are
+// connected to this server replica. Polling delivers the actions queued by other server replicas, which do not
+// signal this replica.
+const defaultDispatchPollingInterval = 5 * time.Second
+
+type dispatchTarget struct {
+	tenantID  string
+	clusterID string
+}
+
+// dispatchBroker delivers the queued actions to the streams of the dispatchers connected to this server replica.
+// It lists the queued actions of the subscribed clusters when the store signals that a queued action is set, and
+// polls them periodically as a fallback.
+type dispatchBroker struct {
+	store  *store.S
+	logger logr.Logger
+
+	mu sync.Mutex
+	// subscribers are the channels of the connected streams. Each channel receives the latest queued actions
+	// of its cluster.
+	subscribers map[dispatchTarget]map[chan []*v1.DispatchAction]struct{}
+}
+
+func newDispatchBroker(s *store.S, logger logr.Logger) *dispatchBroker {
+	return &dispatchBroker{
+		store:       s,
+		logger:      logger.WithName("dispatch"),
+		subscribers: map[dispatchTarget]map[chan []*v1.DispatchAction]struct{}{},
+	}
+}
+
+// subscribe registers a stream of the cluster. The returned function unregisters the stream.
+func (b *dispatchBroker) subscribe(t dispatchTarget) (<-chan []*v1.DispatchAction, func()) {
+	ch := make(chan []*v1.DispatchAction, 1)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	subs, ok := b.subscribers[t]
+	if !ok {
+		subs = map[chan []*v1.DispatchAction]struct{}{}
+		b.subscribers[t] = subs
+	}
+	subs[ch] = struct{}{}
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(subs, ch)
+		if len(subs) == 0 {
+			delete(b.subscribers, t)
+		}
+	}
+}
+
+func (b *dispatchBroker) run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-b.store.QueuedActionSet():
+		case <-ticker.C:
+		}
+		if err := b.publish(); err != nil {
+			b.logger.Error(err, "Failed to publish queued actions")
+		}
+	}
+}
+
+// publish delivers the queued actions to the subscribed streams. The queued actions are listed per cluster
+// without holding the lock so that the queries do not block the streams.
+func (b *dispatchBroker) publish() error {
+	b.mu.Lock()
+	targets := make([]dispatchTarget, 0, len(b.subscribers))
+	for t := range b.subscribers {
+		targets = append(targets, t)
+	}
+	b.mu.Unlock()
+
+	var errs []error
+	for _, t := range targets {
+		actions, err := listDispatchActions(b.store, t)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cluster %q: %s", t.clusterID, err))
+			continue
+		}
+
+		b.mu.Lock()
+		for ch := range b.subscribers[t] {
+			// Replace the actions that the stream has not received yet. Only the latest actions matter.
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- actions
+		}
+		b.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// listDispatchActions lists the queued actions of the cluster.
+func listDispatchActions(st *store.S, t dispatchTarget) ([]*v1.DispatchAction, error) {
+	jobs, err := st.ListQueuedJobsByTenantIDAndClusterID(t.tenantID, t.clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("list queued jobs: %s", err)
+	}
+	nbs, err := st.ListQueuedNotebooksByTenantIDAndClusterID(t.tenantID, t.clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("list queued notebooks: %s", err)
+	}
+	bjobs, err := st.ListQueuedBatchJobsByTenantIDAndClusterID(t.tenantID, t.clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("list queued batch jobs: %s", err)
+	}
+	return toDispatchActions(jobs, nbs, bjobs)[t], nil
+}
+
+// toDispatchActions converts queued workloads to the dispatch actions grouped by the clusters and sorted by
+// their cursors. The cursor of an action is the dispatch sequence number allocated when the action was queued.
+//
+// A sequence number can become visible after a greater one when their transactions commit in the reverse order.
+// Such an action is still pushed to the connected streams, and the polling of the dispatcher processes the action
+// if the dispatcher has reconnected with a greater cursor.
+func toDispatchActions(jobs []*store.Job, nbs []*store.Notebook, bjobs []store.BatchJob) map[dispatchTarget][]*v1.DispatchAction {
+	actions := map[dispatchTarget][]*v1.DispatchAction{}
+	add := func(tenantID, clusterID string, a *v1.DispatchAction) {
+		if a.Action == "" {
+			// The workload is waiting for being scheduled.
+			return
+		}
+		t := dispatchTarget{tenantID: tenantID, clusterID: clusterID}
+		actions[t] = append(actions[t], a)
+	}
+	for _, job := range jobs {
+		add(job.TenantID, job.ClusterID, &v1.DispatchAction{
+			WorkloadType: v1.DispatchAction_JOB,
+			Id:           job.JobID,
+			Action:       string(job.QueuedAction),
+			Cursor:       job.DispatchSequence,
+		})
+	}
+	for _, nb := range nbs {
+		add(nb.TenantID, nb.ClusterID, &v1.DispatchAction{
+			WorkloadType: v1.DispatchAction_NOTEBOOK,
+			Id:           nb.NotebookID,
+			Action:       string(nb.QueuedAction),
+			Cursor:       nb.DispatchSequence,
+		})
+	}
+	for _, job := range bjobs {
+		add(job.TenantID, job.ClusterID, &v1.DispatchAction{
+			WorkloadType: v1.DispatchAction_BATCH_JOB,
+			Id:           job.JobID,
+			Action:       string(job.QueuedAction),
+			Cursor:       job.DispatchSequence,
+		})
+	}
+	for _, as := range actions {
+		sort.Slice(as, func(i, j int) bool {
+			if as[i].Cursor != as[j].Cursor {
+				return as[i].Cursor < as[j].Cursor
+			}
+			return as[i].Id < as[j].Id
+		})
+	}
+	return actions
+}
+
+// dispatchActionFilter filters out the actions that have already been pushed to a stream.
+type dispatchActionFilter struct {
+	// cursors are the cursors that the dispatcher resumes the stream after keyed by the workload types.
+	cursors map[v1.DispatchAction_WorkloadType]int64
+	// pushed is the cursors of the pushed actions keyed by their workload IDs.
+	pushed map[string]int64
+}
+
+func newDispatchActionFilter(cursors []*v1.DispatchCursor) *dispatchActionFilter {
+	f := &dispatchActionFilter{
+		cursors: map[v1.DispatchAction_WorkloadType]int64{},
+		pushed:  map[string]int64{},
+	}
+	for _, c := range cursors {
+		f.cursors[c.WorkloadType] = c.Cursor
+	}
+	return f
+}
+
+// filter returns the actions to push from all queued actions of the cluster.
+func (f *dispatchActionFilter) filter(actions []*v1.DispatchAction) []*v1.DispatchAction {
+	pushed := make(map[string]int64, len(actions))
+	var filtered []*v1.DispatchAction
+	for _, a := range actions {
+		pushed[a.Id] = a.Cursor
+		if a.Cursor <= f.cursors[a.WorkloadType] {
+			continue
+		}
+		if c, ok := f.pushed[a.Id]; ok && c == a.Cursor {
+			continue
+		}
+		filtered = append(filtered, a)
+	}
+	// Forget the actions that are no longer queued.
+	f.pushed = pushed
+	return filtered
+}
+
+// StreamQueuedActions pushes the queued actions of the cluster to the dispatcher.
+func (ws *WS) StreamQueuedActions(stream v1.JobWorkerService_StreamQueuedActionsServer) error {
+	ctx := stream.Context()
+	clusterInfo, err := ws.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	sub := req.GetSubscribe()
+	if sub == nil {
+		return status.Error(codes.InvalidArgument, "the first message must be subscribe")
+	}
+
+	log := ws.logger.WithValues("clusterID", clusterInfo.ClusterID)
+	log.Info("Dispatcher connected", "cursors", sub.Cursors)
+
+	t := dispatchTarget{tenantID: clusterInfo.TenantID, clusterID: clusterInfo.ClusterID}
+	actionsCh, unsubscribe := ws.dispatchBroker.subscribe(t)
+	defer unsubscribe()
+
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			ack := req.GetAck()
+			if ack == nil {
+				errCh <- status.Error(codes.InvalidArgument, "ack is required")
+				return
+			}
+			if ack.Error != "" {
+				log.Info("Dispatcher failed to process the action", "workloadType", ack.WorkloadType, "id", ack.Id, "cursor", ack.Cursor, "error", ack.Error)
+				continue
+			}
+			log.V(1).Info("Dispatcher processed the action", "workloadType", ack.WorkloadType, "id", ack.Id, "cursor", ack.Cursor)
+		}
+	}()
+
+	actions, err := listDispatchActions(ws.store, t)
+	if err != nil {
+		return status.Errorf(codes.Internal, "list queued actions: %s", err)
+	}
+
+	f := newDispatchActionFilter(sub.Cursors)
+	for {
+		for _, a := range f.filter(actions) {
+			if err := stream.Send(&v1.StreamQueuedActionsResponse{Action: a}); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			if errors.Is(err, io.EOF) {
+				log.Info("Dispatcher disconnected")
+				return nil
+			}
+			return err
+		case actions = <-actionsCh:
+		}
+	}
+}
+
+// UpdateLastDispatchError updates the error of the last attempt of the dispatcher to process the queued action
+// of a workload.
+func (ws *WS) UpdateLastDispatchError(ctx context.Context, req *v1.UpdateLastDispatchErrorRequest) (*v1.UpdateLastDispatchErrorResponse, error) {
+	clusterInfo, err := ws.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	var (
+		tenantID  string
+		clusterID string
+		update    func(id, lastDispatchError string) error
+	)
+	switch req.WorkloadType {
+	case v1.DispatchAction_JOB:
+		job, err := ws.store.GetJobByJobID(req.Id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "job not found")
+			}
+			return nil, status.Errorf(codes.Internal, "get job: %s", err)
+		}
+		tenantID = job.TenantID
+		clusterID = job.ClusterID
+		update = ws.store.UpdateJobLastDispatchError
+	case v1.DispatchAction_NOTEBOOK:
+		nb, err := ws.store.GetNotebookByID(req.Id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "notebook not found")
+			}
+			return nil, status.Errorf(codes.Internal, "get notebook: %s", err)
+		}
+		tenantID = nb.TenantID
+		clusterID = nb.ClusterID
+		update = ws.store.UpdateNotebookLastDispatchError
+	case v1.DispatchAction_BATCH_JOB:
+		job, err := ws.store.GetBatchJobByID(req.Id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "batch job not found")
+			}
+			return nil, status.Errorf(codes.Internal, "get batch job: %s", err)
+		}
+		tenantID = job.TenantID
+		clusterID = job.ClusterID
+		update = ws.store.UpdateBatchJobLastDispatchError
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown workload type: %s", req.WorkloadType)
+	}
+	// Only the cluster that the workload is assigned to can report the error.
+	if tenantID != clusterInfo.TenantID || clusterID != clusterInfo.ClusterID {
+		return nil, status.Errorf(codes.NotFound, "workload not found")
+	}
+
+	if err := update(req.Id, req.Error); err != nil {
+		return nil, status.Errorf(codes.Internal, "update last dispatch error: %s", err)
+	}
+	return &v1.UpdateLastDispatchErrorResponse{}, nil
+}
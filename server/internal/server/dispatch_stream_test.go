@@ -0,0 +1,285 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/job-manager/server/internal/config"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamQueuedActions(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createJob := func(id, clusterID string, state store.JobState, action store.JobQueuedAction) {
+		err := st.CreateJob(&store.Job{
+			JobID:        id,
+			TenantID:     defaultTenantID,
+			ClusterID:    clusterID,
+			State:        state,
+			QueuedAction: action,
+		})
+		assert.NoError(t, err)
+	}
+	createJob("job0", defaultClusterID, store.JobStateQueued, store.JobQueuedActionCreate)
+	createJob("job1", defaultClusterID, store.JobStateRunning, "")
+	createJob("job2", "different-cluster", store.JobStateQueued, store.JobQueuedActionCreate)
+	err := st.CreateNotebook(&store.Notebook{
+		NotebookID:   "nb0",
+		TenantID:     defaultTenantID,
+		ClusterID:    defaultClusterID,
+		State:        store.NotebookStateQueued,
+		QueuedAction: store.NotebookQueuedActionStart,
+	})
+	assert.NoError(t, err)
+	err = st.CreateBatchJob(&store.BatchJob{
+		JobID:        "bj0",
+		TenantID:     defaultTenantID,
+		ClusterID:    defaultClusterID,
+		State:        store.BatchJobStateQueued,
+		QueuedAction: store.BatchJobQueuedActionCancel,
+	})
+	assert.NoError(t, err)
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	stream := newFakeDispatchStream()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.StreamQueuedActions(stream)
+	}()
+
+	stream.reqs <- &v1.StreamQueuedActionsRequest{
+		Message: &v1.StreamQueuedActionsRequest_Subscribe_{
+			Subscribe: &v1.StreamQueuedActionsRequest_Subscribe{},
+		},
+	}
+	got := map[string]*v1.DispatchAction{}
+	for range 3 {
+		resp := <-stream.resps
+		got[resp.Action.Id] = resp.Action
+	}
+	assert.Len(t, got, 3)
+	assert.Equal(t, v1.DispatchAction_JOB, got["job0"].WorkloadType)
+	assert.Equal(t, string(store.JobQueuedActionCreate), got["job0"].Action)
+	assert.Equal(t, v1.DispatchAction_NOTEBOOK, got["nb0"].WorkloadType)
+	assert.Equal(t, string(store.NotebookQueuedActionStart), got["nb0"].Action)
+	assert.Equal(t, v1.DispatchAction_BATCH_JOB, got["bj0"].WorkloadType)
+	assert.Equal(t, string(store.BatchJobQueuedActionCancel), got["bj0"].Action)
+
+	stream.reqs <- &v1.StreamQueuedActionsRequest{
+		Message: &v1.StreamQueuedActionsRequest_Ack_{
+			Ack: &v1.StreamQueuedActionsRequest_Ack{
+				Id:     "job0",
+				Cursor: got["job0"].Cursor,
+			},
+		},
+	}
+
+	// Only the new action is pushed. The broker is signaled without waiting for the polling.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = srv.dispatchBroker.run(ctx, time.Hour)
+	}()
+	createJob("job3", defaultClusterID, store.JobStateQueued, store.JobQueuedActionCreate)
+	resp := <-stream.resps
+	assert.Equal(t, "job3", resp.Action.Id)
+	assert.Greater(t, resp.Action.Cursor, got["bj0"].Cursor)
+
+	close(stream.reqs)
+	assert.NoError(t, <-errCh)
+	assert.Empty(t, srv.dispatchBroker.subscribers)
+
+	// The dispatcher resumes the stream after the cursor of each workload type.
+	stream = newFakeDispatchStream()
+	go func() {
+		errCh <- srv.StreamQueuedActions(stream)
+	}()
+	stream.reqs <- &v1.StreamQueuedActionsRequest{
+		Message: &v1.StreamQueuedActionsRequest_Subscribe_{
+			Subscribe: &v1.StreamQueuedActionsRequest_Subscribe{
+				Cursors: []*v1.DispatchCursor{
+					{WorkloadType: v1.DispatchAction_JOB, Cursor: resp.Action.Cursor},
+					{WorkloadType: v1.DispatchAction_BATCH_JOB, Cursor: got["bj0"].Cursor},
+				},
+			},
+		},
+	}
+	resp = <-stream.resps
+	assert.Equal(t, "nb0", resp.Action.Id)
+	close(stream.reqs)
+	assert.NoError(t, <-errCh)
+	assert.Empty(t, stream.resps)
+}
+
+func TestStreamQueuedActions_NoSubscribe(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	stream := newFakeDispatchStream()
+	stream.reqs <- &v1.StreamQueuedActionsRequest{
+		Message: &v1.StreamQueuedActionsRequest_Ack_{
+			Ack: &v1.StreamQueuedActionsRequest_Ack{Id: "job0"},
+		},
+	}
+	err := srv.StreamQueuedActions(stream)
+	assert.Error(t, err)
+}
+
+func TestDispatchActionFilter(t *testing.T) {
+	f := newDispatchActionFilter([]*v1.DispatchCursor{
+		{WorkloadType: v1.DispatchAction_JOB, Cursor: 10},
+		{WorkloadType: v1.DispatchAction_NOTEBOOK, Cursor: 3},
+	})
+	job := func(id string, cursor int64) *v1.DispatchAction {
+		return &v1.DispatchAction{WorkloadType: v1.DispatchAction_JOB, Id: id, Cursor: cursor}
+	}
+
+	got := f.filter([]*v1.DispatchAction{
+		job("a0", 5),
+		job("a1", 10),
+		job("a2", 11),
+		// The cursors of other workload types are applied to their actions.
+		{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "n0", Cursor: 2},
+		{WorkloadType: v1.DispatchAction_NOTEBOOK, Id: "n1", Cursor: 5},
+		{WorkloadType: v1.DispatchAction_BATCH_JOB, Id: "b0", Cursor: 1},
+	})
+	assert.Len(t, got, 3)
+	assert.Equal(t, "a2", got[0].Id)
+	assert.Equal(t, "n1", got[1].Id)
+	assert.Equal(t, "b0", got[2].Id)
+
+	got = f.filter([]*v1.DispatchAction{
+		job("a0", 5),
+		// The action of the workload has been updated.
+		job("a1", 12),
+		job("a2", 11),
+		job("a3", 11),
+	})
+	assert.Len(t, got, 2)
+	assert.Equal(t, "a1", got[0].Id)
+	assert.Equal(t, "a3", got[1].Id)
+
+	// An action is pushed again once the workload is queued again with the same cursor.
+	got = f.filter(nil)
+	assert.Empty(t, got)
+	got = f.filter([]*v1.DispatchAction{job("a3", 11)})
+	assert.Len(t, got, 1)
+}
+
+func TestUpdateLastDispatchError(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateJob(&store.Job{
+		JobID:     "job0",
+		TenantID:  defaultTenantID,
+		ClusterID: defaultClusterID,
+		State:     store.JobStateQueued,
+	})
+	assert.NoError(t, err)
+	err = st.CreateNotebook(&store.Notebook{
+		NotebookID: "nb0",
+		TenantID:   "different-tenant",
+		ClusterID:  defaultClusterID,
+		State:      store.NotebookStateQueued,
+	})
+	assert.NoError(t, err)
+	err = st.CreateBatchJob(&store.BatchJob{
+		JobID:     "bjob0",
+		TenantID:  defaultTenantID,
+		ClusterID: "different-cluster",
+		State:     store.BatchJobStateQueued,
+	})
+	assert.NoError(t, err)
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+	_, err = srv.UpdateLastDispatchError(ctx, &v1.UpdateLastDispatchErrorRequest{
+		WorkloadType: v1.DispatchAction_JOB,
+		Id:           "job0",
+		Error:        "failed",
+	})
+	assert.NoError(t, err)
+	job, err := st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	jobProto, err := job.V1Job()
+	assert.NoError(t, err)
+	assert.Equal(t, "failed", jobProto.LastDispatchError)
+
+	_, err = srv.UpdateLastDispatchError(ctx, &v1.UpdateLastDispatchErrorRequest{
+		WorkloadType: v1.DispatchAction_JOB,
+		Id:           "job0",
+	})
+	assert.NoError(t, err)
+	job, err = st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	assert.Empty(t, job.LastDispatchError)
+
+	// The notebook of another tenant is not found.
+	_, err = srv.UpdateLastDispatchError(ctx, &v1.UpdateLastDispatchErrorRequest{
+		WorkloadType: v1.DispatchAction_NOTEBOOK,
+		Id:           "nb0",
+		Error:        "failed",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The batch job assigned to another cluster is not found.
+	_, err = srv.UpdateLastDispatchError(ctx, &v1.UpdateLastDispatchErrorRequest{
+		WorkloadType: v1.DispatchAction_BATCH_JOB,
+		Id:           "bjob0",
+		Error:        "failed",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	bjob, err := st.GetBatchJobByID("bjob0")
+	assert.NoError(t, err)
+	assert.Empty(t, bjob.LastDispatchError)
+
+	_, err = srv.UpdateLastDispatchError(ctx, &v1.UpdateLastDispatchErrorRequest{
+		Id:    "job0",
+		Error: "failed",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+type fakeDispatchStream struct {
+	grpc.ServerStream
+
+	reqs  chan *v1.StreamQueuedActionsRequest
+	resps chan *v1.StreamQueuedActionsResponse
+}
+
+func newFakeDispatchStream() *fakeDispatchStream {
+	return &fakeDispatchStream{
+		reqs:  make(chan *v1.StreamQueuedActionsRequest, 10),
+		resps: make(chan *v1.StreamQueuedActionsResponse, 10),
+	}
+}
+
+func (s *fakeDispatchStream) Context() context.Context {
+	return fakeAuthInto(context.Background())
+}
+
+func (s *fakeDispatchStream) Recv() (*v1.StreamQueuedActionsRequest, error) {
+	req, ok := <-s.reqs
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func (s *fakeDispatchStream) Send(resp *v1.StreamQueuedActionsResponse) error {
+	s.resps <- resp
+	return nil
+}
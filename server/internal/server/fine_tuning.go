@@ -10,6 +10,7 @@ import (
 	"github.com/llmariner/common/pkg/id"
 	fv1 "github.com/llmariner/file-manager/api/v1"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/scheduler"
 	"github.com/llmariner/job-manager/server/internal/store"
 	mv1 "github.com/llmariner/model-manager/api/v1"
 	"github.com/llmariner/rbac-manager/pkg/auth"
@@ -160,24 +161,45 @@ func (s *S) CreateJob(
 		}
 	}
 
+	if err := s.validatePlacement(userInfo, req.Placement); err != nil {
+		return nil, err
+	}
+
 	// Assume the minimum schedulable GPU count is 1.
 	gpuCount := int32(1)
+	w := scheduler.Workload{PodCount: 1}
+	applyPlacement(&w, req.Placement)
 	if req.Resources != nil {
 		if req.Resources.GpuCount < 0 {
 			return nil, status.Errorf(codes.InvalidArgument, "gpu count must be non-negative")
 		}
+		if req.Resources.CpuMilicore < 0 || req.Resources.MemoryMegabytes < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "cpu and memory must be non-negative")
+		}
 		if req.Resources.GpuCount > gpuCount {
 			gpuCount = req.Resources.GpuCount
 		}
-	}
-	sresult, err := s.scheduler.Schedule(userInfo, "", int(gpuCount))
+		if err := validateAcceleratorType(req.Resources.AcceleratorType); err != nil {
+			return nil, err
+		}
+		if err := validateGPUModel(req.Resources.AcceleratorType, req.Resources.GpuType, req.Resources.MinGpuMemoryMegabytes); err != nil {
+			return nil, err
+		}
+		w.AcceleratorType = req.Resources.AcceleratorType
+		w.GPUType = req.Resources.GpuType
+		w.MinGPUMemoryMegabytes = int(req.Resources.MinGpuMemoryMegabytes)
+		w.CPUMilicorePerPod = int(req.Resources.CpuMilicore)
+		w.MemoryMegabytesPerPod = int(req.Resources.MemoryMegabytes)
+	}
+	w.GPUCountPerPod = int(gpuCount)
+	sresult, err := scheduleAndReserve(s.cache, userInfo.TenantID, w, func(*store.S) (scheduler.SchedulingResult, error) {
+		return s.scheduler.Schedule(userInfo, "", w)
+	}, func(ns string) []string {
+		return []string{fmt.Sprintf("%s/%s", ns, jobID)}
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "schedule: %s", err)
 	}
-	if err := s.cache.AddAssumedPod(userInfo.TenantID, sresult.ClusterID,
-		fmt.Sprintf("%s/%s", sresult.Namespace, jobID), int(gpuCount)); err != nil {
-		return nil, status.Errorf(codes.Internal, "add assumed pod: %s", err)
-	}
 
 	jobProto := &v1.Job{
 		Id:              jobID,
@@ -201,23 +223,34 @@ func (s *S) CreateJob(
 		ProjectTitle:      userInfo.ProjectTitle,
 		ClusterName:       sresult.ClusterName,
 		Resources: &v1.Job_Resources{
-			GpuCount: gpuCount,
+			GpuCount:              gpuCount,
+			AcceleratorType:       w.AcceleratorType,
+			GpuType:               w.GPUType,
+			MinGpuMemoryMegabytes: int32(w.MinGPUMemoryMegabytes),
+			CpuMilicore:           int32(w.CPUMilicorePerPod),
+			MemoryMegabytes:       int32(w.MemoryMegabytesPerPod),
 		},
+		Placement: req.Placement,
 	}
 	msg, err := proto.Marshal(jobProto)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "marshal job: %s", err)
 	}
 
+	proj, err := toProjectMessage(userInfo)
+	if err != nil {
+		return nil, err
+	}
 	job := &store.Job{
-		JobID:        jobID,
-		State:        store.JobStateQueued,
-		QueuedAction: store.JobQueuedActionCreate,
-		Message:      msg,
-		Suffix:       req.Suffix,
-		TenantID:     userInfo.TenantID,
-		ProjectID:    userInfo.ProjectID,
-		ClusterID:    sresult.ClusterID,
+		JobID:          jobID,
+		State:          store.JobStateQueued,
+		QueuedAction:   store.JobQueuedActionCreate,
+		Message:        msg,
+		ProjectMessage: proj,
+		Suffix:         req.Suffix,
+		TenantID:       userInfo.TenantID,
+		ProjectID:      userInfo.ProjectID,
+		ClusterID:      sresult.ClusterID,
 	}
 	if err := s.store.CreateJob(job); err != nil {
 		return nil, status.Errorf(codes.Internal, "create job: %s", err)
@@ -226,6 +259,22 @@ func (s *S) CreateJob(
 	return jobProto, nil
 }
 
+// fineTuningJobWorkload returns the workload of a fine-tuning job that requests the given resources with the
+// placement constraints.
+func fineTuningJobWorkload(r *v1.Job_Resources, p *v1.Job_Placement) scheduler.Workload {
+	w := scheduler.Workload{PodCount: 1}
+	applyPlacement(&w, p)
+	if r != nil {
+		w.GPUCountPerPod = int(r.GpuCount)
+		w.AcceleratorType = r.AcceleratorType
+		w.GPUType = r.GpuType
+		w.MinGPUMemoryMegabytes = int(r.MinGpuMemoryMegabytes)
+		w.CPUMilicorePerPod = int(r.CpuMilicore)
+		w.MemoryMegabytesPerPod = int(r.MemoryMegabytes)
+	}
+	return w
+}
+
 func (s *S) validateFile(ctx context.Context, fileID string) error {
 	if _, err := s.fileGetClient.GetFile(ctx, &fv1.GetFileRequest{
 		Id: fileID,
@@ -358,7 +407,8 @@ func (s *S) CancelJob(
 		store.JobStateFailed,
 		store.JobStateCanceled:
 		return jobProto, nil
-	case store.JobStateRunning:
+	case store.JobStateRunning,
+		store.JobStateRequeued:
 	case store.JobStateQueued:
 		if job.QueuedAction == store.JobQueuedActionCancel {
 			return jobProto, nil
@@ -401,6 +451,7 @@ func (ws *WS) ListQueuedInternalJobs(ctx context.Context, req *v1.ListQueuedInte
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "convert job to internal job: %s", err)
 		}
+		jobProto.KueueQueue = ws.jobKueueQueue(job.ProjectID)
 		ijobs = append(ijobs, jobProto)
 	}
 	return &v1.ListQueuedInternalJobsResponse{Jobs: ijobs}, nil
@@ -432,9 +483,23 @@ func (ws *WS) GetInternalJob(ctx context.Context, req *v1.GetInternalJobRequest)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "convert job to internal job: %s", err)
 	}
+	jobProto.KueueQueue = ws.jobKueueQueue(job.ProjectID)
 	return jobProto, nil
 }
 
+// jobKueueQueue returns the Kueue queue currently configured for the project. It returns nil if the project
+// has no queue.
+func (ws *WS) jobKueueQueue(projectID string) *v1.InternalJob_KueueQueue {
+	q, ok := ws.kueueProjects[projectID]
+	if !ok {
+		return nil
+	}
+	return &v1.InternalJob_KueueQueue{
+		Name:           q.QueueName,
+		ResourceQuotas: q.ResourceQuotas,
+	}
+}
+
 // UpdateJobPhase updates the job status depending on the given phase.
 func (ws *WS) UpdateJobPhase(ctx context.Context, req *v1.UpdateJobPhaseRequest) (resp *v1.UpdateJobPhaseResponse, err error) {
 	clusterInfo, err := ws.extractClusterInfoFromContext(ctx)
@@ -505,7 +570,11 @@ func (ws *WS) UpdateJobPhase(ctx context.Context, req *v1.UpdateJobPhaseRequest)
 	case v1.UpdateJobPhaseRequest_FAILED:
 		if err := job.MutateMessage(func(j *v1.Job) {
 			j.FinishedAt = time.Now().UTC().Unix()
-			j.Error = &v1.Job_Error{Message: req.Message}
+			j.Error = &v1.Job_Error{
+				Code:     req.ErrorCode,
+				Message:  req.Message,
+				ExitCode: req.ExitCode,
+			}
 		}); err != nil {
 			return nil, status.Errorf(codes.Internal, "mutate message: %s", err)
 		}
@@ -519,8 +588,43 @@ func (ws *WS) UpdateJobPhase(ctx context.Context, req *v1.UpdateJobPhaseRequest)
 		if _, err := ws.store.UpdateJobState(req.Id, job.Version, store.JobStateQueued, store.JobQueuedActionCreate); err != nil {
 			return nil, status.Errorf(codes.Internal, "update job state: %s", err)
 		}
+	case v1.UpdateJobPhaseRequest_REQUEUED:
+		if job.State != store.JobStateQueued || job.QueuedAction != store.JobQueuedActionRequeue {
+			return nil, status.Errorf(codes.FailedPrecondition, "job state is not requeueing: %s (%s)", job.State, job.QueuedAction)
+		}
+		if _, err := ws.store.UpdateJobState(req.Id, job.Version, store.JobStateRequeued, ""); err != nil {
+			return nil, status.Errorf(codes.Internal, "update job state: %s", err)
+		}
 	default:
 		return nil, status.Errorf(codes.Internal, "unknown phase: %v", req.Phase)
 	}
 	return &v1.UpdateJobPhaseResponse{}, nil
 }
+
+// UpdateJobStatusReason updates the reason why a job is not making progress.
+func (ws *WS) UpdateJobStatusReason(ctx context.Context, req *v1.UpdateJobStatusReasonRequest) (*v1.UpdateJobStatusReasonResponse, error) {
+	clusterInfo, err := ws.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	job, err := ws.store.GetJobByJobID(req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "get job: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "get job: %s", err)
+	}
+	if job.TenantID != clusterInfo.TenantID {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+
+	if err := ws.store.UpdateJobStatusReason(req.Id, req.StatusReason); err != nil {
+		return nil, status.Errorf(codes.Internal, "update job status reason: %s", err)
+	}
+	return &v1.UpdateJobStatusReasonResponse{}, nil
+}
@@ -3,12 +3,14 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/go-logr/logr/testr"
 	fv1 "github.com/llmariner/file-manager/api/v1"
 	v1 "github.com/llmariner/job-manager/api/v1"
 	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/job-manager/server/internal/config"
 	"github.com/llmariner/job-manager/server/internal/k8s"
 	"github.com/llmariner/job-manager/server/internal/scheduler"
 	"github.com/llmariner/job-manager/server/internal/store"
@@ -19,6 +21,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -118,6 +121,9 @@ func TestCreateJob(t *testing.T) {
 				&fakeCache{},
 				nil,
 				nil,
+				config.PreemptionConfig{},
+				nil,
+				config.LogArchiveConfig{},
 				testr.New(t),
 				nil)
 			resp, err := srv.CreateJob(fakeAuthInto(context.Background()), tc.req)
@@ -160,6 +166,9 @@ func TestCreateJob_GPUInMetadata(t *testing.T) {
 		&fakeCache{},
 		nil,
 		nil,
+		config.PreemptionConfig{},
+		nil,
+		config.LogArchiveConfig{},
 		testr.New(t),
 		nil)
 
@@ -199,7 +208,7 @@ func TestListJobs(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, nil, testr.New(t), nil)
+	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 	ctx := fakeAuthInto(context.Background())
 	resp, err := srv.ListJobs(ctx, &v1.ListJobsRequest{Limit: 5})
 	assert.NoError(t, err)
@@ -253,7 +262,7 @@ func TestGetJob(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, nil, testr.New(t), nil)
+	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 	resp, err := srv.GetJob(fakeAuthInto(context.Background()), &v1.GetJobRequest{Id: jobID})
 	assert.NoError(t, err)
 	assert.Equal(t, string(store.JobQueuedActionCreate), resp.Status)
@@ -304,7 +313,7 @@ func TestJobCancel(t *testing.T) {
 			})
 			assert.NoError(t, err)
 
-			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, nil, testr.New(t), nil)
+			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 			resp, err := srv.CancelJob(fakeAuthInto(context.Background()), &v1.CancelJobRequest{Id: jobID})
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want.Status, resp.Status)
@@ -353,7 +362,7 @@ func TestListQueuedInternalJobs(t *testing.T) {
 		}))
 	}
 
-	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 	req := &v1.ListQueuedInternalJobsRequest{}
 	got, err := srv.ListQueuedInternalJobs(fakeAuthInto(context.Background()), req)
 	assert.NoError(t, err)
@@ -376,13 +385,49 @@ func TestGetInternalJob(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 	req := &v1.GetInternalJobRequest{Id: "job0"}
 	resp, err := srv.GetInternalJob(fakeAuthInto(context.Background()), req)
 	assert.NoError(t, err)
 	assert.Equal(t, store.JobStateRunning, store.JobState(resp.Job.Status))
 }
 
+func TestGetInternalJob_KueueQueue(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	for _, job := range []*store.Job{
+		{JobID: "job0", ProjectID: defaultProjectID},
+		{JobID: "job1", ProjectID: "other-project"},
+	} {
+		job.TenantID = defaultTenantID
+		job.State = store.JobStateQueued
+		assert.NoError(t, st.CreateJob(job))
+	}
+
+	srv := NewWorkerServiceServer(
+		st,
+		cache.NewStore(st, testr.New(t)),
+		config.KueueConfig{
+			Projects: map[string]config.KueueProjectConfig{
+				defaultProjectID: {
+					QueueName:      "queue0",
+					ResourceQuotas: map[string]string{"nvidia.com/gpu": "8"},
+				},
+			},
+		},
+		testr.New(t))
+
+	resp, err := srv.GetInternalJob(fakeAuthInto(context.Background()), &v1.GetInternalJobRequest{Id: "job0"})
+	assert.NoError(t, err)
+	assert.Equal(t, "queue0", resp.KueueQueue.GetName())
+	assert.Equal(t, map[string]string{"nvidia.com/gpu": "8"}, resp.KueueQueue.GetResourceQuotas())
+
+	resp, err = srv.GetInternalJob(fakeAuthInto(context.Background()), &v1.GetInternalJobRequest{Id: "job1"})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.KueueQueue)
+}
+
 func TestUpdateJobPhase(t *testing.T) {
 	var tests = []struct {
 		name       string
@@ -478,6 +523,24 @@ func TestUpdateJobPhase(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name:       "phase requeued",
+			prevState:  store.JobStateQueued,
+			prevAction: store.JobQueuedActionRequeue,
+			req: &v1.UpdateJobPhaseRequest{
+				Phase: v1.UpdateJobPhaseRequest_REQUEUED,
+			},
+			wantState: store.JobStateRequeued,
+		},
+		{
+			name:       "phase requeued, previous state is not requeueing",
+			prevState:  store.JobStateQueued,
+			prevAction: store.JobQueuedActionCreate,
+			req: &v1.UpdateJobPhaseRequest{
+				Phase: v1.UpdateJobPhaseRequest_REQUEUED,
+			},
+			wantError: true,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -486,15 +549,16 @@ func TestUpdateJobPhase(t *testing.T) {
 
 			const jobID = "job0"
 			err := st.CreateJob(&store.Job{
-				JobID:    jobID,
-				TenantID: defaultTenantID,
-				State:    test.prevState,
+				JobID:        jobID,
+				TenantID:     defaultTenantID,
+				State:        test.prevState,
+				QueuedAction: test.prevAction,
 			})
 			assert.NoError(t, err)
 
 			test.req.Id = jobID
 
-			srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+			srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 			_, err = srv.UpdateJobPhase(fakeAuthInto(context.Background()), test.req)
 			if test.wantError {
 				assert.Error(t, err)
@@ -509,6 +573,113 @@ func TestUpdateJobPhase(t *testing.T) {
 	}
 }
 
+func TestUpdateJobPhase_FailedWithErrorCode(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateJob(&store.Job{
+		JobID:    "job0",
+		TenantID: defaultTenantID,
+		State:    store.JobStateRunning,
+	})
+	assert.NoError(t, err)
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	_, err = srv.UpdateJobPhase(fakeAuthInto(context.Background()), &v1.UpdateJobPhaseRequest{
+		Id:        "job0",
+		Phase:     v1.UpdateJobPhaseRequest_FAILED,
+		Message:   "container main was OOM killed",
+		ErrorCode: "oom_killed",
+	})
+	assert.NoError(t, err)
+
+	job, err := st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.JobStateFailed, job.State)
+	jobProto, err := job.V1Job()
+	assert.NoError(t, err)
+	assert.Equal(t, "oom_killed", jobProto.Error.Code)
+	assert.Equal(t, "container main was OOM killed", jobProto.Error.Message)
+}
+
+func TestUpdateJobPhase_FailedWithExitCode(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateJob(&store.Job{
+		JobID:    "job0",
+		TenantID: defaultTenantID,
+		State:    store.JobStateRunning,
+	})
+	assert.NoError(t, err)
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	_, err = srv.UpdateJobPhase(fakeAuthInto(context.Background()), &v1.UpdateJobPhaseRequest{
+		Id:        "job0",
+		Phase:     v1.UpdateJobPhaseRequest_FAILED,
+		Message:   "container main exited with code 2",
+		ErrorCode: "user_error",
+		ExitCode:  2,
+	})
+	assert.NoError(t, err)
+
+	job, err := st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	jobProto, err := job.V1Job()
+	assert.NoError(t, err)
+	assert.Equal(t, "user_error", jobProto.Error.Code)
+	assert.Equal(t, int32(2), jobProto.Error.ExitCode)
+}
+
+func TestUpdateJobStatusReason(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateJob(&store.Job{
+		JobID:    "job0",
+		TenantID: defaultTenantID,
+		State:    store.JobStateRunning,
+	})
+	assert.NoError(t, err)
+	err = st.CreateJob(&store.Job{
+		JobID:    "job1",
+		TenantID: "different-tenant",
+		State:    store.JobStateRunning,
+	})
+	assert.NoError(t, err)
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+	_, err = srv.UpdateJobStatusReason(ctx, &v1.UpdateJobStatusReasonRequest{
+		Id:           "job0",
+		StatusReason: "ImagePullBackOff: Back-off pulling image",
+	})
+	assert.NoError(t, err)
+	job, err := st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	jobProto, err := job.V1Job()
+	assert.NoError(t, err)
+	assert.Equal(t, "ImagePullBackOff: Back-off pulling image", jobProto.StatusReason)
+
+	_, err = srv.UpdateJobStatusReason(ctx, &v1.UpdateJobStatusReasonRequest{
+		Id: "job0",
+	})
+	assert.NoError(t, err)
+	job, err = st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	assert.Empty(t, job.StatusReason)
+
+	// The job of another tenant is not found.
+	_, err = srv.UpdateJobStatusReason(ctx, &v1.UpdateJobStatusReasonRequest{
+		Id:           "job1",
+		StatusReason: "reason",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.UpdateJobStatusReason(ctx, &v1.UpdateJobStatusReasonRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 type noopFileGetClient struct {
 	ids map[string]bool
 }
@@ -549,14 +720,22 @@ func (f *noopK8sClientFactory) NewDynamicClient(clusterID, token string) (k8s.Dy
 
 type noopK8sClient struct{}
 
-func (c *noopK8sClient) CreateSecret(ctx context.Context, name, namespace string, data map[string][]byte) error {
+func (c *noopK8sClient) CreateSecret(ctx context.Context, name, namespace string, labels map[string]string, data map[string][]byte) error {
 	return nil
 }
 
-func (c *noopK8sClient) CreateConfigMap(ctx context.Context, name, namespace string, data map[string][]byte) error {
+func (c *noopK8sClient) CreateConfigMap(ctx context.Context, name, namespace string, labels map[string]string, data map[string][]byte) error {
 	return nil
 }
 
+func (c *noopK8sClient) ListPods(ctx context.Context, namespace, labelSelector string) ([]corev1.Pod, error) {
+	return nil, nil
+}
+
+func (c *noopK8sClient) StreamPodLogs(ctx context.Context, name, namespace string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 type noopDynClient struct{}
 
 func (c *noopDynClient) PatchResource(ctx context.Context, name, namespace string, gvr schema.GroupVersionResource, data []byte) (*unstructured.Unstructured, error) {
@@ -569,7 +748,7 @@ func (c *noopDynClient) DeleteResource(ctx context.Context, name, namespace stri
 
 type fakeScheduler struct{}
 
-func (s *fakeScheduler) Schedule(userInfo *auth.UserInfo, clusterID string, gpuCount int) (scheduler.SchedulingResult, error) {
+func (s *fakeScheduler) Schedule(userInfo *auth.UserInfo, clusterID string, w scheduler.Workload) (scheduler.SchedulingResult, error) {
 	if len(userInfo.AssignedKubernetesEnvs) == 0 {
 		return scheduler.SchedulingResult{}, fmt.Errorf("no kuberentes cluster/namespace")
 	}
@@ -580,6 +759,25 @@ func (s *fakeScheduler) Schedule(userInfo *auth.UserInfo, clusterID string, gpuC
 	}, nil
 }
 
-type fakeCache struct{}
+func (s *fakeScheduler) FindPreemptionVictims(
+	userInfo *auth.UserInfo,
+	clusterID string,
+	w scheduler.Workload,
+	priority int32,
+	candidates []scheduler.PreemptionCandidate,
+) (scheduler.PreemptionResult, error) {
+	return scheduler.PreemptionResult{}, fmt.Errorf("no preemption victims found")
+}
 
-func (c *fakeCache) AddAssumedPod(tenantID, clusterID, key string, gpuCount int) error { return nil }
+type fakeCache struct {
+	store *store.S
+}
+
+func (c *fakeCache) Reserve(tenantID string, fn func(tx *store.S) (cache.Reservation, error)) error {
+	_, err := fn(c.store)
+	return err
+}
+
+func (c *fakeCache) AddOrUpdateCluster(cluster *store.Cluster) error {
+	return nil
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/logarchive"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// GetJobLogArchives returns the presigned URLs of the archived logs of a fine-tuning job.
+func (s *S) GetJobLogArchives(
+	ctx context.Context,
+	req *v1.GetJobLogArchivesRequest,
+) (*v1.GetJobLogArchivesResponse, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if !s.logArchiveConfig.Enable {
+		return nil, status.Error(codes.FailedPrecondition, "log archive is not enabled")
+	}
+
+	if _, err := s.store.GetJobByJobIDAndProjectID(req.Id, userInfo.ProjectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "get job: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "get job: %s", err)
+	}
+
+	archives, expiresAt, err := s.getLogArchives(ctx, logarchive.KindFineTuningJob, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	var resp v1.GetJobLogArchivesResponse
+	for _, a := range archives {
+		resp.Archives = append(resp.Archives, &v1.JobLogArchive{
+			PodName:       a.podName,
+			ContainerName: a.containerName,
+			ChunkUrls:     a.chunkURLs,
+		})
+	}
+	resp.ExpiresAt = expiresAt
+	return &resp, nil
+}
+
+// GetBatchJobLogArchives returns the presigned URLs of the archived logs of a batch job.
+func (s *S) GetBatchJobLogArchives(
+	ctx context.Context,
+	req *v1.GetBatchJobLogArchivesRequest,
+) (*v1.GetBatchJobLogArchivesResponse, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if !s.logArchiveConfig.Enable {
+		return nil, status.Error(codes.FailedPrecondition, "log archive is not enabled")
+	}
+
+	if _, err := s.store.GetActiveBatchJobByIDAndProjectID(req.Id, userInfo.ProjectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "get batch job: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "get batch job: %s", err)
+	}
+
+	archives, expiresAt, err := s.getLogArchives(ctx, logarchive.KindBatchJob, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	var resp v1.GetBatchJobLogArchivesResponse
+	for _, a := range archives {
+		resp.Archives = append(resp.Archives, &v1.BatchJobLogArchive{
+			PodName:       a.podName,
+			ContainerName: a.containerName,
+			ChunkUrls:     a.chunkURLs,
+		})
+	}
+	resp.ExpiresAt = expiresAt
+	return &resp, nil
+}
+
+// logArchive is the archived log of a container.
+type logArchive struct {
+	podName       string
+	containerName string
+	chunkURLs     []string
+}
+
+// getLogArchives lists the archived log chunks of a workload and generates their presigned URLs. The archives are
+// sorted by pod names and container names, and the chunks of each archive are sorted by their indexes.
+func (s *S) getLogArchives(ctx context.Context, kind, id string) ([]*logArchive, int64, error) {
+	prefix := logarchive.Prefix(s.logArchiveConfig.PathPrefix, kind, id)
+	keys, err := s.logArchiveClient.ListObjectKeys(ctx, prefix)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "list log archives: %s", err)
+	}
+
+	type chunk struct {
+		logarchive.Chunk
+		key string
+	}
+	var chunks []chunk
+	for _, key := range keys {
+		c, err := logarchive.ParseChunkKey(prefix, key)
+		if err != nil {
+			s.logger.Error(err, "Ignoring an unexpected object in the log archive", "key", key)
+			continue
+		}
+		chunks = append(chunks, chunk{Chunk: c, key: key})
+	}
+	sort.Slice(chunks, func(i, j int) bool {
+		a, b := chunks[i], chunks[j]
+		if a.PodName != b.PodName {
+			return a.PodName < b.PodName
+		}
+		if a.ContainerName != b.ContainerName {
+			return a.ContainerName < b.ContainerName
+		}
+		return a.Index < b.Index
+	})
+
+	expiresAt := time.Now().Add(s.logArchiveConfig.URLExpiration).Unix()
+	var archives []*logArchive
+	for _, c := range chunks {
+		url, err := s.logArchiveClient.GeneratePresignedURL(ctx, c.key, s.logArchiveConfig.URLExpiration)
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "generate presigned url: %s", err)
+		}
+		if n := len(archives); n > 0 && archives[n-1].podName == c.PodName && archives[n-1].containerName == c.ContainerName {
+			archives[n-1].chunkURLs = append(archives[n-1].chunkURLs, url)
+			continue
+		}
+		archives = append(archives, &logArchive{
+			podName:       c.PodName,
+			containerName: c.ContainerName,
+			chunkURLs:     []string{url},
+		})
+	}
+	return archives, expiresAt, nil
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/config"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetJobLogArchives(t *testing.T) {
+	const jobID = "job0"
+
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateJob(&store.Job{
+		JobID:     jobID,
+		TenantID:  defaultTenantID,
+		ProjectID: defaultProjectID,
+		State:     store.JobStateSucceeded,
+	})
+	assert.NoError(t, err)
+
+	lc := &fakeLogArchiveClient{
+		keys: []string{
+			"logs/fine-tuning-jobs/job0/job0-abcde/main/00001.log.gz",
+			"logs/fine-tuning-jobs/job0/job0-abcde/init/00000.log.gz",
+			"logs/fine-tuning-jobs/job0/job0-abcde/main/00000.log.gz",
+			"logs/fine-tuning-jobs/job0/unexpected",
+		},
+	}
+	srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, lc, config.LogArchiveConfig{
+		Enable:        true,
+		PathPrefix:    "logs",
+		URLExpiration: time.Hour,
+	}, testr.New(t), nil)
+
+	resp, err := srv.GetJobLogArchives(fakeAuthInto(context.Background()), &v1.GetJobLogArchivesRequest{Id: jobID})
+	assert.NoError(t, err)
+	assert.Equal(t, "logs/fine-tuning-jobs/job0/", lc.prefix)
+	want := []*v1.JobLogArchive{
+		{
+			PodName:       "job0-abcde",
+			ContainerName: "init",
+			ChunkUrls:     []string{"https://s3/logs/fine-tuning-jobs/job0/job0-abcde/init/00000.log.gz"},
+		},
+		{
+			PodName:       "job0-abcde",
+			ContainerName: "main",
+			ChunkUrls: []string{
+				"https://s3/logs/fine-tuning-jobs/job0/job0-abcde/main/00000.log.gz",
+				"https://s3/logs/fine-tuning-jobs/job0/job0-abcde/main/00001.log.gz",
+			},
+		},
+	}
+	assert.Equal(t, want, resp.Archives)
+	assert.Greater(t, resp.ExpiresAt, time.Now().Unix())
+
+	_, err = srv.GetJobLogArchives(fakeAuthInto(context.Background()), &v1.GetJobLogArchivesRequest{Id: "job1"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetBatchJobLogArchives(t *testing.T) {
+	const jobID = "job0"
+
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateBatchJob(&store.BatchJob{
+		JobID:     jobID,
+		TenantID:  defaultTenantID,
+		ProjectID: defaultProjectID,
+		State:     store.BatchJobStateFailed,
+	})
+	assert.NoError(t, err)
+
+	lc := &fakeLogArchiveClient{
+		keys: []string{
+			"logs/batch-jobs/job0/job0-1-fghij/main/00000.log.gz",
+			"logs/batch-jobs/job0/job0-0-abcde/main/00000.log.gz",
+		},
+	}
+	logArchiveConfig := config.LogArchiveConfig{
+		Enable:        true,
+		PathPrefix:    "logs",
+		URLExpiration: time.Hour,
+	}
+	srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, lc, logArchiveConfig, testr.New(t), nil)
+
+	resp, err := srv.GetBatchJobLogArchives(fakeAuthInto(context.Background()), &v1.GetBatchJobLogArchivesRequest{Id: jobID})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Archives, 2)
+	assert.Equal(t, "job0-0-abcde", resp.Archives[0].PodName)
+	assert.Equal(t, "job0-1-fghij", resp.Archives[1].PodName)
+
+	srv = New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	_, err = srv.GetBatchJobLogArchives(fakeAuthInto(context.Background()), &v1.GetBatchJobLogArchivesRequest{Id: jobID})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+type fakeLogArchiveClient struct {
+	keys   []string
+	prefix string
+}
+
+func (c *fakeLogArchiveClient) ListObjectKeys(ctx context.Context, prefix string) ([]string, error) {
+	c.prefix = prefix
+	var keys []string
+	for _, k := range c.keys {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func (c *fakeLogArchiveClient) GeneratePresignedURL(ctx context.Context, key string, expire time.Duration) (string, error) {
+	return "https://s3/" + key, nil
+}
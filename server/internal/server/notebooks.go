@@ -9,6 +9,8 @@ import (
 
 	"github.com/llmariner/common/pkg/id"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"github.com/llmariner/job-manager/pkg/workload"
 	"github.com/llmariner/job-manager/server/internal/scheduler"
 	"github.com/llmariner/job-manager/server/internal/store"
 	rbacv1 "github.com/llmariner/rbac-manager/api/v1"
@@ -62,15 +64,34 @@ func (s *S) CreateNotebook(ctx context.Context, req *v1.CreateNotebookRequest) (
 	}
 
 	// TODO(aya): validate resources
+	if r := req.Resources; r != nil {
+		if err := validateAcceleratorType(r.AcceleratorType); err != nil {
+			return nil, err
+		}
+		if err := validateGPUModel(r.AcceleratorType, r.GpuType, r.MinGpuMemoryMegabytes); err != nil {
+			return nil, err
+		}
+		if err := validateGPUProfile(r.AcceleratorType, r.GpuProfile); err != nil {
+			return nil, err
+		}
+		if r.GpuProfile != "" && r.GpuCount == 0 {
+			// Request one fractional GPU by default.
+			r.GpuCount = 1
+		}
+	}
 
-	nbID, err := id.GenerateIDForK8SResource("nb-")
+	preemptible, err := s.validatePriority(userInfo.ProjectID, req.Priority, req.Preemptible)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "generate notebook id: %s", err)
+		return nil, err
 	}
 
-	var gpuCount int
-	if r := req.Resources; r != nil {
-		gpuCount = int(r.GpuCount)
+	if err := s.validatePlacement(userInfo, req.Placement); err != nil {
+		return nil, err
+	}
+
+	nbID, err := id.GenerateIDForK8SResource("nb-")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate notebook id: %s", err)
 	}
 
 	proj, err := toProjectMessage(userInfo)
@@ -106,7 +127,7 @@ func (s *S) CreateNotebook(ctx context.Context, req *v1.CreateNotebookRequest) (
 		return nil, status.Errorf(codes.Internal, "set token: %s", err)
 	}
 
-	sresult, err := s.scheduleNotebook(ctx, nb, gpuCount)
+	sresult, p, err := s.scheduleNotebook(ctx, nb, notebookWorkload(req.Resources, req.Placement), req.Priority)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "schedule: %s", err)
 	}
@@ -134,6 +155,10 @@ func (s *S) CreateNotebook(ctx context.Context, req *v1.CreateNotebookRequest) (
 		ClusterName:       sresult.ClusterName,
 
 		AdditionalExposedPorts: req.AdditionalExposedPorts,
+
+		Priority:    req.Priority,
+		Preemptible: preemptible,
+		Placement:   req.Placement,
 	}
 	msg, err := proto.Marshal(nbProto)
 	if err != nil {
@@ -141,7 +166,9 @@ func (s *S) CreateNotebook(ctx context.Context, req *v1.CreateNotebookRequest) (
 	}
 	nb.Message = msg
 
-	if err := s.store.CreateNotebook(nb); err != nil {
+	if err := s.persistWithPreemption(p, func(tx *store.S) error {
+		return tx.CreateNotebook(nb)
+	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "create notebook: %s", err)
 	}
 
@@ -167,44 +194,74 @@ func toProjectMessage(userInfo *auth.UserInfo) ([]byte, error) {
 	return proj, nil
 }
 
-func (s *S) scheduleNotebook(ctx context.Context, nb *store.Notebook, gpuCount int) (scheduler.SchedulingResult, error) {
-	userInfo, err := nb.RebuildUserInfo()
-	if err != nil {
-		return scheduler.SchedulingResult{}, status.Errorf(codes.Internal, "rebuild user info: %s", err)
+// notebookWorkload returns the workload of a notebook that requests the given resources with the placement constraints.
+func notebookWorkload(r *v1.Resources, p *v1.Placement) scheduler.Workload {
+	w := scheduler.Workload{
+		PodCount:              1,
+		GPUCountPerPod:        int(r.GetGpuCount()),
+		AcceleratorType:       accelerator.ResourceName(r.GetAcceleratorType(), r.GetGpuProfile()),
+		GPUType:               r.GetGpuType(),
+		MinGPUMemoryMegabytes: int(r.GetMinGpuMemoryMegabytes()),
+		CPUMilicorePerPod:     requestedQuantity(r.GetCpuMilicore()),
+		MemoryMegabytesPerPod: requestedQuantity(r.GetMemoryMegabytes()),
+	}
+	applyPlacement(&w, p)
+	return w
+}
+
+// requestedQuantity returns the requested quantity of a resource. Kubernetes sets the request to the limit
+// if only the limit is specified.
+func requestedQuantity(q *v1.Resources_Quantity) int {
+	if r := q.GetRequests(); r > 0 {
+		return int(r)
 	}
+	return int(q.GetLimits())
+}
 
-	sresult, err := s.scheduler.Schedule(userInfo, nb.ClusterID, gpuCount)
+// scheduleNotebook schedules a notebook and creates its secret in the scheduled cluster. The returned preemption
+// must be applied when the notebook is persisted.
+func (s *S) scheduleNotebook(ctx context.Context, nb *store.Notebook, w scheduler.Workload, priority int32) (scheduler.SchedulingResult, *preemption, error) {
+	userInfo, err := nb.RebuildUserInfo()
 	if err != nil {
-		return sresult, status.Errorf(codes.Internal, "schedule: %s", err)
-	}
-	if err := s.cache.AddAssumedPod(userInfo.TenantID, sresult.ClusterID,
-		fmt.Sprintf("%s/%s", sresult.Namespace, nb.NotebookID), gpuCount); err != nil {
-		return sresult, status.Errorf(codes.Internal, "add assumed pod: %s", err)
+		return scheduler.SchedulingResult{}, nil, status.Errorf(codes.Internal, "rebuild user info: %s", err)
+	}
+
+	var p *preemption
+	sresult, err := scheduleAndReserve(s.cache, userInfo.TenantID, w, func(tx *store.S) (scheduler.SchedulingResult, error) {
+		var sresult scheduler.SchedulingResult
+		var err error
+		sresult, p, err = s.scheduleWithPreemption(tx, userInfo, nb.ClusterID, w, priority, nb.NotebookID)
+		return sresult, err
+	}, func(ns string) []string {
+		return []string{fmt.Sprintf("%s/%s", ns, nb.NotebookID)}
+	})
+	if err != nil {
+		return sresult, nil, status.Errorf(codes.Internal, "schedule: %s", err)
 	}
 
 	// Get the API key and token using the helper methods
 	apiKey, err := nb.GetAPIKey(ctx, s.dataKey)
 	if err != nil {
-		return sresult, status.Errorf(codes.Internal, "get api key: %s", err)
+		return sresult, nil, status.Errorf(codes.Internal, "get api key: %s", err)
 	}
 
 	token, err := nb.GetToken(ctx, s.dataKey)
 	if err != nil {
-		return sresult, status.Errorf(codes.Internal, "get token: %s", err)
+		return sresult, nil, status.Errorf(codes.Internal, "get token: %s", err)
 	}
 
 	kclient, err := s.k8sClientFactory.NewClient(sresult.ClusterID, apiKey)
 	if err != nil {
-		return sresult, status.Errorf(codes.Internal, "create k8s client: %s", err)
+		return sresult, nil, status.Errorf(codes.Internal, "create k8s client: %s", err)
 	}
-	if err := kclient.CreateSecret(ctx, nb.NotebookID, sresult.Namespace, map[string][]byte{
+	if err := kclient.CreateSecret(ctx, nb.NotebookID, sresult.Namespace, workload.Labels(workload.KindNotebook, nb.NotebookID), map[string][]byte{
 		"OPENAI_API_KEY":    []byte(apiKey),
 		"NOTEBOOK_TOKEN":    []byte(token),
 		"LLMARINER_API_KEY": []byte(apiKey),
 	}); err != nil {
-		return sresult, status.Errorf(codes.Internal, "create secret: %s", err)
+		return sresult, nil, status.Errorf(codes.Internal, "create secret: %s", err)
 	}
-	return sresult, nil
+	return sresult, p, nil
 }
 
 // ListNotebooks lists notebooks.
@@ -464,14 +521,17 @@ func (ws *WS) UpdateNotebookState(ctx context.Context, req *v1.UpdateNotebookSta
 		return nil, status.Error(codes.NotFound, "notebook not found")
 	}
 
-	if nb.State == convertNotebookState(req.State) {
-		// already in the state
+	if nb.State == convertNotebookState(req.State) || (req.State == v1.NotebookState_STATE_UNSPECIFIED && req.Error != nil) {
+		// Already in the state or only the error is reported.
+		if err := ws.updateNotebookError(nb, req.Error); err != nil {
+			return nil, err
+		}
 		return &v1.UpdateNotebookStateResponse{}, nil
 	}
 
 	switch req.State {
 	case v1.NotebookState_STATE_UNSPECIFIED:
-		return nil, status.Error(codes.InvalidArgument, "state is required")
+		return nil, status.Error(codes.InvalidArgument, "state or error is required")
 	case v1.NotebookState_INITIALIZING:
 		if nb.State != store.NotebookStateQueued {
 			return nil, status.Errorf(codes.FailedPrecondition, "notebook is not queued state: %s", nb.State)
@@ -484,6 +544,8 @@ func (ws *WS) UpdateNotebookState(ctx context.Context, req *v1.UpdateNotebookSta
 				nb.StartedAt = time.Now().UTC().Unix()
 			}
 			nb.StoppedAt = 0
+			// Clear the error of the previous run (e.g., preemption).
+			nb.Error = req.Error
 		}); err != nil {
 			return nil, status.Errorf(codes.Internal, "mutate message: %s", err)
 		}
@@ -537,16 +599,20 @@ func (ws *WS) UpdateNotebookState(ctx context.Context, req *v1.UpdateNotebookSta
 			return nil, status.Errorf(codes.Internal, "set non queued state and message: %s", err)
 		}
 	case v1.NotebookState_REQUEUED:
-		if nb.State != store.NotebookStateQueued {
-			return nil, status.Errorf(codes.FailedPrecondition, "notebook is not queued: %s", nb.State)
-		}
-		if nb.QueuedAction != store.NotebookQueuedActionRequeue {
-			return nil, status.Errorf(codes.FailedPrecondition, "notebook is not requeueing: %s", nb.QueuedAction)
+		// The dispatcher also requeues an initializing or running notebook whose deployment has been lost.
+		if nb.State != store.NotebookStateInitializing && nb.State != store.NotebookStateRunning {
+			if nb.State != store.NotebookStateQueued {
+				return nil, status.Errorf(codes.FailedPrecondition, "notebook is not queued: %s", nb.State)
+			}
+			if nb.QueuedAction != store.NotebookQueuedActionRequeue {
+				return nil, status.Errorf(codes.FailedPrecondition, "notebook is not requeueing: %s", nb.QueuedAction)
+			}
 		}
 		if err := nb.MutateMessage(func(nbProto *v1.Notebook) {}); err != nil {
 			return nil, status.Errorf(codes.Internal, "mutate message: %s", err)
 		}
 		nb.State = store.NotebookStateRequeued
+		nb.QueuedAction = store.NotebookQueuedActionRequeue
 		nb.Reason = ""
 		if err := ws.store.UpdateNotebookForRescheduling(nb); err != nil {
 			return nil, status.Errorf(codes.Internal, "update notebook: %s", err)
@@ -560,6 +626,29 @@ func (ws *WS) UpdateNotebookState(ctx context.Context, req *v1.UpdateNotebookSta
 	return &v1.UpdateNotebookStateResponse{}, nil
 }
 
+// updateNotebookError records the error of the notebook pod reported by the dispatcher.
+func (ws *WS) updateNotebookError(nb *store.Notebook, nbErr *v1.Notebook_Error) error {
+	if nbErr == nil {
+		return nil
+	}
+	nbProto, err := nb.V1Notebook()
+	if err != nil {
+		return status.Errorf(codes.Internal, "convert notebook: %s", err)
+	}
+	if proto.Equal(nbProto.Error, nbErr) {
+		return nil
+	}
+	if err := nb.MutateMessage(func(nb *v1.Notebook) {
+		nb.Error = nbErr
+	}); err != nil {
+		return status.Errorf(codes.Internal, "mutate message: %s", err)
+	}
+	if err := ws.store.UpdateNotebookMessage(nb.NotebookID, nb.Version, nb.Message); err != nil {
+		return status.Errorf(codes.Internal, "update notebook message: %s", err)
+	}
+	return nil
+}
+
 func convertNotebookState(s v1.NotebookState) store.NotebookState {
 	return store.NotebookState(strings.ToLower(s.String()))
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/job-manager/api/v1"
 	"github.com/llmariner/job-manager/server/internal/cache"
+	"github.com/llmariner/job-manager/server/internal/config"
 	"github.com/llmariner/job-manager/server/internal/store"
 	rbacv1 "github.com/llmariner/rbac-manager/api/v1"
 	"github.com/stretchr/testify/assert"
@@ -61,6 +62,19 @@ func TestCreateNotebook(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "unassigned required cluster",
+			req: &v1.CreateNotebookRequest{
+				Name: "nb0",
+				Image: &v1.CreateNotebookRequest_Image{
+					Image: &v1.CreateNotebookRequest_Image_Type{Type: "t0"},
+				},
+				Placement: &v1.Placement{
+					RequiredClusters: []string{"other"},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -68,7 +82,7 @@ func TestCreateNotebook(t *testing.T) {
 			st, tearDown := store.NewTest(t)
 			defer tearDown()
 
-			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, map[string]string{"t0": "img0"}, nil, testr.New(t), nil)
+			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, map[string]string{"t0": "img0"}, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 			resp, err := srv.CreateNotebook(fakeAuthInto(context.Background()), tc.req)
 			if tc.wantErr {
 				assert.Error(t, err)
@@ -98,8 +112,8 @@ func TestCreateNotebook_SameName(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, map[string]string{"t0": "img0"}, nil, testr.New(t), nil)
-	wsrv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, map[string]string{"t0": "img0"}, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	wsrv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 
 	req := &v1.CreateNotebookRequest{
 		Name: "nb0",
@@ -154,7 +168,7 @@ func TestListNotebooks(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+	srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 	ctx := fakeAuthInto(context.Background())
 	resp, err := srv.ListNotebooks(ctx, &v1.ListNotebooksRequest{Limit: 5})
 	assert.NoError(t, err)
@@ -199,7 +213,7 @@ func TestGetNotebook(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+	srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 	resp, err := srv.GetNotebook(fakeAuthInto(context.Background()), &v1.GetNotebookRequest{Id: nbID})
 	assert.NoError(t, err)
 	assert.EqualValues(t, store.NotebookQueuedActionStart, store.NotebookState(resp.Status))
@@ -260,7 +274,7 @@ func TestStopNotebook(t *testing.T) {
 			})
 			assert.NoError(t, err)
 
-			srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+			srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 			resp, err := srv.StopNotebook(fakeAuthInto(context.Background()), &v1.StopNotebookRequest{Id: nbID})
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want.Status, resp.Status)
@@ -323,7 +337,7 @@ func TestStartNotebook(t *testing.T) {
 			})
 			assert.NoError(t, err)
 
-			srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+			srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 			resp, err := srv.StartNotebook(fakeAuthInto(context.Background()), &v1.StartNotebookRequest{Id: nbID})
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want.Status, resp.Status)
@@ -346,7 +360,7 @@ func TestDeleteNotebook(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	srv := New(st, nil, nil, nil, nil, nil, nil, nil, testr.New(t), nil)
+	srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 	_, err = srv.DeleteNotebook(fakeAuthInto(context.Background()), &v1.DeleteNotebookRequest{Id: nbID})
 	assert.NoError(t, err)
 }
@@ -392,7 +406,7 @@ func TestListQueuedInternalNotebooks(t *testing.T) {
 		}))
 	}
 
-	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 	req := &v1.ListQueuedInternalNotebooksRequest{}
 	got, err := srv.ListQueuedInternalNotebooks(fakeAuthInto(context.Background()), req)
 	assert.NoError(t, err)
@@ -503,6 +517,20 @@ func TestUpdateNotebookState(t *testing.T) {
 			state:      v1.NotebookState_REQUEUED,
 			wantState:  store.NotebookStateRequeued,
 		},
+		{
+			name:       "set requeued state, previous state is running",
+			prevState:  store.NotebookStateRunning,
+			prevAction: store.NotebookQueuedActionStart,
+			state:      v1.NotebookState_REQUEUED,
+			wantState:  store.NotebookStateRequeued,
+		},
+		{
+			name:       "set requeued state, previous action is starting",
+			prevState:  store.NotebookStateQueued,
+			prevAction: store.NotebookQueuedActionStart,
+			state:      v1.NotebookState_REQUEUED,
+			wantError:  true,
+		},
 		{
 			name:       "set requeued state, previous action is requeueing",
 			prevState:  store.NotebookStateRequeued,
@@ -532,7 +560,7 @@ func TestUpdateNotebookState(t *testing.T) {
 			})
 			assert.NoError(t, err)
 
-			srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), testr.New(t))
+			srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
 			_, err = srv.UpdateNotebookState(fakeAuthInto(context.Background()), &v1.UpdateNotebookStateRequest{
 				Id:    notebookID,
 				State: test.state,
@@ -549,3 +577,46 @@ func TestUpdateNotebookState(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateNotebookState_Error(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	const notebookID = "notebook0"
+	err := st.CreateNotebook(&store.Notebook{
+		NotebookID: notebookID,
+		TenantID:   defaultTenantID,
+		State:      store.NotebookStateRunning,
+	})
+	assert.NoError(t, err)
+
+	srv := NewWorkerServiceServer(st, cache.NewStore(st, testr.New(t)), config.KueueConfig{}, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+	nbErr := &v1.Notebook_Error{
+		Code:    "oom_killed",
+		Message: "container jupyter-lab was OOM killed",
+	}
+	// Only the error is reported.
+	_, err = srv.UpdateNotebookState(ctx, &v1.UpdateNotebookStateRequest{
+		Id:    notebookID,
+		Error: nbErr,
+	})
+	assert.NoError(t, err)
+	nb, err := st.GetNotebookByID(notebookID)
+	assert.NoError(t, err)
+	assert.Equal(t, store.NotebookStateRunning, nb.State)
+	nbProto, err := nb.V1Notebook()
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(nbErr, nbProto.Error))
+
+	// The same error is not recorded again.
+	_, err = srv.UpdateNotebookState(ctx, &v1.UpdateNotebookStateRequest{
+		Id:    notebookID,
+		State: v1.NotebookState_RUNNING,
+		Error: nbErr,
+	})
+	assert.NoError(t, err)
+	got, err := st.GetNotebookByID(notebookID)
+	assert.NoError(t, err)
+	assert.Equal(t, nb.Version, got.Version)
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/llmariner/job-manager/server/internal/scheduler"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// regionLabelKey is the key of the cluster label that specifies the region of a cluster.
+const regionLabelKey = "region"
+
+// placementConstraints is the placement constraints of a notebook, a batch job, or a fine-tuning job.
+type placementConstraints interface {
+	GetRequiredClusters() []string
+	GetPreferredClusters() []string
+	GetClusterSelector() map[string]string
+	GetRegion() string
+	GetPreferredClusterSelector() map[string]string
+	GetNamespace() string
+}
+
+// validatePlacement validates placement constraints. The clusters specified by their IDs or names and the namespace
+// must be assigned to the project so that a workload is not queued for a cluster where it never runs.
+func (s *S) validatePlacement(userInfo *auth.UserInfo, p placementConstraints) error {
+	for k := range p.GetClusterSelector() {
+		if k == "" {
+			return status.Error(codes.InvalidArgument, "cluster selector key must not be empty")
+		}
+	}
+	for k := range p.GetPreferredClusterSelector() {
+		if k == "" {
+			return status.Error(codes.InvalidArgument, "preferred cluster selector key must not be empty")
+		}
+	}
+	if r := p.GetRegion(); r != "" {
+		if v, ok := p.GetClusterSelector()[regionLabelKey]; ok && v != r {
+			return status.Errorf(codes.InvalidArgument, "region %q conflicts with the cluster selector %q", r, v)
+		}
+	}
+
+	if ns := p.GetNamespace(); ns != "" {
+		if !slices.ContainsFunc(userInfo.AssignedKubernetesEnvs, func(env auth.AssignedKubernetesEnv) bool {
+			return env.Namespace == ns
+		}) {
+			return status.Errorf(codes.InvalidArgument, "namespace %q is not assigned to the project", ns)
+		}
+	}
+
+	required, preferred := p.GetRequiredClusters(), p.GetPreferredClusters()
+	if len(required) == 0 && len(preferred) == 0 {
+		return nil
+	}
+
+	clusters, err := s.store.ListClustersByTenantID(userInfo.TenantID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "list clusters: %s", err)
+	}
+	assigned := map[string]bool{}
+	for _, env := range userInfo.AssignedKubernetesEnvs {
+		assigned[env.ClusterID] = true
+	}
+
+	for _, v := range slices.Concat(required, preferred) {
+		if v == "" {
+			return status.Error(codes.InvalidArgument, "placement cluster must not be empty")
+		}
+		var found bool
+		for _, c := range clusters {
+			if assigned[c.ClusterID] && (v == c.ClusterID || v == c.Name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return status.Errorf(codes.InvalidArgument, "cluster %q is not assigned to the project", v)
+		}
+	}
+	return nil
+}
+
+// applyPlacement sets the placement constraints to the workload.
+func applyPlacement(w *scheduler.Workload, p placementConstraints) {
+	w.RequiredClusters = p.GetRequiredClusters()
+	w.PreferredClusters = p.GetPreferredClusters()
+	w.PreferredClusterSelector = p.GetPreferredClusterSelector()
+	w.ClusterSelector = p.GetClusterSelector()
+	w.Namespace = p.GetNamespace()
+	if r := p.GetRegion(); r != "" {
+		// Copy the selector not to modify the request.
+		w.ClusterSelector = maps.Clone(w.ClusterSelector)
+		if w.ClusterSelector == nil {
+			w.ClusterSelector = map[string]string{}
+		}
+		w.ClusterSelector[regionLabelKey] = r
+	}
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/config"
+	"github.com/llmariner/job-manager/server/internal/scheduler"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidatePlacement(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	for _, c := range []*store.Cluster{
+		{ClusterID: "c0", Name: "cluster0", TenantID: defaultTenantID},
+		{ClusterID: "c1", Name: "cluster1", TenantID: defaultTenantID},
+		{ClusterID: "c2", Name: "cluster2", TenantID: "other"},
+	} {
+		_, err := st.CreateOrUpdateCluster(c)
+		assert.NoError(t, err)
+	}
+
+	userInfo := &auth.UserInfo{
+		TenantID: defaultTenantID,
+		AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+			{ClusterID: "c0", Namespace: "ns0"},
+			{ClusterID: "c2", Namespace: "ns2"},
+		},
+	}
+
+	tcs := []struct {
+		name      string
+		placement *v1.Placement
+		wantCode  codes.Code
+	}{
+		{
+			name:     "no constraints",
+			wantCode: codes.OK,
+		},
+		{
+			name: "cluster ID and name",
+			placement: &v1.Placement{
+				RequiredClusters:  []string{"c0"},
+				PreferredClusters: []string{"cluster0"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "unassigned cluster",
+			placement: &v1.Placement{
+				RequiredClusters: []string{"cluster1"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "cluster of another tenant",
+			placement: &v1.Placement{
+				PreferredClusters: []string{"c2"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "empty cluster",
+			placement: &v1.Placement{
+				RequiredClusters: []string{""},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "cluster selector and region",
+			placement: &v1.Placement{
+				ClusterSelector: map[string]string{"network-fabric": "infiniband", "region": "us-west-2"},
+				Region:          "us-west-2",
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "conflicting region",
+			placement: &v1.Placement{
+				ClusterSelector: map[string]string{"region": "us-east-1"},
+				Region:          "us-west-2",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "empty selector key",
+			placement: &v1.Placement{
+				PreferredClusterSelector: map[string]string{"": "v"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "assigned namespace",
+			placement: &v1.Placement{
+				Namespace: "ns0",
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "unassigned namespace",
+			placement: &v1.Placement{
+				Namespace: "ns1",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := New(st, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+			err := srv.validatePlacement(userInfo, tc.placement)
+			assert.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestApplyPlacement(t *testing.T) {
+	p := &v1.Placement{
+		RequiredClusters: []string{"c0"},
+		ClusterSelector:  map[string]string{"network-fabric": "infiniband"},
+		Region:           "us-west-2",
+		Namespace:        "ns0",
+	}
+	var w scheduler.Workload
+	applyPlacement(&w, p)
+	assert.Equal(t, []string{"c0"}, w.RequiredClusters)
+	assert.Equal(t, map[string]string{"network-fabric": "infiniband", "region": "us-west-2"}, w.ClusterSelector)
+	assert.Equal(t, "ns0", w.Namespace)
+	// The request is not modified.
+	assert.Equal(t, map[string]string{"network-fabric": "infiniband"}, p.ClusterSelector)
+
+	w = scheduler.Workload{}
+	applyPlacement(&w, (*v1.Placement)(nil))
+	assert.Empty(t, w.RequiredClusters)
+	assert.Empty(t, w.ClusterSelector)
+}
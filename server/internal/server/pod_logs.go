@@ -0,0 +1,240 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultLogContainer = "main"
+
+	// jobCompletionIndexLabelKey is the label of the pods of indexed k8s jobs. The index is the PyTorch rank
+	// of the pod.
+	jobCompletionIndexLabelKey = "batch.kubernetes.io/job-completion-index"
+
+	podLogsChunkSize = 32 * 1024
+)
+
+// StreamJobLogs streams the logs of a fine-tuning job pod.
+func (s *S) StreamJobLogs(req *v1.StreamJobLogsRequest, stream v1.FineTuningService_StreamJobLogsServer) error {
+	ctx := stream.Context()
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+	opts := podLogsOptions{
+		follow:    req.Follow,
+		tailLines: req.TailLines,
+		container: req.Container,
+		rank:      req.Rank,
+	}
+	if err := opts.validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	job, err := s.store.GetJobByJobIDAndProjectID(req.Id, userInfo.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "get job: %s", err)
+		}
+		return status.Errorf(codes.Internal, "get job: %s", err)
+	}
+	jobProto, err := job.V1Job()
+	if err != nil {
+		return status.Errorf(codes.Internal, "convert job to proto: %s", err)
+	}
+
+	return s.streamPodLogs(ctx, job.ClusterID, jobProto.KubernetesNamespace, job.JobID, opts, func(data string) error {
+		return stream.Send(&v1.StreamJobLogsResponse{Data: data})
+	})
+}
+
+// StreamBatchJobLogs streams the logs of a batch job pod.
+func (s *S) StreamBatchJobLogs(req *v1.StreamBatchJobLogsRequest, stream v1.BatchService_StreamBatchJobLogsServer) error {
+	ctx := stream.Context()
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+	opts := podLogsOptions{
+		follow:    req.Follow,
+		tailLines: req.TailLines,
+		container: req.Container,
+		rank:      req.Rank,
+	}
+	if err := opts.validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	job, err := s.store.GetActiveBatchJobByIDAndProjectID(req.Id, userInfo.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "get batch job: %s", err)
+		}
+		return status.Errorf(codes.Internal, "get batch job: %s", err)
+	}
+	jobProto, err := job.V1BatchJob()
+	if err != nil {
+		return status.Errorf(codes.Internal, "convert batch job to proto: %s", err)
+	}
+
+	return s.streamPodLogs(ctx, job.ClusterID, jobProto.KubernetesNamespace, job.JobID, opts, func(data string) error {
+		return stream.Send(&v1.StreamBatchJobLogsResponse{Data: data})
+	})
+}
+
+type podLogsOptions struct {
+	follow    bool
+	tailLines int64
+	container string
+	rank      int32
+}
+
+func (o *podLogsOptions) validate() error {
+	if o.tailLines < 0 {
+		return fmt.Errorf("tail_lines must not be negative")
+	}
+	if o.rank < 0 {
+		return fmt.Errorf("rank must not be negative")
+	}
+	return nil
+}
+
+// streamPodLogs streams the logs of the pod of the k8s job through the session manager to the worker cluster
+// where the job is scheduled.
+func (s *S) streamPodLogs(
+	ctx context.Context,
+	clusterID string,
+	namespace string,
+	jobName string,
+	opts podLogsOptions,
+	send func(data string) error,
+) error {
+	if clusterID == "" || namespace == "" {
+		return status.Error(codes.FailedPrecondition, "job has not been scheduled")
+	}
+
+	apikey, err := auth.ExtractTokenFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	kclient, err := s.k8sClientFactory.NewClient(clusterID, apikey)
+	if err != nil {
+		return status.Errorf(codes.Internal, "create k8s client: %s", err)
+	}
+	pods, err := kclient.ListPods(ctx, namespace, "job-name="+jobName)
+	if err != nil {
+		return status.Errorf(codes.Internal, "list pods: %s", err)
+	}
+	pod := findPodOfRank(pods, opts.rank)
+	if pod == nil {
+		return status.Errorf(codes.NotFound, "no pod of rank %d found", opts.rank)
+	}
+
+	container := opts.container
+	if container == "" {
+		container = defaultLogContainer
+	}
+	logOpts := &corev1.PodLogOptions{
+		Container: container,
+		Follow:    opts.follow,
+	}
+	if opts.tailLines > 0 {
+		logOpts.TailLines = ptr.To(opts.tailLines)
+	}
+	r, err := kclient.StreamPodLogs(ctx, pod.Name, namespace, logOpts)
+	if err != nil {
+		switch {
+		case apierrors.IsNotFound(err):
+			return status.Errorf(codes.NotFound, "stream pod logs: %s", err)
+		case apierrors.IsBadRequest(err):
+			// The container does not exist or has not started yet.
+			return status.Errorf(codes.FailedPrecondition, "stream pod logs: %s", err)
+		}
+		return status.Errorf(codes.Internal, "stream pod logs: %s", err)
+	}
+	defer func() { _ = r.Close() }()
+
+	buf := make([]byte, podLogsChunkSize)
+	var pending int
+	for {
+		n, err := r.Read(buf[pending:])
+		n += pending
+		// Hold back an incomplete UTF-8 sequence at the end of the chunk so that a character split
+		// across reads is not corrupted.
+		m := n
+		if err == nil {
+			m = completeUTF8Prefix(buf[:n])
+		}
+		if m > 0 {
+			// Proto strings must be valid UTF-8.
+			if err := send(strings.ToValidUTF8(string(buf[:m]), "\uFFFD")); err != nil {
+				return err
+			}
+		}
+		pending = copy(buf, buf[m:n])
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return status.Errorf(codes.Internal, "read pod logs: %s", err)
+		}
+	}
+}
+
+// findPodOfRank returns the latest pod of the given rank. The pods of non-indexed k8s jobs have rank 0.
+func findPodOfRank(pods []corev1.Pod, rank int32) *corev1.Pod {
+	var found *corev1.Pod
+	for i, pod := range pods {
+		r := 0
+		if v, ok := pod.Labels[jobCompletionIndexLabelKey]; ok {
+			var err error
+			if r, err = strconv.Atoi(v); err != nil {
+				continue
+			}
+		}
+		if r != int(rank) {
+			continue
+		}
+		if found == nil || found.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			found = &pods[i]
+		}
+	}
+	return found
+}
+
+// completeUTF8Prefix returns the length of b without an incomplete UTF-8 sequence at its end.
+func completeUTF8Prefix(b []byte) int {
+	for i := 1; i <= utf8.UTFMax && i <= len(b); i++ {
+		if !utf8.RuneStart(b[len(b)-i]) {
+			continue
+		}
+		if utf8.FullRune(b[len(b)-i:]) {
+			return len(b)
+		}
+		return len(b) - i
+	}
+	return len(b)
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/config"
+	"github.com/llmariner/job-manager/server/internal/k8s"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestStreamBatchJobLogs(t *testing.T) {
+	now := time.Now()
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "job0-0-abcde",
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+				Labels:            map[string]string{jobCompletionIndexLabelKey: "0"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "job0-1-fghij",
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+				Labels:            map[string]string{jobCompletionIndexLabelKey: "1"},
+			},
+		},
+		{
+			// A pod recreated after the failure of the previous pod of rank 1.
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "job0-1-klmno",
+				CreationTimestamp: metav1.NewTime(now),
+				Labels:            map[string]string{jobCompletionIndexLabelKey: "1"},
+			},
+		},
+	}
+
+	tcs := []struct {
+		name     string
+		req      *v1.StreamBatchJobLogsRequest
+		wantPod  string
+		wantOpts *corev1.PodLogOptions
+		wantCode codes.Code
+	}{
+		{
+			name:     "default",
+			req:      &v1.StreamBatchJobLogsRequest{Id: "job0"},
+			wantPod:  "job0-0-abcde",
+			wantOpts: &corev1.PodLogOptions{Container: "main"},
+		},
+		{
+			name: "rank, container, follow, and tail lines",
+			req: &v1.StreamBatchJobLogsRequest{
+				Id:        "job0",
+				Rank:      1,
+				Container: "init",
+				Follow:    true,
+				TailLines: 10,
+			},
+			wantPod: "job0-1-klmno",
+			wantOpts: &corev1.PodLogOptions{
+				Container: "init",
+				Follow:    true,
+				TailLines: ptr.To(int64(10)),
+			},
+		},
+		{
+			name:     "no pod of rank",
+			req:      &v1.StreamBatchJobLogsRequest{Id: "job0", Rank: 2},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "negative tail lines",
+			req:      &v1.StreamBatchJobLogsRequest{Id: "job0", TailLines: -1},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "job not found",
+			req:      &v1.StreamBatchJobLogsRequest{Id: "job1"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "not scheduled",
+			req:      &v1.StreamBatchJobLogsRequest{Id: "job2"},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			msg, err := proto.Marshal(&v1.BatchJob{Id: "job0", KubernetesNamespace: "ns0"})
+			assert.NoError(t, err)
+			err = st.CreateBatchJob(&store.BatchJob{
+				JobID:     "job0",
+				TenantID:  defaultTenantID,
+				ProjectID: defaultProjectID,
+				ClusterID: "cluster0",
+				State:     store.BatchJobStateRunning,
+				Message:   msg,
+			})
+			assert.NoError(t, err)
+			msg, err = proto.Marshal(&v1.BatchJob{Id: "job2"})
+			assert.NoError(t, err)
+			err = st.CreateBatchJob(&store.BatchJob{
+				JobID:     "job2",
+				TenantID:  defaultTenantID,
+				ProjectID: defaultProjectID,
+				State:     store.BatchJobStateQueued,
+				Message:   msg,
+			})
+			assert.NoError(t, err)
+
+			kc := &fakePodLogsK8sClient{pods: pods, logs: "line0\nline1\n"}
+			srv := New(st, nil, nil, &fakePodLogsK8sClientFactory{client: kc}, nil, nil, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+			stream := &fakeBatchJobLogsStream{}
+			err = srv.StreamBatchJobLogs(tc.req, stream)
+			if tc.wantCode != codes.OK {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantCode, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "ns0", kc.namespace)
+			assert.Equal(t, "job-name=job0", kc.labelSelector)
+			assert.Equal(t, tc.wantPod, kc.podName)
+			assert.Equal(t, tc.wantOpts, kc.opts)
+			assert.Equal(t, "line0\nline1\n", stream.data.String())
+		})
+	}
+}
+
+func TestCompleteUTF8Prefix(t *testing.T) {
+	b := []byte("ab日本")
+	assert.Equal(t, len(b), completeUTF8Prefix(b))
+	// Cut the last character in the middle.
+	assert.Equal(t, len(b)-3, completeUTF8Prefix(b[:len(b)-1]))
+	assert.Equal(t, 0, completeUTF8Prefix(nil))
+}
+
+type fakePodLogsK8sClientFactory struct {
+	noopK8sClientFactory
+	client *fakePodLogsK8sClient
+}
+
+func (f *fakePodLogsK8sClientFactory) NewClient(clusterID string, token string) (k8s.Client, error) {
+	return f.client, nil
+}
+
+type fakePodLogsK8sClient struct {
+	noopK8sClient
+
+	pods []corev1.Pod
+	logs string
+
+	namespace     string
+	labelSelector string
+	podName       string
+	opts          *corev1.PodLogOptions
+}
+
+func (c *fakePodLogsK8sClient) ListPods(ctx context.Context, namespace, labelSelector string) ([]corev1.Pod, error) {
+	c.namespace = namespace
+	c.labelSelector = labelSelector
+	return c.pods, nil
+}
+
+func (c *fakePodLogsK8sClient) StreamPodLogs(ctx context.Context, name, namespace string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	c.podName = name
+	c.opts = opts
+	return io.NopCloser(strings.NewReader(c.logs)), nil
+}
+
+type fakeBatchJobLogsStream struct {
+	grpc.ServerStream
+	data strings.Builder
+}
+
+func (s *fakeBatchJobLogsStream) Context() context.Context {
+	return fakeAuthInto(context.Background())
+}
+
+func (s *fakeBatchJobLogsStream) Send(resp *v1.StreamBatchJobLogsResponse) error {
+	s.data.WriteString(resp.Data)
+	return nil
+}
@@ -0,0 +1,242 @@
+package server
+
+import (
+	"fmt"
+
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	"github.com/llmariner/job-manager/server/internal/scheduler"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// preemptedErrorCode is the error code set to workloads that have been preempted by higher-priority workloads.
+const preemptedErrorCode = "preempted"
+
+// validatePriority validates the priority and the preemptible flag of a workload, and returns
+// whether the workload is preemptible.
+func (s *S) validatePriority(projectID string, priority int32, preemptible *bool) (bool, error) {
+	if priority < 0 {
+		return false, status.Error(codes.InvalidArgument, "priority must be non-negative")
+	}
+	if preemptible == nil || *preemptible {
+		return true, nil
+	}
+	if !s.nonPreemptibleProjects[projectID] {
+		return false, status.Errorf(codes.PermissionDenied, "project %q is not allowed to create non-preemptible workloads", projectID)
+	}
+	return false, nil
+}
+
+// scheduleWithPreemption schedules a workload. If the workload cannot be scheduled
+// and preemption is enabled, it finds lower-priority preemptible workloads in a cluster whose preemption lets
+// the workload be placed in the cluster. tx is the store of the transaction that reserves the resources of the workload.
+//
+// The victims are not preempted here. The returned preemption is applied when the workload is persisted
+// (see persistWithPreemption) so that no workload is preempted for a workload that fails to be created.
+// The returned preemption is nil if the workload is scheduled without preemption.
+func (s *S) scheduleWithPreemption(
+	tx *store.S,
+	userInfo *auth.UserInfo,
+	prevClusterID string,
+	w scheduler.Workload,
+	priority int32,
+	preemptorID string,
+) (scheduler.SchedulingResult, *preemption, error) {
+	sresult, err := s.scheduler.Schedule(userInfo, prevClusterID, w)
+	if err == nil {
+		return sresult, nil, nil
+	}
+	if !s.enablePreemption || w.PodCount*w.GPUCountPerPod == 0 || priority <= 0 {
+		return scheduler.SchedulingResult{}, nil, err
+	}
+
+	targets, lerr := listPreemptionTargets(tx, userInfo.TenantID, priority, s.preemptNotebooks)
+	if lerr != nil {
+		return scheduler.SchedulingResult{}, nil, fmt.Errorf("list preemption targets: %s", lerr)
+	}
+	presult, perr := s.scheduler.FindPreemptionVictims(userInfo, prevClusterID, w, priority, targets.candidates)
+	if perr != nil {
+		s.logger.V(1).Info("No preemption victims found", "preemptorID", preemptorID, "reason", perr)
+		// Return the original scheduling error as it better explains why the workload cannot be scheduled.
+		return scheduler.SchedulingResult{}, nil, err
+	}
+
+	p := &preemption{preemptorID: preemptorID}
+	for _, v := range presult.Victims {
+		if job, ok := targets.batchJobs[v.ID]; ok {
+			p.batchJobs = append(p.batchJobs, job)
+			continue
+		}
+		if nb, ok := targets.notebooks[v.ID]; ok {
+			p.notebooks = append(p.notebooks, nb)
+			continue
+		}
+		return scheduler.SchedulingResult{}, nil, fmt.Errorf("unknown preemption victim: %s", v.ID)
+	}
+	return presult.SchedulingResult, p, nil
+}
+
+// persistWithPreemption persists a workload with the given function. If the workload preempts other workloads,
+// the victims are preempted in the same transaction so that they are preempted if and only if the workload is persisted.
+func (s *S) persistWithPreemption(p *preemption, persist func(tx *store.S) error) error {
+	if p == nil {
+		return persist(s.store)
+	}
+	if err := s.store.Transaction(func(tx *store.S) error {
+		if err := persist(tx); err != nil {
+			return err
+		}
+		return p.apply(tx)
+	}); err != nil {
+		return err
+	}
+	s.logger.Info("Preempted workloads", "preemptorID", p.preemptorID, "victimIDs", p.victimIDs())
+	return nil
+}
+
+// preemption is a set of running workloads to be preempted by a higher-priority workload.
+type preemption struct {
+	preemptorID string
+
+	batchJobs []*store.BatchJob
+	notebooks []*store.Notebook
+}
+
+// apply queues actions to requeue or cancel the batch jobs and stop the notebooks, and records the preemption in their
+// errors. Batch jobs are requeued so that they are rescheduled later unless they were created without the information
+// needed to recreate them. A victim that has been updated since it was listed fails the preemption.
+func (p *preemption) apply(tx *store.S) error {
+	msg := fmt.Sprintf("preempted by a higher-priority workload %s", p.preemptorID)
+
+	for _, job := range p.batchJobs {
+		action := store.BatchJobQueuedActionCancel
+		if job.Reschedulable() {
+			action = store.BatchJobQueuedActionRequeue
+		}
+		if err := job.MutateMessage(func(j *v1.BatchJob) {
+			j.Error = &v1.BatchJob_Error{
+				Code:    preemptedErrorCode,
+				Message: msg,
+			}
+		}); err != nil {
+			return err
+		}
+		if err := tx.SetBatchJobQueuedActionAndMessage(job.JobID, job.Version, action, job.Message); err != nil {
+			return fmt.Errorf("preempt %s: %s", job.JobID, err)
+		}
+	}
+
+	for _, nb := range p.notebooks {
+		if err := nb.MutateMessage(func(n *v1.Notebook) {
+			n.Error = &v1.Notebook_Error{
+				Code:    preemptedErrorCode,
+				Message: msg,
+			}
+		}); err != nil {
+			return err
+		}
+		if err := tx.SetNotebookQueuedActionAndMessage(nb.NotebookID, nb.Version, store.NotebookQueuedActionStop, nb.Message); err != nil {
+			return fmt.Errorf("preempt %s: %s", nb.NotebookID, err)
+		}
+	}
+	return nil
+}
+
+func (p *preemption) victimIDs() []string {
+	var ids []string
+	for _, job := range p.batchJobs {
+		ids = append(ids, job.JobID)
+	}
+	for _, nb := range p.notebooks {
+		ids = append(ids, nb.NotebookID)
+	}
+	return ids
+}
+
+// preemptionTargets is a set of running workloads that can be preempted.
+type preemptionTargets struct {
+	candidates []scheduler.PreemptionCandidate
+
+	batchJobs map[string]*store.BatchJob
+	notebooks map[string]*store.Notebook
+}
+
+// listPreemptionTargets lists running preemptible workloads whose priorities are lower than the given priority.
+// Notebooks are listed only when includeNotebooks is true.
+func listPreemptionTargets(st *store.S, tenantID string, priority int32, includeNotebooks bool) (*preemptionTargets, error) {
+	targets := &preemptionTargets{
+		batchJobs: map[string]*store.BatchJob{},
+		notebooks: map[string]*store.Notebook{},
+	}
+
+	jobs, err := st.ListBatchJobsByTenantIDAndState(tenantID, store.BatchJobStateRunning)
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs {
+		job := &jobs[i]
+		jobProto, err := job.V1BatchJob()
+		if err != nil {
+			return nil, err
+		}
+		if !jobProto.Preemptible || jobProto.Priority >= priority {
+			continue
+		}
+		podCount := batchJobPodCount(jobProto)
+		targets.batchJobs[job.JobID] = job
+		targets.candidates = append(targets.candidates, scheduler.PreemptionCandidate{
+			ID:              job.JobID,
+			ClusterID:       job.ClusterID,
+			Namespace:       jobProto.KubernetesNamespace,
+			Priority:        jobProto.Priority,
+			PodKeys:         batchJobPodKeys(jobProto.KubernetesNamespace, job.JobID, podCount),
+			GPUCount:        podCount * int(jobProto.Resources.GetGpuCount()),
+			CPUMilicore:     podCount * int(jobProto.Resources.GetCpuMilicore()),
+			MemoryMegabytes: podCount * int(jobProto.Resources.GetMemoryMegabytes()),
+			AcceleratorType: accelerator.ResourceName(jobProto.Resources.GetAcceleratorType(), jobProto.Resources.GetGpuProfile()),
+		})
+	}
+
+	if !includeNotebooks {
+		return targets, nil
+	}
+
+	nbs, err := st.ListNotebooksByTenantIDAndState(tenantID, store.NotebookStateRunning)
+	if err != nil {
+		return nil, err
+	}
+	for _, nb := range nbs {
+		nbProto, err := nb.V1Notebook()
+		if err != nil {
+			return nil, err
+		}
+		if !nbProto.Preemptible || nbProto.Priority >= priority {
+			continue
+		}
+		w := notebookWorkload(nbProto.Resources, nbProto.Placement)
+		targets.notebooks[nb.NotebookID] = nb
+		targets.candidates = append(targets.candidates, scheduler.PreemptionCandidate{
+			ID:              nb.NotebookID,
+			ClusterID:       nb.ClusterID,
+			Namespace:       nbProto.KubernetesNamespace,
+			Priority:        nbProto.Priority,
+			PodKeys:         []string{fmt.Sprintf("%s/%s", nbProto.KubernetesNamespace, nb.NotebookID)},
+			GPUCount:        w.GPUCountPerPod,
+			CPUMilicore:     w.CPUMilicorePerPod,
+			MemoryMegabytes: w.MemoryMegabytesPerPod,
+			AcceleratorType: w.AcceleratorType,
+		})
+	}
+	return targets, nil
+}
+
+// batchJobPodCount returns the number of pods of a batch job.
+func batchJobPodCount(job *v1.BatchJob) int {
+	if p := job.Kind.GetPytorch(); p != nil && p.WorkerCount > 0 {
+		return int(p.WorkerCount)
+	}
+	return 1
+}
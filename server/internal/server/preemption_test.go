@@ -0,0 +1,275 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/config"
+	"github.com/llmariner/job-manager/server/internal/scheduler"
+	"github.com/llmariner/job-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+func TestScheduleWithPreemption(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createBatchJob := func(id string, priority int32, preemptible, reschedulable bool) {
+		msg, err := proto.Marshal(&v1.BatchJob{
+			Id:          id,
+			Resources:   &v1.BatchJob_Resources{GpuCount: 1},
+			Priority:    priority,
+			Preemptible: preemptible,
+		})
+		assert.NoError(t, err)
+		job := &store.BatchJob{
+			JobID:     id,
+			Message:   msg,
+			State:     store.BatchJobStateRunning,
+			TenantID:  defaultTenantID,
+			ProjectID: defaultProjectID,
+			ClusterID: defaultClusterID,
+		}
+		if reschedulable {
+			job.ProjectMessage = []byte("project")
+			err = job.SetScripts(map[string][]byte{"main.py": []byte("print('hello')")})
+			assert.NoError(t, err)
+		}
+		err = st.CreateBatchJob(job)
+		assert.NoError(t, err)
+	}
+	createNotebook := func(id string, priority int32, preemptible bool) {
+		msg, err := proto.Marshal(&v1.Notebook{
+			Id:          id,
+			Resources:   &v1.Resources{GpuCount: 1},
+			Priority:    priority,
+			Preemptible: preemptible,
+		})
+		assert.NoError(t, err)
+		err = st.CreateNotebook(&store.Notebook{
+			NotebookID: id,
+			Message:    msg,
+			State:      store.NotebookStateRunning,
+			TenantID:   defaultTenantID,
+			ProjectID:  defaultProjectID,
+			ClusterID:  defaultClusterID,
+		})
+		assert.NoError(t, err)
+	}
+
+	createBatchJob("bj0", 0, true, true)
+	createBatchJob("bj1", 10, true, true)
+	createBatchJob("bj2", 0, false, true)
+	createBatchJob("bj4", 0, true, false)
+	createNotebook("nb0", 0, true)
+
+	sched := &fakePreemptingScheduler{}
+	srv := New(st, nil, nil, nil, sched, &fakeCache{}, nil, nil, config.PreemptionConfig{Enable: true, PreemptNotebooks: true}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+
+	userInfo := &auth.UserInfo{
+		TenantID: defaultTenantID,
+		AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+			{
+				ClusterID: defaultClusterID,
+				Namespace: "default",
+			},
+		},
+	}
+	got, p, err := srv.scheduleWithPreemption(st, userInfo, "", scheduler.Workload{PodCount: 1, GPUCountPerPod: 2}, 5, "bj3")
+	assert.NoError(t, err)
+	assert.Equal(t, defaultClusterID, got.ClusterID)
+
+	var ids []string
+	for _, c := range sched.candidates {
+		ids = append(ids, c.ID)
+	}
+	assert.ElementsMatch(t, []string{"bj0", "bj4", "nb0"}, ids)
+	assert.ElementsMatch(t, []string{"bj0", "bj4", "nb0"}, p.victimIDs())
+
+	// The victims are not preempted until the preemptor is persisted.
+	job, err := st.GetBatchJobByID("bj0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.BatchJobStateRunning, job.State)
+
+	// The victims are not preempted when the preemptor fails to be persisted.
+	err = srv.persistWithPreemption(p, func(tx *store.S) error {
+		if err := tx.CreateBatchJob(&store.BatchJob{JobID: "bj3", TenantID: defaultTenantID}); err != nil {
+			return err
+		}
+		return fmt.Errorf("failed")
+	})
+	assert.Error(t, err)
+	_, err = st.GetBatchJobByID("bj3")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	job, err = st.GetBatchJobByID("bj0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.BatchJobStateRunning, job.State)
+
+	err = srv.persistWithPreemption(p, func(tx *store.S) error {
+		return tx.CreateBatchJob(&store.BatchJob{JobID: "bj3", TenantID: defaultTenantID})
+	})
+	assert.NoError(t, err)
+	_, err = st.GetBatchJobByID("bj3")
+	assert.NoError(t, err)
+
+	job, err = st.GetBatchJobByID("bj0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.BatchJobStateQueued, job.State)
+	assert.Equal(t, store.BatchJobQueuedActionRequeue, job.QueuedAction)
+	jobProto, err := job.V1BatchJob()
+	assert.NoError(t, err)
+	assert.Equal(t, preemptedErrorCode, jobProto.Error.Code)
+
+	// A batch job that cannot be rescheduled is canceled.
+	job, err = st.GetBatchJobByID("bj4")
+	assert.NoError(t, err)
+	assert.Equal(t, store.BatchJobStateQueued, job.State)
+	assert.Equal(t, store.BatchJobQueuedActionCancel, job.QueuedAction)
+
+	nb, err := st.GetNotebookByID("nb0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.NotebookStateQueued, nb.State)
+	assert.Equal(t, store.NotebookQueuedActionStop, nb.QueuedAction)
+	nbProto, err := nb.V1Notebook()
+	assert.NoError(t, err)
+	assert.Equal(t, preemptedErrorCode, nbProto.Error.Code)
+
+	for _, id := range []string{"bj1", "bj2"} {
+		job, err := st.GetBatchJobByID(id)
+		assert.NoError(t, err)
+		assert.Equal(t, store.BatchJobStateRunning, job.State)
+	}
+}
+
+func TestScheduleWithPreemption_NotebooksNotPreempted(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	msg, err := proto.Marshal(&v1.Notebook{
+		Id:          "nb0",
+		Resources:   &v1.Resources{GpuCount: 1},
+		Preemptible: true,
+	})
+	assert.NoError(t, err)
+	err = st.CreateNotebook(&store.Notebook{
+		NotebookID: "nb0",
+		Message:    msg,
+		State:      store.NotebookStateRunning,
+		TenantID:   defaultTenantID,
+		ProjectID:  defaultProjectID,
+		ClusterID:  defaultClusterID,
+	})
+	assert.NoError(t, err)
+
+	sched := &fakePreemptingScheduler{}
+	srv := New(st, nil, nil, nil, sched, &fakeCache{}, nil, nil, config.PreemptionConfig{Enable: true}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+
+	userInfo := &auth.UserInfo{
+		TenantID: defaultTenantID,
+		AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+			{
+				ClusterID: defaultClusterID,
+				Namespace: "default",
+			},
+		},
+	}
+	_, p, err := srv.scheduleWithPreemption(st, userInfo, "", scheduler.Workload{PodCount: 1, GPUCountPerPod: 1}, 5, "bj0")
+	assert.NoError(t, err)
+	assert.Empty(t, sched.candidates)
+	assert.Empty(t, p.victimIDs())
+
+	nb, err := st.GetNotebookByID("nb0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.NotebookStateRunning, nb.State)
+}
+
+func TestScheduleWithPreemption_Disabled(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, nil, nil, nil, &fakePreemptingScheduler{}, &fakeCache{}, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	_, _, err := srv.scheduleWithPreemption(st, &auth.UserInfo{TenantID: defaultTenantID}, "", scheduler.Workload{PodCount: 1, GPUCountPerPod: 1}, 5, "bj0")
+	assert.Error(t, err)
+}
+
+func TestValidatePriority(t *testing.T) {
+	srv := New(nil, nil, nil, nil, nil, nil, nil, nil, config.PreemptionConfig{
+		NonPreemptibleProjectIDs: []string{"p0"},
+	}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+
+	tcs := []struct {
+		name        string
+		projectID   string
+		priority    int32
+		preemptible *bool
+		want        bool
+		wantErr     bool
+	}{
+		{
+			name:      "default",
+			projectID: "p1",
+			want:      true,
+		},
+		{
+			name:        "non-preemptible allowed",
+			projectID:   "p0",
+			preemptible: proto.Bool(false),
+			want:        false,
+		},
+		{
+			name:        "non-preemptible not allowed",
+			projectID:   "p1",
+			preemptible: proto.Bool(false),
+			wantErr:     true,
+		},
+		{
+			name:      "negative priority",
+			projectID: "p0",
+			priority:  -1,
+			wantErr:   true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := srv.validatePriority(tc.projectID, tc.priority, tc.preemptible)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// fakePreemptingScheduler fails to schedule any workload and picks up all candidates as preemption victims.
+type fakePreemptingScheduler struct {
+	candidates []scheduler.PreemptionCandidate
+}
+
+func (s *fakePreemptingScheduler) Schedule(userInfo *auth.UserInfo, clusterID string, w scheduler.Workload) (scheduler.SchedulingResult, error) {
+	return scheduler.SchedulingResult{}, fmt.Errorf("no capacity")
+}
+
+func (s *fakePreemptingScheduler) FindPreemptionVictims(
+	userInfo *auth.UserInfo,
+	clusterID string,
+	w scheduler.Workload,
+	priority int32,
+	candidates []scheduler.PreemptionCandidate,
+) (scheduler.PreemptionResult, error) {
+	s.candidates = candidates
+	kenv := userInfo.AssignedKubernetesEnvs[0]
+	return scheduler.PreemptionResult{
+		SchedulingResult: scheduler.SchedulingResult{
+			ClusterID: kenv.ClusterID,
+			Namespace: kenv.Namespace,
+		},
+		Victims: candidates,
+	}, nil
+}
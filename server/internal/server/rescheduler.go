@@ -7,6 +7,7 @@ import (
 	"time"
 
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/scheduler"
 	"github.com/llmariner/job-manager/server/internal/store"
 )
 
@@ -14,7 +15,7 @@ const pullingImageReason = "Pulling"
 
 // RunRescheduler requeues and reschedules the jobs.
 func (s *S) RunRescheduler(ctx context.Context, interval, maxQueuedTime time.Duration) error {
-	if err := s.rescheduleNotebooks(ctx, maxQueuedTime); err != nil {
+	if err := s.reschedule(ctx, maxQueuedTime); err != nil {
 		return err
 	}
 
@@ -24,13 +25,23 @@ func (s *S) RunRescheduler(ctx context.Context, interval, maxQueuedTime time.Dur
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-ticker.C:
-			if err := s.rescheduleNotebooks(ctx, maxQueuedTime); err != nil {
+			if err := s.reschedule(ctx, maxQueuedTime); err != nil {
 				return ctx.Err()
 			}
 		}
 	}
 }
 
+func (s *S) reschedule(ctx context.Context, maxQueuedTime time.Duration) error {
+	if err := s.rescheduleNotebooks(ctx, maxQueuedTime); err != nil {
+		return err
+	}
+	if err := s.rescheduleBatchJobs(ctx); err != nil {
+		return err
+	}
+	return s.rescheduleFineTuningJobs()
+}
+
 func (s *S) rescheduleNotebooks(ctx context.Context, maxQueuedTime time.Duration) error {
 	s.logger.V(1).Info("Rescheduling notebooks ...")
 	nbs, err := s.store.ListNotebooksByState(store.NotebookStateInitializing)
@@ -63,11 +74,7 @@ func (s *S) rescheduleNotebooks(ctx context.Context, maxQueuedTime time.Duration
 		if err != nil {
 			return fmt.Errorf("reschedule a notebook %s: %w", nb.NotebookID, err)
 		}
-		gpuCount := 0
-		if nbProto.Resources != nil {
-			gpuCount = int(nbProto.Resources.GpuCount)
-		}
-		sresult, err := s.scheduleNotebook(ctx, nb, gpuCount)
+		sresult, p, err := s.scheduleNotebook(ctx, nb, notebookWorkload(nbProto.Resources, nbProto.Placement), nbProto.Priority)
 		if err != nil {
 			// skip this notebook if it cannot be scheduled
 			s.logger.Error(err, fmt.Sprintf("reschedule a notebook %s", nb.NotebookID))
@@ -85,10 +92,128 @@ func (s *S) rescheduleNotebooks(ctx context.Context, maxQueuedTime time.Duration
 		}); err != nil {
 			return err
 		}
-		if err := s.store.UpdateNotebookForRescheduling(nb); err != nil {
+		if err := s.persistWithPreemption(p, func(tx *store.S) error {
+			return tx.UpdateNotebookForRescheduling(nb)
+		}); err != nil {
 			return fmt.Errorf("reschedule a notebook %s: %w", nb.NotebookID, err)
 		}
 		s.logger.V(1).Info("notebook is rescheduled", "nb", nb)
 	}
 	return nil
 }
+
+// rescheduleBatchJobs schedules the requeued batch jobs. Unlike notebooks, a batch job can be rescheduled to the
+// cluster where it previously ran as it is requeued only when the cluster is drained (and hence cordoned) or the
+// job is preempted.
+func (s *S) rescheduleBatchJobs(ctx context.Context) error {
+	s.logger.V(1).Info("Rescheduling batch jobs ...")
+	jobs, err := s.store.ListBatchJobsByState(store.BatchJobStateRequeued)
+	if err != nil {
+		return fmt.Errorf("reschedule batch jobs: %w", err)
+	}
+	for i := range jobs {
+		job := &jobs[i]
+		jobProto, err := job.V1BatchJob()
+		if err != nil {
+			return fmt.Errorf("reschedule a batch job %s: %w", job.JobID, err)
+		}
+		userInfo, err := job.RebuildUserInfo()
+		if err != nil {
+			return fmt.Errorf("reschedule a batch job %s: %w", job.JobID, err)
+		}
+		w := batchJobWorkload(jobProto.Resources, jobProto.Kind, jobProto.Placement)
+		var p *preemption
+		sresult, err := scheduleAndReserve(s.cache, job.TenantID, w, func(tx *store.S) (scheduler.SchedulingResult, error) {
+			var sresult scheduler.SchedulingResult
+			var err error
+			sresult, p, err = s.scheduleWithPreemption(tx, userInfo, "", w, jobProto.Priority, job.JobID)
+			return sresult, err
+		}, func(ns string) []string {
+			return batchJobPodKeys(ns, job.JobID, w.PodCount)
+		})
+		if err != nil {
+			// skip this batch job if it cannot be scheduled
+			s.logger.Error(err, fmt.Sprintf("reschedule a batch job %s", job.JobID))
+			continue
+		}
+
+		apikey, err := job.GetAPIKey(ctx, s.dataKey)
+		if err != nil {
+			return fmt.Errorf("reschedule a batch job %s: get api key: %w", job.JobID, err)
+		}
+		scripts, err := job.GetScripts()
+		if err != nil {
+			return fmt.Errorf("reschedule a batch job %s: get scripts: %w", job.JobID, err)
+		}
+		if err := s.createBatchJobResources(ctx, job.JobID, sresult.ClusterID, sresult.Namespace, apikey, scripts); err != nil {
+			return fmt.Errorf("reschedule a batch job %s: %w", job.JobID, err)
+		}
+
+		job.State = store.BatchJobStateQueued
+		job.QueuedAction = store.BatchJobQueuedActionCreate
+		job.ClusterID = sresult.ClusterID
+		if err := job.MutateMessage(func(j *v1.BatchJob) {
+			j.ClusterId = sresult.ClusterID
+			j.ClusterName = sresult.ClusterName
+			j.KubernetesNamespace = sresult.Namespace
+			// Clear the error recorded when the job was preempted.
+			j.Error = nil
+		}); err != nil {
+			return err
+		}
+		if err := s.persistWithPreemption(p, func(tx *store.S) error {
+			return tx.UpdateBatchJobForRescheduling(job)
+		}); err != nil {
+			return fmt.Errorf("reschedule a batch job %s: %w", job.JobID, err)
+		}
+		s.logger.V(1).Info("batch job is rescheduled", "jobID", job.JobID, "clusterID", job.ClusterID)
+	}
+	return nil
+}
+
+// rescheduleFineTuningJobs schedules the requeued fine-tuning jobs. The jobs are pre-processed again in the
+// rescheduled cluster.
+func (s *S) rescheduleFineTuningJobs() error {
+	s.logger.V(1).Info("Rescheduling fine-tuning jobs ...")
+	jobs, err := s.store.ListJobsByState(store.JobStateRequeued)
+	if err != nil {
+		return fmt.Errorf("reschedule fine-tuning jobs: %w", err)
+	}
+	for _, job := range jobs {
+		jobProto, err := job.V1Job()
+		if err != nil {
+			return fmt.Errorf("reschedule a fine-tuning job %s: %w", job.JobID, err)
+		}
+		userInfo, err := job.RebuildUserInfo()
+		if err != nil {
+			return fmt.Errorf("reschedule a fine-tuning job %s: %w", job.JobID, err)
+		}
+		w := fineTuningJobWorkload(jobProto.Resources, jobProto.Placement)
+		sresult, err := scheduleAndReserve(s.cache, job.TenantID, w, func(*store.S) (scheduler.SchedulingResult, error) {
+			return s.scheduler.Schedule(userInfo, "", w)
+		}, func(ns string) []string {
+			return []string{fmt.Sprintf("%s/%s", ns, job.JobID)}
+		})
+		if err != nil {
+			// skip this job if it cannot be scheduled
+			s.logger.Error(err, fmt.Sprintf("reschedule a fine-tuning job %s", job.JobID))
+			continue
+		}
+
+		job.State = store.JobStateQueued
+		job.QueuedAction = store.JobQueuedActionCreate
+		job.ClusterID = sresult.ClusterID
+		if err := job.MutateMessage(func(j *v1.Job) {
+			j.ClusterId = sresult.ClusterID
+			j.ClusterName = sresult.ClusterName
+			j.KubernetesNamespace = sresult.Namespace
+		}); err != nil {
+			return err
+		}
+		if err := s.store.UpdateJobForRescheduling(job); err != nil {
+			return fmt.Errorf("reschedule a fine-tuning job %s: %w", job.JobID, err)
+		}
+		s.logger.V(1).Info("fine-tuning job is rescheduled", "jobID", job.JobID, "clusterID", job.ClusterID)
+	}
+	return nil
+}
@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/config"
 	"github.com/llmariner/job-manager/server/internal/store"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestRescheduleNotebooks(t *testing.T) {
@@ -73,7 +76,7 @@ func TestRescheduleNotebooks(t *testing.T) {
 			assert.NoError(t, err)
 			time.Sleep(time.Second * 2)
 
-			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, map[string]string{"t0": "img0"}, nil, testr.New(t), nil)
+			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, map[string]string{"t0": "img0"}, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 			err = srv.rescheduleNotebooks(context.Background(), time.Second)
 			assert.NoError(t, err)
 
@@ -84,3 +87,103 @@ func TestRescheduleNotebooks(t *testing.T) {
 		})
 	}
 }
+
+func TestRescheduleBatchJobs(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	msg, err := proto.Marshal(&v1.BatchJob{
+		ClusterId:           "cluster0",
+		KubernetesNamespace: "namespace0",
+	})
+	assert.NoError(t, err)
+	schedulable, err := toProjectMessage(&auth.UserInfo{
+		AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+			{
+				ClusterID: "cluster1",
+				Namespace: "namespace1",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	unschedulable, err := toProjectMessage(&auth.UserInfo{})
+	assert.NoError(t, err)
+	for _, job := range []*store.BatchJob{
+		{JobID: "bj0", ProjectMessage: schedulable},
+		{JobID: "bj1", ProjectMessage: unschedulable},
+	} {
+		job.TenantID = defaultTenantID
+		job.ClusterID = "cluster0"
+		job.Message = msg
+		job.State = store.BatchJobStateRequeued
+		err := job.SetScripts(map[string][]byte{"main.py": []byte("print('hello')")})
+		assert.NoError(t, err)
+		err = job.SetAPIKey(context.Background(), "key0", nil)
+		assert.NoError(t, err)
+		err = st.CreateBatchJob(job)
+		assert.NoError(t, err)
+	}
+
+	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	err = srv.rescheduleBatchJobs(context.Background())
+	assert.NoError(t, err)
+
+	job, err := st.GetBatchJobByID("bj0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.BatchJobStateQueued, job.State)
+	assert.Equal(t, store.BatchJobQueuedActionCreate, job.QueuedAction)
+	assert.Equal(t, "cluster1", job.ClusterID)
+	jobProto, err := job.V1BatchJob()
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster1", jobProto.ClusterId)
+	assert.Equal(t, "namespace1", jobProto.KubernetesNamespace)
+
+	// The job that cannot be scheduled is kept requeued.
+	job, err = st.GetBatchJobByID("bj1")
+	assert.NoError(t, err)
+	assert.Equal(t, store.BatchJobStateRequeued, job.State)
+	assert.Equal(t, "cluster0", job.ClusterID)
+}
+
+func TestRescheduleFineTuningJobs(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	msg, err := proto.Marshal(&v1.Job{
+		ClusterId:           "cluster0",
+		KubernetesNamespace: "namespace0",
+	})
+	assert.NoError(t, err)
+	proj, err := toProjectMessage(&auth.UserInfo{
+		AssignedKubernetesEnvs: []auth.AssignedKubernetesEnv{
+			{
+				ClusterID: "cluster1",
+				Namespace: "namespace1",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	err = st.CreateJob(&store.Job{
+		JobID:          "job0",
+		TenantID:       defaultTenantID,
+		ClusterID:      "cluster0",
+		Message:        msg,
+		ProjectMessage: proj,
+		State:          store.JobStateRequeued,
+	})
+	assert.NoError(t, err)
+
+	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, nil, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
+	err = srv.rescheduleFineTuningJobs()
+	assert.NoError(t, err)
+
+	job, err := st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.JobStateQueued, job.State)
+	assert.Equal(t, store.JobQueuedActionCreate, job.QueuedAction)
+	assert.Equal(t, "cluster1", job.ClusterID)
+	jobProto, err := job.V1Job()
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster1", jobProto.ClusterId)
+	assert.Equal(t, "namespace1", jobProto.KubernetesNamespace)
+}
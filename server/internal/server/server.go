@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/llmariner/api-usage/pkg/sender"
 	fv1 "github.com/llmariner/file-manager/api/v1"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/cache"
 	"github.com/llmariner/job-manager/server/internal/config"
 	"github.com/llmariner/job-manager/server/internal/k8s"
 	"github.com/llmariner/job-manager/server/internal/scheduler"
@@ -39,12 +41,53 @@ type modelClient interface {
 	GetModel(ctx context.Context, in *mv1.GetModelRequest, opts ...grpc.CallOption) (*mv1.Model, error)
 }
 
+type logArchiveClient interface {
+	ListObjectKeys(ctx context.Context, prefix string) ([]string, error)
+	GeneratePresignedURL(ctx context.Context, key string, expire time.Duration) (string, error)
+}
+
 type schedulerI interface {
-	Schedule(userInfo *auth.UserInfo, prevClusterID string, gpuCount int) (scheduler.SchedulingResult, error)
+	Schedule(userInfo *auth.UserInfo, prevClusterID string, w scheduler.Workload) (scheduler.SchedulingResult, error)
+	FindPreemptionVictims(
+		userInfo *auth.UserInfo,
+		prevClusterID string,
+		w scheduler.Workload,
+		priority int32,
+		candidates []scheduler.PreemptionCandidate,
+	) (scheduler.PreemptionResult, error)
 }
 
 type cacheI interface {
-	AddAssumedPod(tenantID, clusterID, key string, gpuCount int) error
+	Reserve(tenantID string, fn func(tx *store.S) (cache.Reservation, error)) error
+	AddOrUpdateCluster(cluster *store.Cluster) error
+}
+
+// scheduleAndReserve schedules a workload with the given function and reserves the resources of its pods
+// atomically across server replicas. schedule is called with a store that runs queries in the transaction of
+// the reservation. podKeys returns the keys of the pods in the scheduled namespace.
+func scheduleAndReserve(
+	c cacheI,
+	tenantID string,
+	w scheduler.Workload,
+	schedule func(tx *store.S) (scheduler.SchedulingResult, error),
+	podKeys func(namespace string) []string,
+) (scheduler.SchedulingResult, error) {
+	var sresult scheduler.SchedulingResult
+	if err := c.Reserve(tenantID, func(tx *store.S) (cache.Reservation, error) {
+		var err error
+		sresult, err = schedule(tx)
+		if err != nil {
+			return cache.Reservation{}, err
+		}
+		return cache.Reservation{
+			ClusterID: sresult.ClusterID,
+			Keys:      podKeys(sresult.Namespace),
+			Resources: w.PodResources(),
+		}, nil
+	}); err != nil {
+		return scheduler.SchedulingResult{}, err
+	}
+	return sresult, nil
 }
 
 // New creates a server.
@@ -57,6 +100,9 @@ func New(
 	cache cacheI,
 	nbImageTypes map[string]string,
 	batchJobImages map[string]string,
+	preemptionConfig config.PreemptionConfig,
+	logArchiveClient logArchiveClient,
+	logArchiveConfig config.LogArchiveConfig,
 	logger logr.Logger,
 	dataKey []byte,
 ) *S {
@@ -64,6 +110,10 @@ func New(
 	for t := range nbImageTypes {
 		nbtypes = append(nbtypes, t)
 	}
+	nonPreemptibleProjects := map[string]bool{}
+	for _, id := range preemptionConfig.NonPreemptibleProjectIDs {
+		nonPreemptibleProjects[id] = true
+	}
 	return &S{
 		store:            store,
 		fileGetClient:    fileGetClient,
@@ -74,8 +124,16 @@ func New(
 		nbImageTypes:     nbImageTypes,
 		nbImageTypeStr:   strings.Join(nbtypes, ", "),
 		batchJobImages:   batchJobImages,
-		logger:           logger.WithName("grpc"),
-		dataKey:          dataKey,
+
+		enablePreemption:       preemptionConfig.Enable,
+		nonPreemptibleProjects: nonPreemptibleProjects,
+		preemptNotebooks:       preemptionConfig.PreemptNotebooks,
+
+		logArchiveClient: logArchiveClient,
+		logArchiveConfig: logArchiveConfig,
+
+		logger:  logger.WithName("grpc"),
+		dataKey: dataKey,
 	}
 }
 
@@ -100,31 +158,49 @@ type S struct {
 
 	batchJobImages map[string]string
 
+	enablePreemption bool
+	// nonPreemptibleProjects is the set of the project IDs that are allowed to create non-preemptible workloads.
+	nonPreemptibleProjects map[string]bool
+	// preemptNotebooks is true if running notebooks can be preempted in addition to batch jobs.
+	preemptNotebooks bool
+
+	// logArchiveClient is used only when the log archive is enabled.
+	logArchiveClient logArchiveClient
+	logArchiveConfig config.LogArchiveConfig
+
 	logger  logr.Logger
 	dataKey []byte
 }
 
+// accessResourceForGRPCRequest returns the resource that the caller of the gRPC method must have access to.
+func accessResourceForGRPCRequest(fullMethod string) string {
+	switch {
+	case strings.HasPrefix(fullMethod, "/llmariner.workspace."):
+		return "api.workspaces.notebooks"
+	case clusterOperationMethods[fullMethod]:
+		return clusterOperationAccessResource
+	}
+	// TODO(kenji): Add a case for JobWorkerService.
+	return "api.fine_tuning.jobs"
+}
+
 // Run starts the gRPC server.
 func (s *S) Run(ctx context.Context, port int, authConfig config.AuthConfig, usage sender.UsageSetter) error {
 	s.logger.Info("Starting gRPC server", "port", port)
 
-	var opt grpc.ServerOption
+	var opts []grpc.ServerOption
 	if authConfig.Enable {
 		ai, err := auth.NewInterceptor(ctx, auth.Config{
-			RBACServerAddr: authConfig.RBACInternalServerAddr,
-			GetAccessResourceForGRPCRequest: func(fullMethod string) string {
-				switch {
-				case strings.HasPrefix(fullMethod, "/llmariner.workspace."):
-					return "api.workspaces.notebooks"
-				}
-				// TODO(kenji): Add a case for JobWorkerService.
-				return "api.fine_tuning.jobs"
-			},
+			RBACServerAddr:                  authConfig.RBACInternalServerAddr,
+			GetAccessResourceForGRPCRequest: accessResourceForGRPCRequest,
 		})
 		if err != nil {
 			return err
 		}
-		opt = grpc.ChainUnaryInterceptor(ai.Unary("/grpc.health.v1.Health/Check"), sender.Unary(usage))
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(ai.Unary("/grpc.health.v1.Health/Check"), sender.Unary(usage)),
+			grpc.ChainStreamInterceptor(streamInterceptor(ai.Unary())),
+		)
 	} else {
 		fakeAuth := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 			if info.FullMethod == "/grpc.health.v1.Health/Check" {
@@ -133,10 +209,16 @@ func (s *S) Run(ctx context.Context, port int, authConfig config.AuthConfig, usa
 			}
 			return handler(fakeAuthInto(ctx), req)
 		}
-		opt = grpc.ChainUnaryInterceptor(fakeAuth, sender.Unary(usage))
+		fakeStreamAuth := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, &serverStream{ServerStream: ss, ctx: fakeAuthInto(ss.Context())})
+		}
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(fakeAuth, sender.Unary(usage)),
+			grpc.ChainStreamInterceptor(fakeStreamAuth),
+		)
 	}
 
-	grpcServer := grpc.NewServer(opt)
+	grpcServer := grpc.NewServer(opts...)
 	v1.RegisterFineTuningServiceServer(grpcServer, s)
 	v1.RegisterWorkspaceServiceServer(grpcServer, s)
 	v1.RegisterBatchServiceServer(grpcServer, s)
@@ -160,6 +242,29 @@ func (s *S) Run(ctx context.Context, port int, authConfig config.AuthConfig, usa
 	return nil
 }
 
+// streamInterceptor returns a stream server interceptor that authorizes server-streaming RPCs with the unary
+// interceptor.
+func streamInterceptor(unary grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		uinfo := &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod}
+		_, err := unary(ss.Context(), nil, uinfo, func(ctx context.Context, _ any) (any, error) {
+			return nil, handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		})
+		return err
+	}
+}
+
+// serverStream is a server stream with the context that carries the user info.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
 // Stop stops the gRPC server.
 func (s *S) Stop() {
 	s.srv.Stop()
@@ -129,7 +129,7 @@ func (s *S) getSummariesByTimeRange(tenantID string, startTime, endTime time.Tim
 					value.TotalRunning += count
 				case "deleted": // NotebookStateDeleted, BatchJobStateDeleted
 					value.TotalDeleted += count
-				case "requeued": // NotebookStateRequeued
+				case "requeued": // JobStateRequeued, BatchJobStateRequeued, NotebookStateRequeued
 					value.TotalQueued += count
 				case "queued": // JobStateQueued, BatchJobStateQueued
 					value.TotalQueued += count
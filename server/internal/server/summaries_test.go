@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/server/internal/config"
 	"github.com/llmariner/job-manager/server/internal/store"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -144,7 +145,7 @@ func TestGetSummariesByTimeRange(t *testing.T) {
 	// Run test cases
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, map[string]string{"t0": "img0"}, testr.New(t), nil)
+			srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, map[string]string{"t0": "img0"}, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 
 			datapoints, err := srv.getSummariesByTimeRange(tc.tenantID, startTime, endTime, tc.interval)
 			assert.NoError(t, err)
@@ -261,7 +262,7 @@ func TestListJobSummaries(t *testing.T) {
 	store.Seed(t, st, seed)
 
 	// Create server with the test store
-	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, map[string]string{"t0": "img0"}, testr.New(t), nil)
+	srv := New(st, nil, nil, &noopK8sClientFactory{}, &fakeScheduler{}, &fakeCache{}, nil, map[string]string{"t0": "img0"}, config.PreemptionConfig{}, nil, config.LogArchiveConfig{}, testr.New(t), nil)
 
 	// Test cases
 	testCases := []struct {
@@ -10,6 +10,8 @@ import (
 	v1 "github.com/llmariner/job-manager/api/v1"
 	"github.com/llmariner/job-manager/server/internal/config"
 	"github.com/llmariner/job-manager/server/internal/k8s"
+	"github.com/llmariner/job-manager/server/internal/scheduler"
+	"github.com/llmariner/job-manager/server/internal/store"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -105,18 +107,19 @@ func (ss *SS) PatchKubernetesObject(ctx context.Context, req *v1.PatchKubernetes
 
 	// TODO(aya): Schedule to the cluster where it was created If the resource is not newly created.
 	// TODO(kenji): Fix the gpu count.
-	var gpuCount int
+	w := scheduler.Workload{PodCount: 1}
 	if r := req.Resources; r != nil {
-		gpuCount = int(r.GpuLimit)
+		w.GPUCountPerPod = int(r.GpuLimit)
+		w.AcceleratorType = r.AcceleratorType
 	}
-	sresult, err := ss.scheduler.Schedule(userInfo, "", gpuCount)
+	sresult, err := scheduleAndReserve(ss.cache, userInfo.TenantID, w, func(*store.S) (scheduler.SchedulingResult, error) {
+		return ss.scheduler.Schedule(userInfo, "", w)
+	}, func(ns string) []string {
+		return []string{fmt.Sprintf("%s/%s", ns, req.Name)}
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "schedule: %s", err)
 	}
-	if err := ss.cache.AddAssumedPod(userInfo.TenantID, sresult.ClusterID,
-		fmt.Sprintf("%s/%s", sresult.Namespace, req.Name), gpuCount); err != nil {
-		return nil, status.Errorf(codes.Internal, "add assumed pod: %s", err)
-	}
 	clusterID := sresult.ClusterID
 
 	if sresult.Namespace != req.Namespace {
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 
@@ -22,11 +23,14 @@ const (
 )
 
 // NewWorkerServiceServer creates a new worker service server.
-func NewWorkerServiceServer(s *store.S, c *cache.Store, logger logr.Logger) *WS {
+func NewWorkerServiceServer(s *store.S, c *cache.Store, kueueConfig config.KueueConfig, logger logr.Logger) *WS {
+	logger = logger.WithName("worker")
 	return &WS{
-		store:  s,
-		cache:  c,
-		logger: logger.WithName("worker"),
+		store:          s,
+		cache:          c,
+		kueueProjects:  kueueConfig.Projects,
+		dispatchBroker: newDispatchBroker(s, logger),
+		logger:         logger,
 	}
 }
 
@@ -42,6 +46,12 @@ type WS struct {
 	cache  *cache.Store
 	logger logr.Logger
 
+	dispatchBroker *dispatchBroker
+
+	// kueueProjects are the Kueue queues of the projects keyed by their IDs. They are resolved when the
+	// dispatcher lists the workloads so that the queues are never exposed to users.
+	kueueProjects map[string]config.KueueProjectConfig
+
 	enableAuth bool
 }
 
@@ -57,7 +67,7 @@ func (ws *WS) Run(ctx context.Context, port int, authConfig config.AuthConfig) e
 		if err != nil {
 			return err
 		}
-		opts = append(opts, grpc.ChainUnaryInterceptor(ai.Unary()))
+		opts = append(opts, grpc.ChainUnaryInterceptor(ai.Unary()), grpc.ChainStreamInterceptor(ai.Stream()))
 		ws.enableAuth = true
 	}
 
@@ -71,6 +81,12 @@ func (ws *WS) Run(ctx context.Context, port int, authConfig config.AuthConfig) e
 
 	ws.srv = srv
 
+	go func() {
+		if err := ws.dispatchBroker.run(ctx, defaultDispatchPollingInterval); err != nil && !errors.Is(err, context.Canceled) {
+			ws.logger.Error(err, "Dispatch broker stopped")
+		}
+	}()
+
 	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		return fmt.Errorf("listen: %w", err)
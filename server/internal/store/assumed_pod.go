@@ -0,0 +1,111 @@
+package store
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AssumedPod is a reservation of resources for a pod that has been scheduled to a cluster, but not yet reported
+// by the dispatcher. Reservations are stored in the database so that all server replicas take them into account.
+type AssumedPod struct {
+	gorm.Model
+
+	TenantID  string `gorm:"index:idx_assumed_pod_tenant_id_cluster_id"`
+	ClusterID string `gorm:"index:idx_assumed_pod_tenant_id_cluster_id"`
+
+	// PodKey is the namespaced name of the pod or its prefix.
+	PodKey string
+
+	// ResourceName is the resource name of the accelerator reserved for the pod (e.g., "nvidia.com/gpu").
+	ResourceName    string
+	AllocatedCount  int32
+	CPUMilicore     int32
+	MemoryMegabytes int32
+}
+
+// TenantLock is a row that is locked to serialize scheduling decisions of a tenant. It exists separately from
+// the clusters of the tenant so that the lock is held even when the tenant has no cluster or a cluster is being added.
+type TenantLock struct {
+	gorm.Model
+
+	TenantID string `gorm:"uniqueIndex"`
+}
+
+// LockClustersByTenantID runs the function in a transaction that holds the lock of the tenant and the row locks of
+// the clusters of the tenant. The function is called with a store that runs queries in the transaction and the locked
+// clusters. This serializes scheduling decisions of the tenant across server replicas.
+func (s *S) LockClustersByTenantID(tenantID string, fn func(tx *S, clusters []*Cluster) error) error {
+	return s.Transaction(func(tx *S) error {
+		if err := tx.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&TenantLock{TenantID: tenantID}).Error; err != nil {
+			return err
+		}
+		if err := tx.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("tenant_id = ?", tenantID).
+			Take(&TenantLock{}).Error; err != nil {
+			return err
+		}
+
+		var cs []*Cluster
+		if err := tx.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("tenant_id = ?", tenantID).
+			Order("cluster_id").
+			Find(&cs).Error; err != nil {
+			return err
+		}
+		return fn(tx, cs)
+	})
+}
+
+// CreateAssumedPods creates assumed pods. Existing assumed pods that have the same cluster IDs and pod keys are replaced.
+func (s *S) CreateAssumedPods(pods []*AssumedPod) error {
+	if len(pods) == 0 {
+		return nil
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, p := range pods {
+			if err := tx.Unscoped().
+				Where("cluster_id = ? AND pod_key = ?", p.ClusterID, p.PodKey).
+				Delete(&AssumedPod{}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(pods).Error
+	})
+}
+
+// ListAssumedPods lists all assumed pods created after the given time.
+func (s *S) ListAssumedPods(createdAfter time.Time) ([]*AssumedPod, error) {
+	var pods []*AssumedPod
+	if err := s.db.Where("created_at > ?", createdAfter).Order("id").Find(&pods).Error; err != nil {
+		return nil, err
+	}
+	return pods, nil
+}
+
+// ListAssumedPodsByTenantID lists assumed pods of the tenant created after the given time.
+func (s *S) ListAssumedPodsByTenantID(tenantID string, createdAfter time.Time) ([]*AssumedPod, error) {
+	var pods []*AssumedPod
+	if err := s.db.Where("tenant_id = ? AND created_at > ?", tenantID, createdAfter).Order("id").Find(&pods).Error; err != nil {
+		return nil, err
+	}
+	return pods, nil
+}
+
+// ListAssumedPodsByClusterID lists assumed pods of the cluster.
+func (s *S) ListAssumedPodsByClusterID(clusterID string) ([]*AssumedPod, error) {
+	var pods []*AssumedPod
+	if err := s.db.Where("cluster_id = ?", clusterID).Order("id").Find(&pods).Error; err != nil {
+		return nil, err
+	}
+	return pods, nil
+}
+
+// DeleteAssumedPods deletes assumed pods by their IDs.
+func (s *S) DeleteAssumedPods(ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.db.Unscoped().Delete(&AssumedPod{}, ids).Error
+}
@@ -0,0 +1,89 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssumedPods(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	for _, c := range []*Cluster{
+		{ClusterID: "c0", TenantID: "t0"},
+		{ClusterID: "c1", TenantID: "t0"},
+		{ClusterID: "c2", TenantID: "t1"},
+	} {
+		_, err := st.CreateOrUpdateCluster(c)
+		assert.NoError(t, err)
+	}
+
+	err := st.LockClustersByTenantID("t0", func(tx *S, clusters []*Cluster) error {
+		assert.Len(t, clusters, 2)
+		assert.Equal(t, "c0", clusters[0].ClusterID)
+		assert.Equal(t, "c1", clusters[1].ClusterID)
+		return tx.CreateAssumedPods([]*AssumedPod{
+			{TenantID: "t0", ClusterID: "c0", PodKey: "ns/p0", AllocatedCount: 1},
+			{TenantID: "t0", ClusterID: "c1", PodKey: "ns/p1", AllocatedCount: 2},
+		})
+	})
+	assert.NoError(t, err)
+
+	// Assumed pods are not created when the function fails.
+	wantErr := errors.New("failed")
+	err = st.LockClustersByTenantID("t1", func(tx *S, clusters []*Cluster) error {
+		assert.Len(t, clusters, 1)
+		if err := tx.CreateAssumedPods([]*AssumedPod{
+			{TenantID: "t1", ClusterID: "c2", PodKey: "ns/p2"},
+		}); err != nil {
+			return err
+		}
+		return wantErr
+	})
+	assert.ErrorIs(t, err, wantErr)
+
+	// A tenant without clusters can be locked repeatedly.
+	for i := 0; i < 2; i++ {
+		err = st.LockClustersByTenantID("t2", func(tx *S, clusters []*Cluster) error {
+			assert.Empty(t, clusters)
+			return nil
+		})
+		assert.NoError(t, err)
+	}
+
+	// An assumed pod with the same key is replaced.
+	err = st.CreateAssumedPods([]*AssumedPod{
+		{TenantID: "t0", ClusterID: "c0", PodKey: "ns/p0", AllocatedCount: 4},
+	})
+	assert.NoError(t, err)
+
+	pods, err := st.ListAssumedPods(time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, pods, 2)
+
+	pods, err = st.ListAssumedPods(time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Empty(t, pods)
+
+	pods, err = st.ListAssumedPodsByTenantID("t0", time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, pods, 2)
+
+	pods, err = st.ListAssumedPodsByClusterID("c0")
+	assert.NoError(t, err)
+	assert.Len(t, pods, 1)
+	assert.Equal(t, int32(4), pods[0].AllocatedCount)
+
+	err = st.DeleteAssumedPods([]uint{pods[0].ID})
+	assert.NoError(t, err)
+	pods, err = st.ListAssumedPodsByClusterID("c0")
+	assert.NoError(t, err)
+	assert.Empty(t, pods)
+
+	pods, err = st.ListAssumedPodsByTenantID("t1", time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.Empty(t, pods)
+}
@@ -1,10 +1,14 @@
 package store
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
+	"github.com/llmariner/common/pkg/aws"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
@@ -25,6 +29,9 @@ const (
 	BatchJobStateCanceled BatchJobState = "canceled"
 	// BatchJobStateDeleted is the state of a batch job that has been deleted.
 	BatchJobStateDeleted BatchJobState = "deleted"
+	// BatchJobStateRequeued is the state of a batch job that has been deleted from its cluster and waits to be
+	// rescheduled to another cluster.
+	BatchJobStateRequeued BatchJobState = "requeued"
 )
 
 // BatchJobQueuedAction is the action of a queue batch job.
@@ -37,6 +44,8 @@ const (
 	BatchJobQueuedActionCancel BatchJobQueuedAction = "canceling"
 	// BatchJobQueuedActionDelete is the action to delete a batch job.
 	BatchJobQueuedActionDelete BatchJobQueuedAction = "deleting"
+	// BatchJobQueuedActionRequeue is the action to requeue a batch job.
+	BatchJobQueuedActionRequeue BatchJobQueuedAction = "requeueing"
 )
 
 // BatchJob is a model of a batch job.
@@ -57,15 +66,89 @@ type BatchJob struct {
 
 	// Message is the marshaled message of the v1.BatchJob.
 	Message []byte
+	// ProjectMessage is the marshaled message of the rbac v1.Project.
+	ProjectMessage []byte
+
+	// APIKey is set when kms encryption is disabled.
+	APIKey string
+	// EncryptedAPIKey is encrypted by data key, and set when kms encryption is enabled.
+	EncryptedAPIKey []byte
+	// Scripts is the marshaled JSON of the scripts of the batch job. The API key and the scripts are kept
+	// to recreate the secret and the configmap of the batch job when it is rescheduled to another cluster.
+	Scripts []byte
 
 	State BatchJobState `gorm:"index:idx_batchjob_project_id_state;index:idx_batchjob_tenant_id_cluster_id_state"`
 	// QueuedAction is the action of the batch job. This field is only used when
 	// the state is BatchJobStateQueued, and processed by the dispatcher.
 	QueuedAction BatchJobQueuedAction
+	// DispatchSequence is the sequence number allocated when the queued action is set. It is the cursor of
+	// the action pushed to the dispatcher.
+	DispatchSequence int64
+	// LastDispatchError is the error of the last attempt of the dispatcher to process the queued action.
+	LastDispatchError string
+	// StatusReason describes why the batch job is not making progress (e.g., an image pull failure).
+	StatusReason string
 
 	Version int
 }
 
+// GetAPIKey returns the API key, decrypting it if necessary.
+func (j *BatchJob) GetAPIKey(ctx context.Context, dataKey []byte) (string, error) {
+	if len(dataKey) > 0 && len(j.EncryptedAPIKey) > 0 {
+		decrypted, err := aws.Decrypt(ctx, j.EncryptedAPIKey, j.JobID, dataKey)
+		if err != nil {
+			return "", fmt.Errorf("decrypt api key: %w", err)
+		}
+		return decrypted, nil
+	}
+	return j.APIKey, nil
+}
+
+// SetAPIKey sets the API key, encrypting it if a data key is provided.
+func (j *BatchJob) SetAPIKey(ctx context.Context, apiKey string, dataKey []byte) error {
+	if len(dataKey) > 0 {
+		encrypted, err := aws.Encrypt(ctx, apiKey, j.JobID, dataKey)
+		if err != nil {
+			return fmt.Errorf("encrypt api key: %w", err)
+		}
+		j.EncryptedAPIKey = encrypted
+		j.APIKey = ""
+	} else {
+		j.APIKey = apiKey
+	}
+	return nil
+}
+
+// GetScripts returns the scripts of the batch job.
+func (j *BatchJob) GetScripts() (map[string][]byte, error) {
+	var scripts map[string][]byte
+	if err := json.Unmarshal(j.Scripts, &scripts); err != nil {
+		return nil, err
+	}
+	return scripts, nil
+}
+
+// SetScripts sets the scripts of the batch job.
+func (j *BatchJob) SetScripts(scripts map[string][]byte) error {
+	b, err := json.Marshal(scripts)
+	if err != nil {
+		return err
+	}
+	j.Scripts = b
+	return nil
+}
+
+// Reschedulable returns true if the batch job keeps what is needed to reschedule it to another cluster. Batch
+// jobs created before the fields were added cannot be rescheduled.
+func (j *BatchJob) Reschedulable() bool {
+	return len(j.ProjectMessage) > 0 && len(j.Scripts) > 0
+}
+
+// RebuildUserInfo rebuilds the user info of the batch job to reschedule it.
+func (j *BatchJob) RebuildUserInfo() (*auth.UserInfo, error) {
+	return rebuildUserInfo(j.ProjectMessage, j.TenantID, j.ProjectID)
+}
+
 // V1BatchJob returns the v1.BatchJob of the batch job.
 func (j *BatchJob) V1BatchJob() (*v1.BatchJob, error) {
 	var jobProto v1.BatchJob
@@ -77,6 +160,8 @@ func (j *BatchJob) V1BatchJob() (*v1.BatchJob, error) {
 	} else {
 		jobProto.Status = string(j.State)
 	}
+	jobProto.LastDispatchError = j.LastDispatchError
+	jobProto.StatusReason = j.StatusReason
 	return &jobProto, nil
 }
 
@@ -138,7 +223,7 @@ func convertToBatchJobQueuedAction(action BatchJobQueuedAction) (v1.InternalBatc
 
 // CreateBatchJob creates a batch job.
 func (s *S) CreateBatchJob(job *BatchJob) error {
-	return s.db.Create(job).Error
+	return s.createWithDispatchSequence(job, string(job.QueuedAction), &job.DispatchSequence)
 }
 
 // GetBatchJobByID gets a batch job by its job ID.
@@ -187,26 +272,65 @@ func (s *S) ListQueuedBatchJobsByTenantIDAndClusterID(tenantID, clusterID string
 	return jobs, nil
 }
 
+// ListBatchJobsByTenantIDAndClusterIDAndStates finds batch jobs in the given states by tenant ID and cluster ID.
+func (s *S) ListBatchJobsByTenantIDAndClusterIDAndStates(tenantID, clusterID string, states []BatchJobState) ([]BatchJob, error) {
+	var jobs []BatchJob
+	if err := s.db.Where("tenant_id = ? AND cluster_id = ? AND state IN ?", tenantID, clusterID, states).Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ListBatchJobsByState finds batch jobs by state.
+func (s *S) ListBatchJobsByState(state BatchJobState) ([]BatchJob, error) {
+	var jobs []BatchJob
+	if err := s.db.Where("state = ?", state).Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ListBatchJobsByTenantIDAndState finds batch jobs by tenant ID and state.
+func (s *S) ListBatchJobsByTenantIDAndState(tenantID string, state BatchJobState) ([]BatchJob, error) {
+	var jobs []BatchJob
+	if err := s.db.Where("tenant_id = ? AND state = ?", tenantID, state).Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
 // SetBatchJobQueuedAction sets the queued action of a batch job.
 func (s *S) SetBatchJobQueuedAction(id string, currentVersion int, newActionn BatchJobQueuedAction) (*BatchJob, error) {
 	var job BatchJob
-	result := s.db.Model(&job).
-		Where("job_id = ?", id).
-		Where("version = ?", currentVersion).
-		Updates(map[string]interface{}{
-			"state":         BatchJobStateQueued,
-			"queued_action": newActionn,
-			"version":       currentVersion + 1,
-		})
-	if err := result.Error; err != nil {
+	rowsAffected, err := s.updateQueuedAction(&job, string(newActionn), map[string]interface{}{
+		"state":   BatchJobStateQueued,
+		"version": currentVersion + 1,
+	}, "job_id = ? AND version = ?", id, currentVersion)
+	if err != nil {
 		return nil, err
 	}
-	if result.RowsAffected == 0 {
+	if rowsAffected == 0 {
 		return nil, fmt.Errorf("update batch job: %w", ErrConcurrentUpdate)
 	}
 	return &job, nil
 }
 
+// SetBatchJobQueuedActionAndMessage sets the queued action and message of a batch job.
+func (s *S) SetBatchJobQueuedActionAndMessage(id string, currentVersion int, newAction BatchJobQueuedAction, message []byte) error {
+	rowsAffected, err := s.updateQueuedAction(&BatchJob{}, string(newAction), map[string]interface{}{
+		"state":   BatchJobStateQueued,
+		"message": message,
+		"version": currentVersion + 1,
+	}, "job_id = ? AND version = ?", id, currentVersion)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("update batch job: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
 // SetBatchJobState sets the state of a batch job.
 func (s *S) SetBatchJobState(id string, currentVersion int, newState BatchJobState) error {
 	result := s.db.Model(&BatchJob{}).
@@ -246,6 +370,57 @@ func (s *S) SetNonQueuedBatchJobStateAndMessage(id string, currentVersion int, n
 	return nil
 }
 
+// UpdateBatchJobForRescheduling updates the cluster, state, queued action, and message of a batch job.
+func (s *S) UpdateBatchJobForRescheduling(job *BatchJob) error {
+	rowsAffected, err := s.updateQueuedAction(&BatchJob{}, string(job.QueuedAction), map[string]interface{}{
+		"cluster_id": job.ClusterID,
+		"state":      job.State,
+		"message":    job.Message,
+		"version":    job.Version + 1,
+	}, "job_id = ? AND version = ?", job.JobID, job.Version)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("update batch job: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
+// UpdateBatchJobMessage updates the message of a batch job. The state and queued action are not changed.
+func (s *S) UpdateBatchJobMessage(id string, currentVersion int, message []byte) error {
+	result := s.db.Model(&BatchJob{}).
+		Where("job_id = ?", id).
+		Where("version = ?", currentVersion).
+		Updates(map[string]interface{}{
+			"message": message,
+			"version": currentVersion + 1,
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("update batch job: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
+// UpdateBatchJobLastDispatchError updates the last dispatch error of a batch job. The version and the update time
+// are kept as the error does not change the state of the batch job.
+func (s *S) UpdateBatchJobLastDispatchError(id, lastDispatchError string) error {
+	return s.db.Model(&BatchJob{}).
+		Where("job_id = ?", id).
+		UpdateColumn("last_dispatch_error", lastDispatchError).Error
+}
+
+// UpdateBatchJobStatusReason updates the status reason of a batch job. The version and the update time
+// are kept as the reason does not change the state of the batch job.
+func (s *S) UpdateBatchJobStatusReason(id, statusReason string) error {
+	return s.db.Model(&BatchJob{}).
+		Where("job_id = ?", id).
+		UpdateColumn("status_reason", statusReason).Error
+}
+
 // CountActiveBatchJobsByProjectID counts the total number of active batch jobs by project ID.
 func (s *S) CountActiveBatchJobsByProjectID(projectID string) (int64, error) {
 	var count int64
@@ -2,10 +2,24 @@ package store
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+// ClusterHealthState is the health state of a cluster.
+type ClusterHealthState string
+
+const (
+	// ClusterHealthStateHealthy is the state of a cluster whose status has been updated recently.
+	ClusterHealthStateHealthy ClusterHealthState = "healthy"
+	// ClusterHealthStateDegraded is the state of a cluster whose status has not been updated recently.
+	ClusterHealthStateDegraded ClusterHealthState = "degraded"
+	// ClusterHealthStateUnreachable is the state of a cluster whose status has not been updated for a long time.
+	ClusterHealthStateUnreachable ClusterHealthState = "unreachable"
+)
+
 // Cluster represents the cluster.
 type Cluster struct {
 	gorm.Model
@@ -17,6 +31,54 @@ type Cluster struct {
 
 	// Status is a marshalled proto message ClusterStatus.
 	Status []byte
+
+	// StatusSessionID and StatusSequenceNumber are the session ID and the sequence number of the last
+	// status update sent by the dispatcher. They are used to detect missed deltas.
+	StatusSessionID      string
+	StatusSequenceNumber int64
+
+	// Cordoned is true if the cluster is excluded from scheduling. Cordoned, CordonReason, Draining,
+	// and MaintenanceWindows are set by administrators and not changed by status updates.
+	Cordoned     bool
+	CordonReason string
+	// Draining is true if the workloads in the cluster are being moved out of the cluster.
+	Draining bool
+
+	MaintenanceWindows []MaintenanceWindow `gorm:"serializer:json"`
+
+	// HealthState is the health state of the cluster. It is updated by the health checker based on UpdatedAt.
+	HealthState ClusterHealthState
+
+	// Labels are the labels set by administrators. They override the labels reported by the dispatcher.
+	Labels map[string]string `gorm:"serializer:json"`
+}
+
+// EffectiveLabels returns the labels reported by the dispatcher merged with the labels set by administrators.
+func (c *Cluster) EffectiveLabels(reported map[string]string) map[string]string {
+	if len(reported) == 0 && len(c.Labels) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(reported)+len(c.Labels))
+	for k, v := range reported {
+		labels[k] = v
+	}
+	for k, v := range c.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// MaintenanceWindow is a period when a cluster is excluded from scheduling.
+type MaintenanceWindow struct {
+	ID        string
+	StartTime time.Time
+	EndTime   time.Time
+	Reason    string
+}
+
+// IsActive returns true if the maintenance window covers the given time.
+func (w *MaintenanceWindow) IsActive(t time.Time) bool {
+	return !t.Before(w.StartTime) && t.Before(w.EndTime)
 }
 
 // CreateOrUpdateCluster creates or updates a cluster.
@@ -28,21 +90,132 @@ func (s *S) CreateOrUpdateCluster(c *Cluster) (*Cluster, error) {
 		}
 
 		// No existing record. Create a new one.
+		if c.HealthState == "" {
+			c.HealthState = ClusterHealthStateHealthy
+		}
 		if err := s.db.Create(c).Error; err != nil {
 			return nil, err
 		}
 		return c, nil
 	}
 
-	// Found an existing record. Update it.
+	// Found an existing record. Update only the status so that the fields set by administrators
+	// are not overwritten.
 	existing.Status = c.Status
-	if err := s.db.Save(&existing).Error; err != nil {
+	existing.StatusSessionID = c.StatusSessionID
+	existing.StatusSequenceNumber = c.StatusSequenceNumber
+	if err := s.db.Model(&existing).Updates(map[string]interface{}{
+		"status":                 c.Status,
+		"status_session_id":      c.StatusSessionID,
+		"status_sequence_number": c.StatusSequenceNumber,
+	}).Error; err != nil {
 		return nil, err
 	}
 
 	return &existing, nil
 }
 
+// UpdateClusterStatusDelta updates the status of a cluster with the status that a delta has been applied to.
+// The update fails with ErrConcurrentUpdate if the session ID or the sequence number has been changed.
+func (s *S) UpdateClusterStatusDelta(clusterID, sessionID string, currentSeq, newSeq int64, status []byte) (*Cluster, error) {
+	result := s.db.Model(&Cluster{}).
+		Where("cluster_id = ?", clusterID).
+		Where("status_session_id = ?", sessionID).
+		Where("status_sequence_number = ?", currentSeq).
+		Updates(map[string]interface{}{
+			"status":                 status,
+			"status_sequence_number": newSeq,
+		})
+	if err := result.Error; err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("update cluster: %w", ErrConcurrentUpdate)
+	}
+	return s.GetClusterByID(clusterID)
+}
+
+// UpdateClusterCordon updates the cordon state of a cluster.
+// UpdatedAt is not changed as it tracks the last status update.
+func (s *S) UpdateClusterCordon(clusterID string, cordoned bool, reason string, draining bool) (*Cluster, error) {
+	result := s.db.Model(&Cluster{}).
+		Where("cluster_id = ?", clusterID).
+		UpdateColumns(map[string]interface{}{
+			"cordoned":      cordoned,
+			"cordon_reason": reason,
+			"draining":      draining,
+		})
+	if err := result.Error; err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.GetClusterByID(clusterID)
+}
+
+// ClearClusterDraining clears the draining flag of a cluster whose workloads have been moved out. The cluster remains
+// cordoned. UpdatedAt is not changed.
+func (s *S) ClearClusterDraining(clusterID string) error {
+	return s.db.Model(&Cluster{}).
+		Where("cluster_id = ? AND draining = ?", clusterID, true).
+		UpdateColumn("draining", false).Error
+}
+
+// UpdateClusterLabels updates the labels of a cluster set by administrators. UpdatedAt is not changed.
+func (s *S) UpdateClusterLabels(clusterID string, labels map[string]string) (*Cluster, error) {
+	var c Cluster
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("cluster_id = ?", clusterID).Take(&c).Error; err != nil {
+			return err
+		}
+		c.Labels = labels
+		return tx.Model(&c).Select("labels").UpdateColumns(&c).Error
+	}); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// UpdateClusterMaintenanceWindows updates the maintenance windows of a cluster with the given function.
+// The function is called in a transaction with the current maintenance windows. UpdatedAt is not changed.
+func (s *S) UpdateClusterMaintenanceWindows(
+	clusterID string,
+	updateFn func([]MaintenanceWindow) ([]MaintenanceWindow, error),
+) (*Cluster, error) {
+	var c Cluster
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("cluster_id = ?", clusterID).Take(&c).Error; err != nil {
+			return err
+		}
+		ws, err := updateFn(c.MaintenanceWindows)
+		if err != nil {
+			return err
+		}
+		c.MaintenanceWindows = ws
+		return tx.Model(&c).Select("maintenance_windows").UpdateColumns(&c).Error
+	}); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// UpdateClusterHealthState updates the health state of a cluster from the given state. It returns
+// ErrConcurrentUpdate if the state is no longer the given state (e.g., another server replica has updated it).
+// UpdatedAt is not changed.
+func (s *S) UpdateClusterHealthState(clusterID string, from, to ClusterHealthState) error {
+	result := s.db.Model(&Cluster{}).
+		Where("cluster_id = ? AND health_state = ?", clusterID, from).
+		UpdateColumn("health_state", to)
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("update cluster: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
 // GetClusterByID gets a cluster by its ID.
 func (s *S) GetClusterByID(clusterID string) (*Cluster, error) {
 	var c Cluster
@@ -60,3 +233,12 @@ func (s *S) ListClustersByTenantID(tenantID string) ([]*Cluster, error) {
 	}
 	return clusters, nil
 }
+
+// ListClusters lists all clusters.
+func (s *S) ListClusters() ([]*Cluster, error) {
+	var clusters []*Cluster
+	if err := s.db.Find(&clusters).Error; err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
@@ -36,3 +37,167 @@ func TestCreateOrUpdateCluster(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []byte("s1"), got.Status)
 }
+
+func TestUpdateClusterStatusDelta(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	c := &Cluster{
+		ClusterID:            "cid0",
+		TenantID:             "tid0",
+		Status:               []byte("s0"),
+		StatusSessionID:      "session0",
+		StatusSequenceNumber: 1,
+	}
+	_, err := st.CreateOrUpdateCluster(c)
+	assert.NoError(t, err)
+
+	got, err := st.UpdateClusterStatusDelta("cid0", "session0", 1, 2, []byte("s1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("s1"), got.Status)
+	assert.Equal(t, int64(2), got.StatusSequenceNumber)
+
+	// Stale sequence number.
+	_, err = st.UpdateClusterStatusDelta("cid0", "session0", 1, 2, []byte("s2"))
+	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
+
+	// Different session.
+	_, err = st.UpdateClusterStatusDelta("cid0", "session1", 2, 3, []byte("s2"))
+	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
+
+	got, err = st.GetClusterByID("cid0")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("s1"), got.Status)
+}
+
+func TestUpdateClusterCordon(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	c, err := st.CreateOrUpdateCluster(&Cluster{
+		ClusterID: "cid0",
+		TenantID:  "tid0",
+		Status:    []byte("s0"),
+	})
+	assert.NoError(t, err)
+
+	got, err := st.UpdateClusterCordon("cid0", true, "r0", true)
+	assert.NoError(t, err)
+	assert.True(t, got.Cordoned)
+	assert.Equal(t, "r0", got.CordonReason)
+	assert.True(t, got.Draining)
+	// The last status update time is not changed.
+	assert.Equal(t, c.UpdatedAt.UnixNano(), got.UpdatedAt.UnixNano())
+
+	// A status update does not change the cordon state.
+	_, err = st.CreateOrUpdateCluster(&Cluster{
+		ClusterID: "cid0",
+		TenantID:  "tid0",
+		Status:    []byte("s1"),
+	})
+	assert.NoError(t, err)
+	got, err = st.GetClusterByID("cid0")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("s1"), got.Status)
+	assert.True(t, got.Cordoned)
+	assert.True(t, got.Draining)
+
+	_, err = st.UpdateClusterCordon("cid1", true, "", false)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
+
+func TestUpdateClusterHealthState(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	c, err := st.CreateOrUpdateCluster(&Cluster{
+		ClusterID: "cid0",
+		TenantID:  "tid0",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ClusterHealthStateHealthy, c.HealthState)
+
+	err = st.UpdateClusterHealthState("cid0", ClusterHealthStateHealthy, ClusterHealthStateUnreachable)
+	assert.NoError(t, err)
+
+	got, err := st.ListClusters()
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, ClusterHealthStateUnreachable, got[0].HealthState)
+	// The last status update time is not changed.
+	assert.Equal(t, c.UpdatedAt.UnixNano(), got[0].UpdatedAt.UnixNano())
+
+	// The state has already been changed.
+	err = st.UpdateClusterHealthState("cid0", ClusterHealthStateHealthy, ClusterHealthStateDegraded)
+	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
+
+	err = st.UpdateClusterHealthState("cid1", ClusterHealthStateHealthy, ClusterHealthStateDegraded)
+	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
+}
+
+func TestUpdateClusterLabels(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	c, err := st.CreateOrUpdateCluster(&Cluster{
+		ClusterID: "cid0",
+		TenantID:  "tid0",
+	})
+	assert.NoError(t, err)
+
+	got, err := st.UpdateClusterLabels("cid0", map[string]string{"region": "us-west-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"region": "us-west-2"}, got.Labels)
+	// The last status update time is not changed.
+	assert.Equal(t, c.UpdatedAt.UnixNano(), got.UpdatedAt.UnixNano())
+
+	// A status update does not change the labels.
+	_, err = st.CreateOrUpdateCluster(&Cluster{
+		ClusterID: "cid0",
+		TenantID:  "tid0",
+	})
+	assert.NoError(t, err)
+	got, err = st.GetClusterByID("cid0")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"region": "us-west-2"}, got.Labels)
+	assert.Equal(t, map[string]string{"region": "us-west-2", "provider": "aws"}, got.EffectiveLabels(map[string]string{"region": "us-east-1", "provider": "aws"}))
+
+	_, err = st.UpdateClusterLabels("cid1", nil)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
+
+func TestUpdateClusterMaintenanceWindows(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	_, err := st.CreateOrUpdateCluster(&Cluster{
+		ClusterID: "cid0",
+		TenantID:  "tid0",
+	})
+	assert.NoError(t, err)
+
+	start := time.Unix(1000, 0).UTC()
+	w := MaintenanceWindow{ID: "w0", StartTime: start, EndTime: start.Add(time.Hour), Reason: "r0"}
+	_, err = st.UpdateClusterMaintenanceWindows("cid0", func(ws []MaintenanceWindow) ([]MaintenanceWindow, error) {
+		assert.Empty(t, ws)
+		return append(ws, w), nil
+	})
+	assert.NoError(t, err)
+
+	got, err := st.GetClusterByID("cid0")
+	assert.NoError(t, err)
+	assert.Len(t, got.MaintenanceWindows, 1)
+	assert.Equal(t, "w0", got.MaintenanceWindows[0].ID)
+	assert.True(t, got.MaintenanceWindows[0].StartTime.Equal(start))
+	assert.True(t, got.MaintenanceWindows[0].IsActive(start))
+	assert.False(t, got.MaintenanceWindows[0].IsActive(start.Add(time.Hour)))
+
+	// The windows are not updated if the function fails.
+	_, err = st.UpdateClusterMaintenanceWindows("cid0", func(ws []MaintenanceWindow) ([]MaintenanceWindow, error) {
+		return nil, errors.New("error")
+	})
+	assert.Error(t, err)
+	got, err = st.GetClusterByID("cid0")
+	assert.NoError(t, err)
+	assert.Len(t, got.MaintenanceWindows, 1)
+}
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"gorm.io/gorm"
+)
+
+// DispatchSequence is a model that allocates dispatch sequence numbers. A row is inserted for every allocation,
+// and its ID is the allocated number.
+type DispatchSequence struct {
+	ID int64 `gorm:"primaryKey"`
+}
+
+// nextDispatchSequence allocates a dispatch sequence number that is greater than all the allocated numbers.
+// The rows of the older numbers are deleted so that the table does not grow.
+func nextDispatchSequence(tx *gorm.DB) (int64, error) {
+	seq := &DispatchSequence{}
+	if err := tx.Create(seq).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Where("id < ?", seq.ID).Delete(&DispatchSequence{}).Error; err != nil {
+		return 0, err
+	}
+	return seq.ID, nil
+}
+
+// updateQueuedAction sets the queued action and the other updates to the rows matching the query, and returns
+// the number of the updated rows. A non-empty action gets a new dispatch sequence number in the same transaction.
+func (s *S) updateQueuedAction(
+	model interface{},
+	action string,
+	updates map[string]interface{},
+	query interface{},
+	args ...interface{},
+) (int64, error) {
+	updates["queued_action"] = action
+	if action == "" {
+		result := s.db.Model(model).Where(query, args...).Updates(updates)
+		return result.RowsAffected, result.Error
+	}
+
+	var rowsAffected int64
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		seq, err := nextDispatchSequence(tx)
+		if err != nil {
+			return err
+		}
+		updates["dispatch_sequence"] = seq
+		result := tx.Model(model).Where(query, args...).Updates(updates)
+		if err := result.Error; err != nil {
+			return err
+		}
+		rowsAffected = result.RowsAffected
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	if rowsAffected > 0 {
+		s.notifyQueuedActionSet()
+	}
+	return rowsAffected, nil
+}
+
+// createWithDispatchSequence creates a workload. A workload created with a queued action gets a new dispatch
+// sequence number in the same transaction.
+func (s *S) createWithDispatchSequence(workload interface{}, action string, seq *int64) error {
+	if action == "" {
+		return s.db.Create(workload).Error
+	}
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if *seq, err = nextDispatchSequence(tx); err != nil {
+			return err
+		}
+		return tx.Create(workload).Error
+	}); err != nil {
+		return err
+	}
+	s.notifyQueuedActionSet()
+	return nil
+}
@@ -0,0 +1,106 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatchSequence(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	nb := &Notebook{
+		NotebookID:   "nb0",
+		State:        NotebookStateQueued,
+		QueuedAction: NotebookQueuedActionStart,
+	}
+	err := st.CreateNotebook(nb)
+	assert.NoError(t, err)
+	assert.NotZero(t, nb.DispatchSequence)
+
+	job := &Job{
+		JobID:        "job0",
+		State:        JobStateQueued,
+		QueuedAction: JobQueuedActionCreate,
+	}
+	err = st.CreateJob(job)
+	assert.NoError(t, err)
+	assert.Greater(t, job.DispatchSequence, nb.DispatchSequence)
+
+	// Clearing the queued action keeps the sequence number.
+	_, err = st.UpdateJobState(job.JobID, job.Version, JobStateRunning, "")
+	assert.NoError(t, err)
+	got, err := st.GetJobByJobID(job.JobID)
+	assert.NoError(t, err)
+	assert.Equal(t, job.DispatchSequence, got.DispatchSequence)
+
+	// A new queued action gets a greater sequence number.
+	err = st.SetNotebookQueuedActionAndMessage(nb.NotebookID, nb.Version, NotebookQueuedActionStop, nil)
+	assert.NoError(t, err)
+	gotNB, err := st.GetNotebookByID(nb.NotebookID)
+	assert.NoError(t, err)
+	assert.Greater(t, gotNB.DispatchSequence, job.DispatchSequence)
+
+	// A failed update keeps the sequence number.
+	err = st.SetNotebookQueuedActionAndMessage(nb.NotebookID, nb.Version, NotebookQueuedActionDelete, nil)
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+	gotNB2, err := st.GetNotebookByID(nb.NotebookID)
+	assert.NoError(t, err)
+	assert.Equal(t, gotNB.DispatchSequence, gotNB2.DispatchSequence)
+	assert.Equal(t, NotebookQueuedActionStop, gotNB2.QueuedAction)
+
+	var count int64
+	err = st.db.Model(&DispatchSequence{}).Count(&count).Error
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestQueuedActionSet(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	signaled := func() bool {
+		select {
+		case <-st.QueuedActionSet():
+			return true
+		default:
+			return false
+		}
+	}
+
+	job := &Job{
+		JobID: "job0",
+		State: JobStateRunning,
+	}
+	err := st.CreateJob(job)
+	assert.NoError(t, err)
+	assert.False(t, signaled())
+
+	_, err = st.UpdateJobState(job.JobID, job.Version, JobStateQueued, JobQueuedActionCancel)
+	assert.NoError(t, err)
+	assert.True(t, signaled())
+	assert.False(t, signaled())
+
+	// The signal is deferred until the transaction commits.
+	err = st.Transaction(func(tx *S) error {
+		if _, err := tx.UpdateJobState(job.JobID, job.Version+1, JobStateQueued, JobQueuedActionCreate); err != nil {
+			return err
+		}
+		assert.False(t, signaled())
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, signaled())
+
+	// A rolled back transaction does not signal.
+	err = st.Transaction(func(tx *S) error {
+		if _, err := tx.UpdateJobState(job.JobID, job.Version+2, JobStateQueued, JobQueuedActionCancel); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+	assert.Error(t, err)
+	assert.False(t, signaled())
+}
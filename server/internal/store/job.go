@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
@@ -23,6 +24,9 @@ const (
 	JobStateSucceeded JobState = "succeeded"
 	// JobStateCanceled represents the canceled state.
 	JobStateCanceled JobState = "canceled"
+	// JobStateRequeued represents the state of a job that has been deleted from its cluster and waits to be
+	// rescheduled to another cluster.
+	JobStateRequeued JobState = "requeued"
 )
 
 // JobQueuedAction is the action of a queue job.
@@ -33,6 +37,8 @@ const (
 	JobQueuedActionCreate JobQueuedAction = "creating"
 	// JobQueuedActionCancel represents the canceling action.
 	JobQueuedActionCancel JobQueuedAction = "canceling"
+	// JobQueuedActionRequeue represents the requeueing action.
+	JobQueuedActionRequeue JobQueuedAction = "requeueing"
 )
 
 // Job represents a job.
@@ -53,11 +59,20 @@ type Job struct {
 
 	// Message is the marshaled proto message of v1.Job.
 	Message []byte
+	// ProjectMessage is the marshaled proto message of the rbac v1.Project. It is used to reschedule the job.
+	ProjectMessage []byte
 
 	State JobState `gorm:"index:idx_job_project_id_state;index:idx_job_tenant_id_cluster_id_state"`
 	// QueuedAction is the action of a queue job.
 	// This field is only used when the state is JobStateQueued.
 	QueuedAction JobQueuedAction
+	// DispatchSequence is the sequence number allocated when the queued action is set. It is the cursor of
+	// the action pushed to the dispatcher.
+	DispatchSequence int64
+	// LastDispatchError is the error of the last attempt of the dispatcher to process the queued action.
+	LastDispatchError string
+	// StatusReason describes why the job is not making progress (e.g., an image pull failure).
+	StatusReason string
 
 	// Suffix is a string that will be added to a fine-tuned model name.
 	Suffix string
@@ -80,9 +95,22 @@ func (j *Job) V1Job() (*v1.Job, error) {
 	} else {
 		jobProto.Status = string(j.State)
 	}
+	jobProto.LastDispatchError = j.LastDispatchError
+	jobProto.StatusReason = j.StatusReason
 	return &jobProto, nil
 }
 
+// Reschedulable returns true if the job keeps what is needed to reschedule it to another cluster. Jobs created
+// before the project message was added cannot be rescheduled.
+func (j *Job) Reschedulable() bool {
+	return len(j.ProjectMessage) > 0
+}
+
+// RebuildUserInfo rebuilds the user info of the job to reschedule it.
+func (j *Job) RebuildUserInfo() (*auth.UserInfo, error) {
+	return rebuildUserInfo(j.ProjectMessage, j.TenantID, j.ProjectID)
+}
+
 // V1InternalJob converts a job to v1.InternalJob.
 func (j *Job) V1InternalJob() (*v1.InternalJob, error) {
 	job, err := j.V1Job()
@@ -143,10 +171,7 @@ func (j *Job) MutateMessage(mutateFn func(j *v1.Job)) error {
 
 // CreateJob creates a new job.
 func (s *S) CreateJob(job *Job) error {
-	if err := s.db.Create(job).Error; err != nil {
-		return err
-	}
-	return nil
+	return s.createWithDispatchSequence(job, string(job.QueuedAction), &job.DispatchSequence)
 }
 
 // GetJobByJobID gets a job.
@@ -185,6 +210,24 @@ func (s *S) ListQueuedJobsByTenantIDAndClusterID(tenantID, clusterID string) ([]
 	return jobs, nil
 }
 
+// ListJobsByState finds jobs by state.
+func (s *S) ListJobsByState(state JobState) ([]*Job, error) {
+	var jobs []*Job
+	if err := s.db.Where("state = ?", state).Order("job_id").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ListJobsByTenantIDAndClusterIDAndStates finds jobs in the given states by tenant ID and cluster ID.
+func (s *S) ListJobsByTenantIDAndClusterIDAndStates(tenantID, clusterID string, states []JobState) ([]*Job, error) {
+	var jobs []*Job
+	if err := s.db.Where("tenant_id = ? AND cluster_id = ? AND state IN ?", tenantID, clusterID, states).Order("job_id").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
 // ListJobsByTenantID finds jobs.
 func (s *S) ListJobsByTenantID(tenantID string) ([]*Job, error) {
 	var jobs []*Job
@@ -216,19 +259,15 @@ func (s *S) ListJobsByProjectIDWithPagination(projectID string, afterID uint, li
 // UpdateJobState updates a job state and queued action.
 func (s *S) UpdateJobState(jobID string, currentVersion int, newState JobState, newAction JobQueuedAction) (*Job, error) {
 	var job Job
-	result := s.db.Model(&job).
-		Where("job_id = ?", jobID).
-		Where("version = ?", currentVersion).
-		Updates(map[string]interface{}{
-			"state":         newState,
-			"queued_action": newAction,
-			"version":       currentVersion + 1,
-		})
-	if err := result.Error; err != nil {
+	rowsAffected, err := s.updateQueuedAction(&job, string(newAction), map[string]interface{}{
+		"state":   newState,
+		"version": currentVersion + 1,
+	}, "job_id = ? AND version = ?", jobID, currentVersion)
+	if err != nil {
 		return nil, err
 	}
 
-	if result.RowsAffected == 0 {
+	if rowsAffected == 0 {
 		return nil, fmt.Errorf("update job: %w", ErrConcurrentUpdate)
 	}
 	return &job, nil
@@ -254,6 +293,41 @@ func (s *S) UpdateJobStateAndMessage(jobID string, currentVersion int, newState
 	return nil
 }
 
+// UpdateJobForRescheduling updates the cluster, state, queued action, and message of a job.
+func (s *S) UpdateJobForRescheduling(job *Job) error {
+	rowsAffected, err := s.updateQueuedAction(&Job{}, string(job.QueuedAction), map[string]interface{}{
+		"cluster_id": job.ClusterID,
+		"state":      job.State,
+		"message":    job.Message,
+		"version":    job.Version + 1,
+	}, "job_id = ? AND version = ?", job.JobID, job.Version)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("update job: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
+// UpdateJobMessage updates the message of a job. The state and queued action are not changed.
+func (s *S) UpdateJobMessage(id string, currentVersion int, message []byte) error {
+	result := s.db.Model(&Job{}).
+		Where("job_id = ?", id).
+		Where("version = ?", currentVersion).
+		Updates(map[string]interface{}{
+			"message": message,
+			"version": currentVersion + 1,
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("update job: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
 // UpdateOutputModelID updates the output model ID.
 func (s *S) UpdateOutputModelID(jobID string, currentVersion int, outputModelID string) error {
 	result := s.db.Model(&Job{}).
@@ -273,6 +347,22 @@ func (s *S) UpdateOutputModelID(jobID string, currentVersion int, outputModelID
 	return nil
 }
 
+// UpdateJobLastDispatchError updates the last dispatch error of a job. The version and the update time are kept
+// as the error does not change the state of the job.
+func (s *S) UpdateJobLastDispatchError(jobID, lastDispatchError string) error {
+	return s.db.Model(&Job{}).
+		Where("job_id = ?", jobID).
+		UpdateColumn("last_dispatch_error", lastDispatchError).Error
+}
+
+// UpdateJobStatusReason updates the status reason of a job. The version and the update time are kept
+// as the reason does not change the state of the job.
+func (s *S) UpdateJobStatusReason(jobID, statusReason string) error {
+	return s.db.Model(&Job{}).
+		Where("job_id = ?", jobID).
+		UpdateColumn("status_reason", statusReason).Error
+}
+
 // CountJobsByProjectID counts the total number of jobs by project ID.
 func (s *S) CountJobsByProjectID(projectID string) (int64, error) {
 	var count int64
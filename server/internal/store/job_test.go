@@ -173,6 +173,57 @@ func TestUpdateOutputModelID(t *testing.T) {
 	assert.Equal(t, "output-model-id", got.OutputModelID)
 }
 
+func TestUpdateJobLastDispatchError(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	job := &Job{
+		JobID:        "job0",
+		State:        JobStateQueued,
+		QueuedAction: JobQueuedActionCreate,
+		Version:      1,
+	}
+	err := st.CreateJob(job)
+	assert.NoError(t, err)
+
+	err = st.UpdateJobLastDispatchError(job.JobID, "failed")
+	assert.NoError(t, err)
+
+	got, err := st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	assert.Equal(t, "failed", got.LastDispatchError)
+	// The version and the update time are kept so that the update does not conflict with state transitions.
+	assert.Equal(t, job.Version, got.Version)
+	assert.True(t, job.UpdatedAt.Equal(got.UpdatedAt))
+}
+
+func TestUpdateJobStatusReason(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	job := &Job{
+		JobID:   "job0",
+		State:   JobStateRunning,
+		Version: 1,
+	}
+	err := st.CreateJob(job)
+	assert.NoError(t, err)
+
+	err = st.UpdateJobStatusReason(job.JobID, "ImagePullBackOff: Back-off pulling image")
+	assert.NoError(t, err)
+
+	got, err := st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	assert.Equal(t, "ImagePullBackOff: Back-off pulling image", got.StatusReason)
+	assert.Equal(t, job.Version, got.Version)
+
+	err = st.UpdateJobStatusReason(job.JobID, "")
+	assert.NoError(t, err)
+	got, err = st.GetJobByJobID("job0")
+	assert.NoError(t, err)
+	assert.Empty(t, got.StatusReason)
+}
+
 func TestCountJobsByProjectID(t *testing.T) {
 	st, teardown := NewTest(t)
 	defer teardown()
@@ -82,6 +82,11 @@ type Notebook struct {
 	// QueuedAction is the action of the queued notebook. This field is only used when
 	// the state is NotebookStateQueued, and processed by the dispatcher.
 	QueuedAction NotebookQueuedAction
+	// DispatchSequence is the sequence number allocated when the queued action is set. It is the cursor of
+	// the action pushed to the dispatcher.
+	DispatchSequence int64
+	// LastDispatchError is the error of the last attempt of the dispatcher to process the queued action.
+	LastDispatchError string
 	// Reason explains why the notebook is in the current state
 	Reason string
 
@@ -157,6 +162,7 @@ func (n *Notebook) V1Notebook() (*v1.Notebook, error) {
 	} else {
 		nbProto.Status = string(n.State)
 	}
+	nbProto.LastDispatchError = n.LastDispatchError
 	return &nbProto, nil
 }
 
@@ -198,8 +204,13 @@ func (n *Notebook) MutateMessage(mutateFn func(nb *v1.Notebook)) error {
 
 // RebuildUserInfo rebuilds the user info from the project message.
 func (n *Notebook) RebuildUserInfo() (*auth.UserInfo, error) {
+	return rebuildUserInfo(n.ProjectMessage, n.TenantID, n.ProjectID)
+}
+
+// rebuildUserInfo rebuilds the user info from the marshaled rbac v1.Project that is stored with a workload.
+func rebuildUserInfo(projectMessage []byte, tenantID, projectID string) (*auth.UserInfo, error) {
 	var pProto rbacv1.Project
-	err := proto.Unmarshal(n.ProjectMessage, &pProto)
+	err := proto.Unmarshal(projectMessage, &pProto)
 	if err != nil {
 		return nil, err
 	}
@@ -213,8 +224,8 @@ func (n *Notebook) RebuildUserInfo() (*auth.UserInfo, error) {
 	}
 	return &auth.UserInfo{
 		AssignedKubernetesEnvs: akes,
-		TenantID:               n.TenantID,
-		ProjectID:              n.ProjectID,
+		TenantID:               tenantID,
+		ProjectID:              projectID,
 	}, nil
 }
 
@@ -240,7 +251,7 @@ func convertToNotebookQueuedAction(action NotebookQueuedAction) (v1.NotebookQueu
 
 // CreateNotebook creates a new notebook.
 func (s *S) CreateNotebook(nb *Notebook) error {
-	return s.db.Create(nb).Error
+	return s.createWithDispatchSequence(nb, string(nb.QueuedAction), &nb.DispatchSequence)
 }
 
 // GetNotebookByID gets a notebook by its notebook ID.
@@ -299,6 +310,16 @@ func (s *S) ListQueuedNotebooksByTenantIDAndClusterID(tenantID, clusterID string
 	return nbs, nil
 }
 
+// ListNotebooksByTenantIDAndClusterIDAndStates finds notebooks in the given states by tenant ID and cluster ID.
+func (s *S) ListNotebooksByTenantIDAndClusterIDAndStates(tenantID, clusterID string, states []NotebookState) ([]*Notebook, error) {
+	var nbs []*Notebook
+	if err := s.db.Where("tenant_id = ? AND cluster_id = ? AND state IN ?", tenantID, clusterID, states).
+		Find(&nbs).Error; err != nil {
+		return nil, err
+	}
+	return nbs, nil
+}
+
 // ListNotebooksByState finds all notebooks with the specified state.
 func (s *S) ListNotebooksByState(state NotebookState) ([]*Notebook, error) {
 	var nbs []*Notebook
@@ -308,27 +329,49 @@ func (s *S) ListNotebooksByState(state NotebookState) ([]*Notebook, error) {
 	return nbs, nil
 }
 
+// ListNotebooksByTenantIDAndState finds notebooks by tenant ID and state.
+func (s *S) ListNotebooksByTenantIDAndState(tenantID string, state NotebookState) ([]*Notebook, error) {
+	var nbs []*Notebook
+	if err := s.db.Where("tenant_id = ? AND state = ?", tenantID, state).Find(&nbs).Error; err != nil {
+		return nil, err
+	}
+	return nbs, nil
+}
+
 // SetNotebookQueuedAction sets a notebook queued action.
 func (s *S) SetNotebookQueuedAction(id string, currentVersion int, newAction NotebookQueuedAction) (*Notebook, error) {
 	var nb Notebook
-	result := s.db.Model(&nb).
-		Where("notebook_id = ?", id).
-		Where("version = ?", currentVersion).
-		Updates(map[string]interface{}{
-			"state":         NotebookStateQueued,
-			"queued_action": newAction,
-			"reason":        "",
-			"version":       currentVersion + 1,
-		})
-	if err := result.Error; err != nil {
+	rowsAffected, err := s.updateQueuedAction(&nb, string(newAction), map[string]interface{}{
+		"state":   NotebookStateQueued,
+		"reason":  "",
+		"version": currentVersion + 1,
+	}, "notebook_id = ? AND version = ?", id, currentVersion)
+	if err != nil {
 		return nil, err
 	}
-	if result.RowsAffected == 0 {
+	if rowsAffected == 0 {
 		return nil, fmt.Errorf("update notebook: %w", ErrConcurrentUpdate)
 	}
 	return &nb, nil
 }
 
+// SetNotebookQueuedActionAndMessage sets a notebook queued action and message.
+func (s *S) SetNotebookQueuedActionAndMessage(id string, currentVersion int, newAction NotebookQueuedAction, message []byte) error {
+	rowsAffected, err := s.updateQueuedAction(&Notebook{}, string(newAction), map[string]interface{}{
+		"state":   NotebookStateQueued,
+		"message": message,
+		"reason":  "",
+		"version": currentVersion + 1,
+	}, "notebook_id = ? AND version = ?", id, currentVersion)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("update notebook: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
 // SetState sets a state.
 func (s *S) SetState(id string, currentVersion int, newState NotebookState) error {
 	result := s.db.Model(&Notebook{}).
@@ -369,18 +412,14 @@ func (s *S) SetNonQueuedStateAndMessage(id string, currentVersion int, newState
 	return nil
 }
 
-// UpdateNotebookForRescheduling updates the notebook.
-func (s *S) UpdateNotebookForRescheduling(nb *Notebook) error {
+// UpdateNotebookMessage updates the message of a notebook. The state and queued action are not changed.
+func (s *S) UpdateNotebookMessage(id string, currentVersion int, message []byte) error {
 	result := s.db.Model(&Notebook{}).
-		Where("notebook_id = ?", nb.NotebookID).
-		Where("version = ?", nb.Version).
+		Where("notebook_id = ?", id).
+		Where("version = ?", currentVersion).
 		Updates(map[string]interface{}{
-			"cluster_id":    nb.ClusterID,
-			"state":         nb.State,
-			"queued_action": nb.QueuedAction,
-			"message":       nb.Message,
-			"reason":        nb.Reason,
-			"version":       nb.Version + 1,
+			"message": message,
+			"version": currentVersion + 1,
 		})
 	if err := result.Error; err != nil {
 		return err
@@ -391,6 +430,32 @@ func (s *S) UpdateNotebookForRescheduling(nb *Notebook) error {
 	return nil
 }
 
+// UpdateNotebookForRescheduling updates the notebook.
+func (s *S) UpdateNotebookForRescheduling(nb *Notebook) error {
+	rowsAffected, err := s.updateQueuedAction(&Notebook{}, string(nb.QueuedAction), map[string]interface{}{
+		"cluster_id": nb.ClusterID,
+		"state":      nb.State,
+		"message":    nb.Message,
+		"reason":     nb.Reason,
+		"version":    nb.Version + 1,
+	}, "notebook_id = ? AND version = ?", nb.NotebookID, nb.Version)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("update notebook: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
+// UpdateNotebookLastDispatchError updates the last dispatch error of a notebook. The version and the update time
+// are kept as the error does not change the state of the notebook.
+func (s *S) UpdateNotebookLastDispatchError(id, lastDispatchError string) error {
+	return s.db.Model(&Notebook{}).
+		Where("notebook_id = ?", id).
+		UpdateColumn("last_dispatch_error", lastDispatchError).Error
+}
+
 // CountActiveNotebooksByProjectID counts the total number of active notebooks by project ID.
 func (s *S) CountActiveNotebooksByProjectID(projectID string) (int64, error) {
 	var count int64
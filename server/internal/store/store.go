@@ -7,13 +7,67 @@ import (
 // New creates a new store instance.
 func New(db *gorm.DB) *S {
 	return &S{
-		db: db,
+		db:            db,
+		queuedActions: make(chan struct{}, 1),
 	}
 }
 
 // S represents the data store.
 type S struct {
 	db *gorm.DB
+
+	// queuedActions is signaled when a queued action is set. It is shared with the stores of transactions.
+	queuedActions chan struct{}
+	// tx is the state of the transaction that the store runs queries in. Nil if the store is not in a transaction.
+	tx *txState
+}
+
+// txState is the state of a transaction.
+type txState struct {
+	// queuedActionSet is true if a queued action is set in the transaction.
+	queuedActionSet bool
+}
+
+// Transaction runs the function in a transaction. The function is called with a store that runs queries
+// in the transaction.
+func (s *S) Transaction(fn func(tx *S) error) error {
+	var txS *S
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		txS = &S{
+			db:            tx,
+			queuedActions: s.queuedActions,
+			tx:            s.tx,
+		}
+		if txS.tx == nil {
+			txS.tx = &txState{}
+		}
+		return fn(txS)
+	}); err != nil {
+		return err
+	}
+	if txS.tx.queuedActionSet {
+		s.notifyQueuedActionSet()
+	}
+	return nil
+}
+
+// QueuedActionSet returns a channel that is signaled after a queued action is set. Signals are coalesced while
+// the channel is not received.
+func (s *S) QueuedActionSet() <-chan struct{} {
+	return s.queuedActions
+}
+
+// notifyQueuedActionSet signals that a queued action is set. The signal is deferred until the outermost
+// transaction commits.
+func (s *S) notifyQueuedActionSet() {
+	if s.tx != nil {
+		s.tx.queuedActionSet = true
+		return
+	}
+	select {
+	case s.queuedActions <- struct{}{}:
+	default:
+	}
 }
 
 // AutoMigrate sets up the auto-migration task of the database.
@@ -28,5 +82,8 @@ func autoMigrate(db *gorm.DB) error {
 		&Notebook{},
 		&BatchJob{},
 		&DataKey{},
+		&AssumedPod{},
+		&TenantLock{},
+		&DispatchSequence{},
 	)
 }
@@ -2,10 +2,13 @@ package controller
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/go-logr/logr"
 	v1 "github.com/llmariner/job-manager/api/v1"
+	"github.com/llmariner/job-manager/pkg/accelerator"
+	corev1 "k8s.io/api/core/v1"
 	v2 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -154,8 +157,26 @@ func isDeleted(o client.Object) bool {
 	return !o.GetDeletionTimestamp().IsZero()
 }
 
+// containerGPUs returns the resource name and the number of the accelerators requested by a container.
+// If the container requests more than one type of accelerators, the one with the smallest name is returned.
+func containerGPUs(container corev1.Container) (string, uint32) {
+	var names []string
+	for name := range container.Resources.Limits {
+		if accelerator.IsSupported(name.String()) {
+			names = append(names, name.String())
+		}
+	}
+	if len(names) == 0 {
+		return "", 0
+	}
+	sort.Strings(names)
+	gpu := container.Resources.Limits[corev1.ResourceName(names[0])]
+	return names[0], uint32(gpu.Value())
+}
+
 func prepareSyncPatchRequest(
 	deployObj client.Object,
+	acceleratorType string,
 	totalGPUs uint32,
 	gvr schema.GroupVersionResource,
 	log logr.Logger,
@@ -189,7 +210,8 @@ func prepareSyncPatchRequest(
 	}
 	if totalGPUs > 0 {
 		patchReq.Resources = &v1.PatchKubernetesObjectRequest_Resources{
-			GpuLimit: int32(totalGPUs),
+			GpuLimit:        int32(totalGPUs),
+			AcceleratorType: acceleratorType,
 		}
 	}
 	return patchReq, nil
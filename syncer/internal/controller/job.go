@@ -90,15 +90,17 @@ func (c *JobController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 	}
 	filterLabels(deployObj.Spec.Template.Labels)
 
-	var totalGPUs uint32
+	var (
+		acceleratorType string
+		totalGPUs       uint32
+	)
 	for _, container := range job.Spec.Template.Spec.Containers {
-		if container.Resources.Limits != nil {
-			if gpu, ok := container.Resources.Limits["nvidia.com/gpu"]; ok {
-				totalGPUs += uint32(gpu.Value())
-			}
+		if rn, gpus := containerGPUs(container); gpus > 0 {
+			acceleratorType = rn
+			totalGPUs += gpus
 		}
 	}
-	patchReq, err := prepareSyncPatchRequest(deployObj, totalGPUs, jobGVR, log)
+	patchReq, err := prepareSyncPatchRequest(deployObj, acceleratorType, totalGPUs, jobGVR, log)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
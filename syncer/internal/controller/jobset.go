@@ -92,7 +92,8 @@ func (c *JobSetController) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}
 	}
 
-	patchReq, err := prepareSyncPatchRequest(deployObj, calcMinJobSetGPUs(deployObj), jobSetGVR, log, removeCreationTime)
+	acceleratorType, minGPUs := calcMinJobSetGPUs(deployObj)
+	patchReq, err := prepareSyncPatchRequest(deployObj, acceleratorType, minGPUs, jobSetGVR, log, removeCreationTime)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -149,18 +150,18 @@ func removeCreationTime(uobj *unstructured.Unstructured) error {
 	return nil
 }
 
-// find minimum GPUs required
-func calcMinJobSetGPUs(deployObj *jobset.JobSet) uint32 {
+// find minimum GPUs required and their resource name
+func calcMinJobSetGPUs(deployObj *jobset.JobSet) (string, uint32) {
 	// quick solution that picks the most expensive containers in terms of GPU as bottleneck
 	// see https://kubernetes.io/docs/concepts/workloads/controllers/job/#controlling-parallelism
-	var maxJobGPU uint32
+	var (
+		acceleratorType string
+		maxJobGPU       uint32
+	)
 	for _, job := range deployObj.Spec.ReplicatedJobs {
 		jobSpec := job.Template.Spec
 		for _, container := range jobSpec.Template.Spec.Containers {
-			if container.Resources.Limits == nil {
-				continue
-			}
-			if gpu, ok := container.Resources.Limits["nvidia.com/gpu"]; ok {
+			if rn, gpu := containerGPUs(container); gpu > 0 {
 				// "If it is unspecified, it defaults to 1."
 				parallelJobs := ptr.Deref(jobSpec.Parallelism, 1)
 				// "do not specify .spec.completions, default to .spec.parallelism."
@@ -168,12 +169,13 @@ func calcMinJobSetGPUs(deployObj *jobset.JobSet) uint32 {
 				// "For fixed completion count Jobs, the actual number of pods running in parallel will not exceed
 				// the number of remaining completions."
 				instanceCount := min(mustCompleteCount, parallelJobs)
-				gpuCount := uint32(gpu.Value()) * uint32(instanceCount)
+				gpuCount := gpu * uint32(instanceCount)
 				if gpuCount > maxJobGPU {
+					acceleratorType = rn
 					maxJobGPU = gpuCount
 				}
 			}
 		}
 	}
-	return maxJobGPU
+	return acceleratorType, maxJobGPU
 }
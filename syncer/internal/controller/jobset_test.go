@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/job-manager/pkg/accelerator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
@@ -214,8 +215,11 @@ func TestCalcMinJobSetGPUs(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			got := calcMinJobSetGPUs(spec.jobSet)
+			gotType, got := calcMinJobSetGPUs(spec.jobSet)
 			assert.Equal(t, spec.exp, got)
+			if got > 0 {
+				assert.Equal(t, accelerator.NvidiaGPU, gotType)
+			}
 		})
 	}
 }
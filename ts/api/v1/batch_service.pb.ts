@@ -23,6 +23,7 @@ export enum InternalBatchJobState {
   FAILED = "FAILED",
   CANCELED = "CANCELED",
   DELETED = "DELETED",
+  REQUEUED = "REQUEUED",
 }
 
 export enum InternalBatchJobAction {
@@ -30,15 +31,23 @@ export enum InternalBatchJobAction {
   CREATING = "CREATING",
   CANCELING = "CANCELING",
   DELETING = "DELETING",
+  REQUEUEING = "REQUEUEING",
 }
 
 export type BatchJobError = {
   code?: string
   message?: string
+  exit_code?: number
 }
 
 export type BatchJobResources = {
   gpu_count?: number
+  accelerator_type?: string
+  gpu_type?: string
+  min_gpu_memory_megabytes?: number
+  gpu_profile?: string
+  cpu_milicore?: number
+  memory_megabytes?: number
 }
 
 
@@ -48,6 +57,15 @@ type BaseBatchJobKind = {
 export type BatchJobKind = BaseBatchJobKind
   & OneOf<{ pytorch: PyTorchJob }>
 
+export type BatchJobPlacement = {
+  required_clusters?: string[]
+  preferred_clusters?: string[]
+  cluster_selector?: {[key: string]: string}
+  region?: string
+  preferred_cluster_selector?: {[key: string]: string}
+  namespace?: string
+}
+
 export type BatchJob = {
   id?: string
   created_at?: string
@@ -66,6 +84,12 @@ export type BatchJob = {
   organization_title?: string
   project_title?: string
   cluster_name?: string
+  priority?: number
+  preemptible?: boolean
+  status_unknown?: boolean
+  placement?: BatchJobPlacement
+  last_dispatch_error?: string
+  status_reason?: string
 }
 
 export type PyTorchJob = {
@@ -80,6 +104,9 @@ export type CreateBatchJobRequest = {
   envs?: {[key: string]: string}
   data_files?: string[]
   kind?: BatchJobKind
+  priority?: number
+  preemptible?: boolean
+  placement?: BatchJobPlacement
 }
 
 export type ListBatchJobsRequest = {
@@ -105,10 +132,43 @@ export type DeleteBatchJobRequest = {
   id?: string
 }
 
+export type BatchJobLogArchive = {
+  pod_name?: string
+  container_name?: string
+  chunk_urls?: string[]
+}
+
+export type GetBatchJobLogArchivesRequest = {
+  id?: string
+}
+
+export type GetBatchJobLogArchivesResponse = {
+  archives?: BatchJobLogArchive[]
+  expires_at?: string
+}
+
+export type StreamBatchJobLogsRequest = {
+  id?: string
+  follow?: boolean
+  tail_lines?: string
+  container?: string
+  rank?: number
+}
+
+export type StreamBatchJobLogsResponse = {
+  data?: string
+}
+
+export type InternalBatchJobKueueQueue = {
+  name?: string
+  resource_quotas?: {[key: string]: string}
+}
+
 export type InternalBatchJob = {
   job?: BatchJob
   state?: InternalBatchJobState
   queued_action?: InternalBatchJobAction
+  kueue_queue?: InternalBatchJobKueueQueue
 }
 
 export type ListQueuedInternalBatchJobsRequest = {
@@ -127,11 +187,20 @@ export type UpdateBatchJobStateRequest = {
   state?: InternalBatchJobState
   reason?: string
   message?: string
+  exit_code?: number
 }
 
 export type UpdateBatchJobStateResponse = {
 }
 
+export type UpdateBatchJobStatusReasonRequest = {
+  id?: string
+  status_reason?: string
+}
+
+export type UpdateBatchJobStatusReasonResponse = {
+}
+
 export class BatchService {
   static CreateBatchJob(req: CreateBatchJobRequest, initReq?: fm.InitReq): Promise<BatchJob> {
     return fm.fetchReq<CreateBatchJobRequest, BatchJob>(`/v1/batch/jobs`, {...initReq, method: "POST", body: JSON.stringify(req)})
@@ -148,6 +217,12 @@ export class BatchService {
   static DeleteBatchJob(req: DeleteBatchJobRequest, initReq?: fm.InitReq): Promise<BatchJob> {
     return fm.fetchReq<DeleteBatchJobRequest, BatchJob>(`/v1/batch/jobs/${req["id"]}`, {...initReq, method: "DELETE"})
   }
+  static GetBatchJobLogArchives(req: GetBatchJobLogArchivesRequest, initReq?: fm.InitReq): Promise<GetBatchJobLogArchivesResponse> {
+    return fm.fetchReq<GetBatchJobLogArchivesRequest, GetBatchJobLogArchivesResponse>(`/v1/batch/jobs/${req["id"]}/log_archives?${fm.renderURLSearchParams(req, ["id"])}`, {...initReq, method: "GET"})
+  }
+  static StreamBatchJobLogs(req: StreamBatchJobLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<StreamBatchJobLogsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<StreamBatchJobLogsRequest, StreamBatchJobLogsResponse>(`/v1/batch/jobs/${req["id"]}/logs?${fm.renderURLSearchParams(req, ["id"])}`, entityNotifier, {...initReq, method: "GET"})
+  }
 }
 export class BatchWorkerService {
   static ListQueuedInternalBatchJobs(req: ListQueuedInternalBatchJobsRequest, initReq?: fm.InitReq): Promise<ListQueuedInternalBatchJobsResponse> {
@@ -159,4 +234,7 @@ export class BatchWorkerService {
   static UpdateBatchJobState(req: UpdateBatchJobStateRequest, initReq?: fm.InitReq): Promise<UpdateBatchJobStateResponse> {
     return fm.fetchReq<UpdateBatchJobStateRequest, UpdateBatchJobStateResponse>(`/llmariner.batch.server.v1.BatchWorkerService/UpdateBatchJobState`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static UpdateBatchJobStatusReason(req: UpdateBatchJobStatusReasonRequest, initReq?: fm.InitReq): Promise<UpdateBatchJobStatusReasonResponse> {
+    return fm.fetchReq<UpdateBatchJobStatusReasonRequest, UpdateBatchJobStatusReasonResponse>(`/llmariner.batch.server.v1.BatchWorkerService/UpdateBatchJobStatusReason`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
 }
\ No newline at end of file
@@ -13,12 +13,14 @@ export enum InternalJobState {
   FAILED = "FAILED",
   SUCCEEDED = "SUCCEEDED",
   CANCELED = "CANCELED",
+  REQUEUED = "REQUEUED",
 }
 
 export enum InternalJobAction {
   ACTION_UNSPECIFIED = "ACTION_UNSPECIFIED",
   CREATING = "CREATING",
   CANCELING = "CANCELING",
+  REQUEUEING = "REQUEUEING",
 }
 
 export enum UpdateJobPhaseRequestPhase {
@@ -29,6 +31,7 @@ export enum UpdateJobPhaseRequestPhase {
   FAILED = "FAILED",
   RECREATE = "RECREATE",
   CANCELED = "CANCELED",
+  REQUEUED = "REQUEUED",
 }
 
 export type IntegrationWandb = {
@@ -59,6 +62,7 @@ export type JobError = {
   code?: string
   message?: string
   param?: string
+  exit_code?: number
 }
 
 export type JobHyperparameters = {
@@ -69,6 +73,20 @@ export type JobHyperparameters = {
 
 export type JobResources = {
   gpu_count?: number
+  accelerator_type?: string
+  gpu_type?: string
+  min_gpu_memory_megabytes?: number
+  cpu_milicore?: number
+  memory_megabytes?: number
+}
+
+export type JobPlacement = {
+  required_clusters?: string[]
+  preferred_clusters?: string[]
+  cluster_selector?: {[key: string]: string}
+  region?: string
+  preferred_cluster_selector?: {[key: string]: string}
+  namespace?: string
 }
 
 export type Job = {
@@ -96,6 +114,10 @@ export type Job = {
   project_title?: string
   cluster_name?: string
   resources?: JobResources
+  status_unknown?: boolean
+  placement?: JobPlacement
+  last_dispatch_error?: string
+  status_reason?: string
 }
 
 export type CreateJobRequestHyperparameters = {
@@ -115,6 +137,7 @@ export type CreateJobRequest = {
   seed?: number
   resources?: JobResources
   metadata?: {[key: string]: string}
+  placement?: JobPlacement
 }
 
 export type ListJobsRequest = {
@@ -137,12 +160,45 @@ export type CancelJobRequest = {
   id?: string
 }
 
+export type JobLogArchive = {
+  pod_name?: string
+  container_name?: string
+  chunk_urls?: string[]
+}
+
+export type GetJobLogArchivesRequest = {
+  id?: string
+}
+
+export type GetJobLogArchivesResponse = {
+  archives?: JobLogArchive[]
+  expires_at?: string
+}
+
+export type StreamJobLogsRequest = {
+  id?: string
+  follow?: boolean
+  tail_lines?: string
+  container?: string
+  rank?: number
+}
+
+export type StreamJobLogsResponse = {
+  data?: string
+}
+
+export type InternalJobKueueQueue = {
+  name?: string
+  resource_quotas?: {[key: string]: string}
+}
+
 export type InternalJob = {
   job?: Job
   output_model_id?: string
   suffix?: string
   state?: InternalJobState
   queued_action?: InternalJobAction
+  kueue_queue?: InternalJobKueueQueue
 }
 
 export type ListQueuedInternalJobsRequest = {
@@ -161,11 +217,21 @@ export type UpdateJobPhaseRequest = {
   phase?: UpdateJobPhaseRequestPhase
   message?: string
   model_id?: string
+  error_code?: string
+  exit_code?: number
 }
 
 export type UpdateJobPhaseResponse = {
 }
 
+export type UpdateJobStatusReasonRequest = {
+  id?: string
+  status_reason?: string
+}
+
+export type UpdateJobStatusReasonResponse = {
+}
+
 export class FineTuningService {
   static CreateJob(req: CreateJobRequest, initReq?: fm.InitReq): Promise<Job> {
     return fm.fetchReq<CreateJobRequest, Job>(`/v1/fine_tuning/jobs`, {...initReq, method: "POST", body: JSON.stringify(req)})
@@ -179,6 +245,12 @@ export class FineTuningService {
   static CancelJob(req: CancelJobRequest, initReq?: fm.InitReq): Promise<Job> {
     return fm.fetchReq<CancelJobRequest, Job>(`/v1/fine_tuning/jobs/${req["id"]}/cancel`, {...initReq, method: "POST"})
   }
+  static GetJobLogArchives(req: GetJobLogArchivesRequest, initReq?: fm.InitReq): Promise<GetJobLogArchivesResponse> {
+    return fm.fetchReq<GetJobLogArchivesRequest, GetJobLogArchivesResponse>(`/v1/fine_tuning/jobs/${req["id"]}/log_archives?${fm.renderURLSearchParams(req, ["id"])}`, {...initReq, method: "GET"})
+  }
+  static StreamJobLogs(req: StreamJobLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<StreamJobLogsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<StreamJobLogsRequest, StreamJobLogsResponse>(`/v1/fine_tuning/jobs/${req["id"]}/logs?${fm.renderURLSearchParams(req, ["id"])}`, entityNotifier, {...initReq, method: "GET"})
+  }
 }
 export class FineTuningWorkerService {
   static ListQueuedInternalJobs(req: ListQueuedInternalJobsRequest, initReq?: fm.InitReq): Promise<ListQueuedInternalJobsResponse> {
@@ -190,4 +262,7 @@ export class FineTuningWorkerService {
   static UpdateJobPhase(req: UpdateJobPhaseRequest, initReq?: fm.InitReq): Promise<UpdateJobPhaseResponse> {
     return fm.fetchReq<UpdateJobPhaseRequest, UpdateJobPhaseResponse>(`/llmariner.fine_tuning.server.v1.FineTuningWorkerService/UpdateJobPhase`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static UpdateJobStatusReason(req: UpdateJobStatusReasonRequest, initReq?: fm.InitReq): Promise<UpdateJobStatusReasonResponse> {
+    return fm.fetchReq<UpdateJobStatusReasonRequest, UpdateJobStatusReasonResponse>(`/llmariner.fine_tuning.server.v1.FineTuningWorkerService/UpdateJobStatusReason`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
 }
\ No newline at end of file
@@ -8,6 +8,20 @@ import * as fm from "../../fetch.pb"
 import * as GoogleProtobufDuration from "../../google/protobuf/duration.pb"
 import * as LlmarinerJobsServerV1Job_manager_server_worker from "./job_manager_server_worker.pb"
 
+export enum ClusterHealthState {
+  CLUSTER_HEALTH_STATE_UNSPECIFIED = "CLUSTER_HEALTH_STATE_UNSPECIFIED",
+  CLUSTER_HEALTH_STATE_HEALTHY = "CLUSTER_HEALTH_STATE_HEALTHY",
+  CLUSTER_HEALTH_STATE_DEGRADED = "CLUSTER_HEALTH_STATE_DEGRADED",
+  CLUSTER_HEALTH_STATE_UNREACHABLE = "CLUSTER_HEALTH_STATE_UNREACHABLE",
+}
+
+export enum JobDrainPolicy {
+  JOB_DRAIN_POLICY_UNSPECIFIED = "JOB_DRAIN_POLICY_UNSPECIFIED",
+  JOB_DRAIN_POLICY_WAIT = "JOB_DRAIN_POLICY_WAIT",
+  JOB_DRAIN_POLICY_CANCEL = "JOB_DRAIN_POLICY_CANCEL",
+  JOB_DRAIN_POLICY_REQUEUE = "JOB_DRAIN_POLICY_REQUEUE",
+}
+
 export enum JobType {
   JOB_TYPE_UNSPECIFIED = "JOB_TYPE_UNSPECIFIED",
   JOB_TYPE_BATCH = "JOB_TYPE_BATCH",
@@ -19,6 +33,13 @@ export type ClusterSummary = {
   gpu_capacity?: number
   gpu_allocated?: number
   gpu_pod_count?: number
+  unhealthy_gpu_count?: number
+}
+
+export type ClusterUnhealthyNode = {
+  name?: string
+  reasons?: string[]
+  unhealthy_gpu_count?: number
 }
 
 export type Cluster = {
@@ -27,6 +48,27 @@ export type Cluster = {
   status?: LlmarinerJobsServerV1Job_manager_server_worker.ClusterStatus
   summary?: ClusterSummary
   last_updated_at?: string
+  unhealthy_nodes?: ClusterUnhealthyNode[]
+  cordoned?: boolean
+  cordon_reason?: string
+  draining?: boolean
+  drain_status?: DrainStatus
+  maintenance_windows?: MaintenanceWindow[]
+  health_state?: ClusterHealthState
+  labels?: {[key: string]: string}
+}
+
+export type DrainStatus = {
+  notebook_count?: number
+  batch_job_count?: number
+  fine_tuning_job_count?: number
+}
+
+export type MaintenanceWindow = {
+  id?: string
+  start_time?: string
+  end_time?: string
+  reason?: string
 }
 
 export type ListClustersRequest = {
@@ -36,6 +78,38 @@ export type ListClustersResponse = {
   clusters?: Cluster[]
 }
 
+export type CordonClusterRequest = {
+  id?: string
+  reason?: string
+}
+
+export type UncordonClusterRequest = {
+  id?: string
+}
+
+export type DrainClusterRequest = {
+  id?: string
+  reason?: string
+  job_policy?: JobDrainPolicy
+}
+
+export type CreateMaintenanceWindowRequest = {
+  cluster_id?: string
+  start_time?: string
+  end_time?: string
+  reason?: string
+}
+
+export type DeleteMaintenanceWindowRequest = {
+  cluster_id?: string
+  id?: string
+}
+
+export type SetClusterLabelsRequest = {
+  id?: string
+  labels?: {[key: string]: string}
+}
+
 export type RequestFilter = {
   start_timestamp?: string
   end_timestamp?: string
@@ -72,6 +146,24 @@ export class JobService {
   static ListClusters(req: ListClustersRequest, initReq?: fm.InitReq): Promise<ListClustersResponse> {
     return fm.fetchReq<ListClustersRequest, ListClustersResponse>(`/v1/jobs/clusters?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
+  static CordonCluster(req: CordonClusterRequest, initReq?: fm.InitReq): Promise<Cluster> {
+    return fm.fetchReq<CordonClusterRequest, Cluster>(`/v1/jobs/clusters/${req["id"]}/actions:cordon`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static UncordonCluster(req: UncordonClusterRequest, initReq?: fm.InitReq): Promise<Cluster> {
+    return fm.fetchReq<UncordonClusterRequest, Cluster>(`/v1/jobs/clusters/${req["id"]}/actions:uncordon`, {...initReq, method: "POST"})
+  }
+  static DrainCluster(req: DrainClusterRequest, initReq?: fm.InitReq): Promise<Cluster> {
+    return fm.fetchReq<DrainClusterRequest, Cluster>(`/v1/jobs/clusters/${req["id"]}/actions:drain`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static CreateMaintenanceWindow(req: CreateMaintenanceWindowRequest, initReq?: fm.InitReq): Promise<Cluster> {
+    return fm.fetchReq<CreateMaintenanceWindowRequest, Cluster>(`/v1/jobs/clusters/${req["cluster_id"]}/maintenance_windows`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static DeleteMaintenanceWindow(req: DeleteMaintenanceWindowRequest, initReq?: fm.InitReq): Promise<Cluster> {
+    return fm.fetchReq<DeleteMaintenanceWindowRequest, Cluster>(`/v1/jobs/clusters/${req["cluster_id"]}/maintenance_windows/${req["id"]}`, {...initReq, method: "DELETE"})
+  }
+  static SetClusterLabels(req: SetClusterLabelsRequest, initReq?: fm.InitReq): Promise<Cluster> {
+    return fm.fetchReq<SetClusterLabelsRequest, Cluster>(`/v1/jobs/clusters/${req["id"]}/labels`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
   static ListJobSummaries(req: ListJobSummariesRequest, initReq?: fm.InitReq): Promise<ListJobSummariesResponse> {
     return fm.fetchReq<ListJobSummariesRequest, ListJobSummariesResponse>(`/v1/jobs/summaries?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -5,37 +5,177 @@
 */
 
 import * as fm from "../../fetch.pb"
+
+type Absent<T, K extends keyof T> = { [k in Exclude<keyof T, K>]?: undefined };
+type OneOf<T> =
+  | { [k in keyof T]?: undefined }
+  | (
+    keyof T extends infer K ?
+      (K extends string & keyof T ? { [k in K]: T[K] } & Absent<T, K>
+        : never)
+    : never);
+
+export enum DispatchActionWorkloadType {
+  WORKLOAD_TYPE_UNSPECIFIED = "WORKLOAD_TYPE_UNSPECIFIED",
+  JOB = "JOB",
+  NOTEBOOK = "NOTEBOOK",
+  BATCH_JOB = "BATCH_JOB",
+}
+
 export type GpuNode = {
   resource_name?: string
   allocatable_count?: number
+  node_name?: string
+  product?: string
+  memory_megabytes?: number
+  capacity_count?: number
+  unhealthy_reasons?: string[]
+}
+
+export type Node = {
+  name?: string
+  allocatable_cpu_milicore?: string
+  allocatable_memory_megabytes?: string
+  requested_cpu_milicore?: string
+  requested_memory_megabytes?: string
 }
 
 export type GpuPod = {
   resource_name?: string
   allocated_count?: number
   namespaced_name?: string
+  node_name?: string
 }
 
 export type ProvisionableResource = {
   instance_family?: string
   instance_type?: string
+  name?: string
+  instance_types?: string[]
+  instance_families?: string[]
+  instance_categories?: string[]
+  gpu_names?: string[]
+  min_gpu_count?: number
+  max_gpu_count?: number
+  gpu_limits?: {[key: string]: number}
+  provisioned_gpus?: {[key: string]: number}
+  source?: string
+  node_gpus?: {[key: string]: number}
+  gpu_product?: string
+  gpu_memory_megabytes?: number
+}
+
+export type NamespaceQuota = {
+  namespace?: string
+  hard?: {[key: string]: string}
+  used?: {[key: string]: string}
 }
 
 export type ClusterStatus = {
   gpu_nodes?: GpuNode[]
   provisionable_resources?: ProvisionableResource[]
   gpu_pods?: GpuPod[]
+  nodes?: Node[]
+  labels?: {[key: string]: string}
+  namespace_quotas?: NamespaceQuota[]
 }
 
 export type UpdateClusterStatusRequest = {
   cluster_status?: ClusterStatus
+  session_id?: string
+  sequence_number?: string
 }
 
 export type UpdateClusterStatusResponse = {
 }
 
+export type UpdateClusterStatusDeltaRequest = {
+  session_id?: string
+  sequence_number?: string
+  updated_gpu_nodes?: GpuNode[]
+  deleted_node_names?: string[]
+  updated_gpu_pods?: GpuPod[]
+  deleted_pod_names?: string[]
+  provisionable_resources?: ProvisionableResource[]
+  provisionable_resources_updated?: boolean
+  updated_nodes?: Node[]
+  deleted_nodes?: string[]
+  namespace_quotas?: NamespaceQuota[]
+  namespace_quotas_updated?: boolean
+}
+
+export type UpdateClusterStatusDeltaResponse = {
+  resync_required?: boolean
+}
+
+export type DispatchAction = {
+  workload_type?: DispatchActionWorkloadType
+  id?: string
+  action?: string
+  cursor?: string
+}
+
+export type DispatchCursor = {
+  workload_type?: DispatchActionWorkloadType
+  cursor?: string
+}
+
+export type StreamQueuedActionsRequestSubscribe = {
+  cursors?: DispatchCursor[]
+}
+
+export type StreamQueuedActionsRequestAck = {
+  id?: string
+  cursor?: string
+  error?: string
+  workload_type?: DispatchActionWorkloadType
+}
+
+
+type BaseStreamQueuedActionsRequest = {
+}
+
+export type StreamQueuedActionsRequest = BaseStreamQueuedActionsRequest
+  & OneOf<{ subscribe: StreamQueuedActionsRequestSubscribe; ack: StreamQueuedActionsRequestAck }>
+
+export type StreamQueuedActionsResponse = {
+  action?: DispatchAction
+}
+
+export type UpdateLastDispatchErrorRequest = {
+  workload_type?: DispatchActionWorkloadType
+  id?: string
+  error?: string
+}
+
+export type UpdateLastDispatchErrorResponse = {
+}
+
+export type ListActiveWorkloadsRequest = {
+}
+
+export type ListActiveWorkloadsResponseWorkload = {
+  workload_type?: DispatchActionWorkloadType
+  id?: string
+  running?: boolean
+  kubernetes_namespace?: string
+}
+
+export type ListActiveWorkloadsResponse = {
+  workloads?: ListActiveWorkloadsResponseWorkload[]
+}
+
 export class JobWorkerService {
   static UpdateClusterStatus(req: UpdateClusterStatusRequest, initReq?: fm.InitReq): Promise<UpdateClusterStatusResponse> {
     return fm.fetchReq<UpdateClusterStatusRequest, UpdateClusterStatusResponse>(`/llmariner.jobs.server.v1.JobWorkerService/UpdateClusterStatus`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static UpdateClusterStatusDelta(req: UpdateClusterStatusDeltaRequest, initReq?: fm.InitReq): Promise<UpdateClusterStatusDeltaResponse> {
+    return fm.fetchReq<UpdateClusterStatusDeltaRequest, UpdateClusterStatusDeltaResponse>(`/llmariner.jobs.server.v1.JobWorkerService/UpdateClusterStatusDelta`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static UpdateLastDispatchError(req: UpdateLastDispatchErrorRequest, initReq?: fm.InitReq): Promise<UpdateLastDispatchErrorResponse> {
+    return fm.fetchReq<UpdateLastDispatchErrorRequest, UpdateLastDispatchErrorResponse>(`/llmariner.jobs.server.v1.JobWorkerService/UpdateLastDispatchError`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static ListActiveWorkloads(req: ListActiveWorkloadsRequest, initReq?: fm.InitReq): Promise<ListActiveWorkloadsResponse> {
+    return fm.fetchReq<ListActiveWorkloadsRequest, ListActiveWorkloadsResponse>(`/llmariner.jobs.server.v1.JobWorkerService/ListActiveWorkloads`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
 }
\ No newline at end of file
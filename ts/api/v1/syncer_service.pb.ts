@@ -7,6 +7,7 @@
 import * as fm from "../../fetch.pb"
 export type PatchKubernetesObjectRequestResources = {
   gpu_limit?: number
+  accelerator_type?: string
 }
 
 export type PatchKubernetesObjectRequest = {
@@ -37,6 +37,7 @@ export enum NotebookQueuedAction {
 export type NotebookError = {
   code?: string
   message?: string
+  exit_code?: number
 }
 
 export type Notebook = {
@@ -59,6 +60,20 @@ export type Notebook = {
   project_title?: string
   cluster_name?: string
   additional_exposed_ports?: number[]
+  priority?: number
+  preemptible?: boolean
+  status_unknown?: boolean
+  placement?: Placement
+  last_dispatch_error?: string
+}
+
+export type Placement = {
+  required_clusters?: string[]
+  preferred_clusters?: string[]
+  cluster_selector?: {[key: string]: string}
+  region?: string
+  preferred_cluster_selector?: {[key: string]: string}
+  namespace?: string
 }
 
 export type ResourcesQuantity = {
@@ -71,6 +86,10 @@ export type Resources = {
   memory_megabytes?: ResourcesQuantity
   storage_megabytes?: ResourcesQuantity
   gpu_count?: number
+  accelerator_type?: string
+  gpu_type?: string
+  min_gpu_memory_megabytes?: number
+  gpu_profile?: string
 }
 
 
@@ -86,6 +105,9 @@ export type CreateNotebookRequest = {
   resources?: Resources
   envs?: {[key: string]: string}
   additional_exposed_ports?: number[]
+  priority?: number
+  preemptible?: boolean
+  placement?: Placement
 }
 
 export type ListNotebooksRequest = {
@@ -135,6 +157,7 @@ export type UpdateNotebookStateRequest = {
   id?: string
   state?: NotebookState
   reason?: string
+  error?: NotebookError
 }
 
 export type UpdateNotebookStateResponse = {